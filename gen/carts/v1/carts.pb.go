@@ -0,0 +1,919 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: carts/v1/carts.proto
+
+package cartsv1
+
+import (
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+	v11 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Represents the cart of a customer. There is one cart per customer, shared by all their devices.
+type Cart struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items      []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// Deprecated: Marked as deprecated in carts/v1/carts.proto.
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"` // Use total_amount.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // The cart is dropped when it is not changed until then.
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`                    // ISO 4217 code the cart is priced in.
+	TotalAmount   *v1.Money              `protobuf:"bytes,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cart) Reset() {
+	*x = Cart{}
+	mi := &file_carts_v1_carts_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cart) ProtoMessage() {}
+
+func (x *Cart) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cart.ProtoReflect.Descriptor instead.
+func (*Cart) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cart) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *Cart) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in carts/v1/carts.proto.
+func (x *Cart) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Cart) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Cart) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Cart) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Cart) GetTotalAmount() *v1.Money {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return nil
+}
+
+// Represents a product in a cart.
+type CartItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Deprecated: Marked as deprecated in carts/v1/carts.proto.
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // Use unit_price.
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	UnitPrice     *v1.Money              `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Unit price when the item was added, or repriced at checkout.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_carts_v1_carts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CartItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in carts/v1/carts.proto.
+func (x *CartItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartItem) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *CartItem) GetUnitPrice() *v1.Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+// Request to add a product to a cart.
+type AddItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // Prices the whole cart in this currency; empty keeps the cart's currency, or the default one for a new cart.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddItemRequest) Reset() {
+	*x = AddItemRequest{}
+	mi := &file_carts_v1_carts_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemRequest) ProtoMessage() {}
+
+func (x *AddItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemRequest.ProtoReflect.Descriptor instead.
+func (*AddItemRequest) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddItemRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *AddItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AddItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AddItemRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Response for an add item request.
+type AddItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddItemResponse) Reset() {
+	*x = AddItemResponse{}
+	mi := &file_carts_v1_carts_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddItemResponse) ProtoMessage() {}
+
+func (x *AddItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddItemResponse.ProtoReflect.Descriptor instead.
+func (*AddItemResponse) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request to remove a product from a cart.
+type RemoveItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveItemRequest) Reset() {
+	*x = RemoveItemRequest{}
+	mi := &file_carts_v1_carts_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemRequest) ProtoMessage() {}
+
+func (x *RemoveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveItemRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *RemoveItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+// Response for a remove item request.
+type RemoveItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveItemResponse) Reset() {
+	*x = RemoveItemResponse{}
+	mi := &file_carts_v1_carts_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveItemResponse) ProtoMessage() {}
+
+func (x *RemoveItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveItemResponse) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemoveItemResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request to set the quantity of a product in a cart.
+type UpdateQuantityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuantityRequest) Reset() {
+	*x = UpdateQuantityRequest{}
+	mi := &file_carts_v1_carts_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuantityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuantityRequest) ProtoMessage() {}
+
+func (x *UpdateQuantityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuantityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateQuantityRequest) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateQuantityRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *UpdateQuantityRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateQuantityRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Response for an update quantity request.
+type UpdateQuantityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateQuantityResponse) Reset() {
+	*x = UpdateQuantityResponse{}
+	mi := &file_carts_v1_carts_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateQuantityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateQuantityResponse) ProtoMessage() {}
+
+func (x *UpdateQuantityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateQuantityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateQuantityResponse) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateQuantityResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request to retrieve a cart.
+type GetCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartRequest) Reset() {
+	*x = GetCartRequest{}
+	mi := &file_carts_v1_carts_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartRequest) ProtoMessage() {}
+
+func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
+func (*GetCartRequest) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCartRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+// Response for a get cart request.
+type GetCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *Cart                  `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartResponse) Reset() {
+	*x = GetCartResponse{}
+	mi := &file_carts_v1_carts_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartResponse) ProtoMessage() {}
+
+func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
+func (*GetCartResponse) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCartResponse) GetCart() *Cart {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Request to place the cart as an order.
+type CheckoutRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId        int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ShipTo            *v11.GeoPoint          `protobuf:"bytes,2,opt,name=ship_to,json=shipTo,proto3" json:"ship_to,omitempty"`
+	MaxBackorderWait  *durationpb.Duration   `protobuf:"bytes,3,opt,name=max_backorder_wait,json=maxBackorderWait,proto3" json:"max_backorder_wait,omitempty"`
+	CouponCodes       []string               `protobuf:"bytes,4,rep,name=coupon_codes,json=couponCodes,proto3" json:"coupon_codes,omitempty"`
+	ShippingAddress   *v11.Address           `protobuf:"bytes,5,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	BillingAddress    *v11.Address           `protobuf:"bytes,6,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	ShippingAddressId int64                  `protobuf:"varint,7,opt,name=shipping_address_id,json=shippingAddressId,proto3" json:"shipping_address_id,omitempty"` // Same as in orders.v1.CreateOrderRequest.
+	BillingAddressId  int64                  `protobuf:"varint,8,opt,name=billing_address_id,json=billingAddressId,proto3" json:"billing_address_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckoutRequest) Reset() {
+	*x = CheckoutRequest{}
+	mi := &file_carts_v1_carts_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRequest) ProtoMessage() {}
+
+func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckoutRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CheckoutRequest) GetShipTo() *v11.GeoPoint {
+	if x != nil {
+		return x.ShipTo
+	}
+	return nil
+}
+
+func (x *CheckoutRequest) GetMaxBackorderWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackorderWait
+	}
+	return nil
+}
+
+func (x *CheckoutRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *CheckoutRequest) GetShippingAddress() *v11.Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *CheckoutRequest) GetBillingAddress() *v11.Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *CheckoutRequest) GetShippingAddressId() int64 {
+	if x != nil {
+		return x.ShippingAddressId
+	}
+	return 0
+}
+
+func (x *CheckoutRequest) GetBillingAddressId() int64 {
+	if x != nil {
+		return x.BillingAddressId
+	}
+	return 0
+}
+
+// Response for a checkout request. The cart is emptied once the order is placed.
+type CheckoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *v11.Order             `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutResponse) Reset() {
+	*x = CheckoutResponse{}
+	mi := &file_carts_v1_carts_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutResponse) ProtoMessage() {}
+
+func (x *CheckoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_carts_v1_carts_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutResponse) Descriptor() ([]byte, []int) {
+	return file_carts_v1_carts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckoutResponse) GetOrder() *v11.Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+var File_carts_v1_carts_proto protoreflect.FileDescriptor
+
+const file_carts_v1_carts_proto_rawDesc = "" +
+	"\n" +
+	"\x14carts/v1/carts.proto\x12\bcarts.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14money/v1/money.proto\x1a\x16orders/v1/orders.proto\"\xb1\x02\n" +
+	"\x04Cart\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.carts.v1.CartItemR\x05items\x12\x18\n" +
+	"\x05total\x18\x03 \x01(\x01B\x02\x18\x01R\x05total\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x122\n" +
+	"\ftotal_amount\x18\a \x01(\v2\x0f.money.v1.MoneyR\vtotalAmount\"\xc6\x01\n" +
+	"\bCartItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x125\n" +
+	"\badded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12.\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\tunitPrice\"\x88\x01\n" +
+	"\x0eAddItemRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"5\n" +
+	"\x0fAddItemResponse\x12\"\n" +
+	"\x04cart\x18\x01 \x01(\v2\x0e.carts.v1.CartR\x04cart\"S\n" +
+	"\x11RemoveItemRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\"8\n" +
+	"\x12RemoveItemResponse\x12\"\n" +
+	"\x04cart\x18\x01 \x01(\v2\x0e.carts.v1.CartR\x04cart\"s\n" +
+	"\x15UpdateQuantityRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"<\n" +
+	"\x16UpdateQuantityResponse\x12\"\n" +
+	"\x04cart\x18\x01 \x01(\v2\x0e.carts.v1.CartR\x04cart\"1\n" +
+	"\x0eGetCartRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\"5\n" +
+	"\x0fGetCartResponse\x12\"\n" +
+	"\x04cart\x18\x01 \x01(\v2\x0e.carts.v1.CartR\x04cart\"\xa6\x03\n" +
+	"\x0fCheckoutRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12,\n" +
+	"\aship_to\x18\x02 \x01(\v2\x13.orders.v1.GeoPointR\x06shipTo\x12G\n" +
+	"\x12max_backorder_wait\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10maxBackorderWait\x12!\n" +
+	"\fcoupon_codes\x18\x04 \x03(\tR\vcouponCodes\x12=\n" +
+	"\x10shipping_address\x18\x05 \x01(\v2\x12.orders.v1.AddressR\x0fshippingAddress\x12;\n" +
+	"\x0fbilling_address\x18\x06 \x01(\v2\x12.orders.v1.AddressR\x0ebillingAddress\x12.\n" +
+	"\x13shipping_address_id\x18\a \x01(\x03R\x11shippingAddressId\x12,\n" +
+	"\x12billing_address_id\x18\b \x01(\x03R\x10billingAddressId\":\n" +
+	"\x10CheckoutResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.orders.v1.OrderR\x05order2\xee\x02\n" +
+	"\vCartService\x12>\n" +
+	"\aAddItem\x12\x18.carts.v1.AddItemRequest\x1a\x19.carts.v1.AddItemResponse\x12G\n" +
+	"\n" +
+	"RemoveItem\x12\x1b.carts.v1.RemoveItemRequest\x1a\x1c.carts.v1.RemoveItemResponse\x12S\n" +
+	"\x0eUpdateQuantity\x12\x1f.carts.v1.UpdateQuantityRequest\x1a .carts.v1.UpdateQuantityResponse\x12>\n" +
+	"\aGetCart\x12\x18.carts.v1.GetCartRequest\x1a\x19.carts.v1.GetCartResponse\x12A\n" +
+	"\bCheckout\x12\x19.carts.v1.CheckoutRequest\x1a\x1a.carts.v1.CheckoutResponseB\x92\x01\n" +
+	"\fcom.carts.v1B\n" +
+	"CartsProtoP\x01Z5github.com/bufbuild/buf-examples/gen/carts/v1;cartsv1\xa2\x02\x03CXX\xaa\x02\bCarts.V1\xca\x02\bCarts\\V1\xe2\x02\x14Carts\\V1\\GPBMetadata\xea\x02\tCarts::V1b\x06proto3"
+
+var (
+	file_carts_v1_carts_proto_rawDescOnce sync.Once
+	file_carts_v1_carts_proto_rawDescData []byte
+)
+
+func file_carts_v1_carts_proto_rawDescGZIP() []byte {
+	file_carts_v1_carts_proto_rawDescOnce.Do(func() {
+		file_carts_v1_carts_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_carts_v1_carts_proto_rawDesc), len(file_carts_v1_carts_proto_rawDesc)))
+	})
+	return file_carts_v1_carts_proto_rawDescData
+}
+
+var file_carts_v1_carts_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_carts_v1_carts_proto_goTypes = []any{
+	(*Cart)(nil),                   // 0: carts.v1.Cart
+	(*CartItem)(nil),               // 1: carts.v1.CartItem
+	(*AddItemRequest)(nil),         // 2: carts.v1.AddItemRequest
+	(*AddItemResponse)(nil),        // 3: carts.v1.AddItemResponse
+	(*RemoveItemRequest)(nil),      // 4: carts.v1.RemoveItemRequest
+	(*RemoveItemResponse)(nil),     // 5: carts.v1.RemoveItemResponse
+	(*UpdateQuantityRequest)(nil),  // 6: carts.v1.UpdateQuantityRequest
+	(*UpdateQuantityResponse)(nil), // 7: carts.v1.UpdateQuantityResponse
+	(*GetCartRequest)(nil),         // 8: carts.v1.GetCartRequest
+	(*GetCartResponse)(nil),        // 9: carts.v1.GetCartResponse
+	(*CheckoutRequest)(nil),        // 10: carts.v1.CheckoutRequest
+	(*CheckoutResponse)(nil),       // 11: carts.v1.CheckoutResponse
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*v1.Money)(nil),               // 13: money.v1.Money
+	(*v11.GeoPoint)(nil),           // 14: orders.v1.GeoPoint
+	(*durationpb.Duration)(nil),    // 15: google.protobuf.Duration
+	(*v11.Address)(nil),            // 16: orders.v1.Address
+	(*v11.Order)(nil),              // 17: orders.v1.Order
+}
+var file_carts_v1_carts_proto_depIdxs = []int32{
+	1,  // 0: carts.v1.Cart.items:type_name -> carts.v1.CartItem
+	12, // 1: carts.v1.Cart.updated_at:type_name -> google.protobuf.Timestamp
+	12, // 2: carts.v1.Cart.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 3: carts.v1.Cart.total_amount:type_name -> money.v1.Money
+	12, // 4: carts.v1.CartItem.added_at:type_name -> google.protobuf.Timestamp
+	13, // 5: carts.v1.CartItem.unit_price:type_name -> money.v1.Money
+	0,  // 6: carts.v1.AddItemResponse.cart:type_name -> carts.v1.Cart
+	0,  // 7: carts.v1.RemoveItemResponse.cart:type_name -> carts.v1.Cart
+	0,  // 8: carts.v1.UpdateQuantityResponse.cart:type_name -> carts.v1.Cart
+	0,  // 9: carts.v1.GetCartResponse.cart:type_name -> carts.v1.Cart
+	14, // 10: carts.v1.CheckoutRequest.ship_to:type_name -> orders.v1.GeoPoint
+	15, // 11: carts.v1.CheckoutRequest.max_backorder_wait:type_name -> google.protobuf.Duration
+	16, // 12: carts.v1.CheckoutRequest.shipping_address:type_name -> orders.v1.Address
+	16, // 13: carts.v1.CheckoutRequest.billing_address:type_name -> orders.v1.Address
+	17, // 14: carts.v1.CheckoutResponse.order:type_name -> orders.v1.Order
+	2,  // 15: carts.v1.CartService.AddItem:input_type -> carts.v1.AddItemRequest
+	4,  // 16: carts.v1.CartService.RemoveItem:input_type -> carts.v1.RemoveItemRequest
+	6,  // 17: carts.v1.CartService.UpdateQuantity:input_type -> carts.v1.UpdateQuantityRequest
+	8,  // 18: carts.v1.CartService.GetCart:input_type -> carts.v1.GetCartRequest
+	10, // 19: carts.v1.CartService.Checkout:input_type -> carts.v1.CheckoutRequest
+	3,  // 20: carts.v1.CartService.AddItem:output_type -> carts.v1.AddItemResponse
+	5,  // 21: carts.v1.CartService.RemoveItem:output_type -> carts.v1.RemoveItemResponse
+	7,  // 22: carts.v1.CartService.UpdateQuantity:output_type -> carts.v1.UpdateQuantityResponse
+	9,  // 23: carts.v1.CartService.GetCart:output_type -> carts.v1.GetCartResponse
+	11, // 24: carts.v1.CartService.Checkout:output_type -> carts.v1.CheckoutResponse
+	20, // [20:25] is the sub-list for method output_type
+	15, // [15:20] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_carts_v1_carts_proto_init() }
+func file_carts_v1_carts_proto_init() {
+	if File_carts_v1_carts_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_carts_v1_carts_proto_rawDesc), len(file_carts_v1_carts_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_carts_v1_carts_proto_goTypes,
+		DependencyIndexes: file_carts_v1_carts_proto_depIdxs,
+		MessageInfos:      file_carts_v1_carts_proto_msgTypes,
+	}.Build()
+	File_carts_v1_carts_proto = out.File
+	file_carts_v1_carts_proto_goTypes = nil
+	file_carts_v1_carts_proto_depIdxs = nil
+}
@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: carts/v1/carts.proto
+
+package cartsv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/carts/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CartServiceName is the fully-qualified name of the CartService service.
+	CartServiceName = "carts.v1.CartService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CartServiceAddItemProcedure is the fully-qualified name of the CartService's AddItem RPC.
+	CartServiceAddItemProcedure = "/carts.v1.CartService/AddItem"
+	// CartServiceRemoveItemProcedure is the fully-qualified name of the CartService's RemoveItem RPC.
+	CartServiceRemoveItemProcedure = "/carts.v1.CartService/RemoveItem"
+	// CartServiceUpdateQuantityProcedure is the fully-qualified name of the CartService's
+	// UpdateQuantity RPC.
+	CartServiceUpdateQuantityProcedure = "/carts.v1.CartService/UpdateQuantity"
+	// CartServiceGetCartProcedure is the fully-qualified name of the CartService's GetCart RPC.
+	CartServiceGetCartProcedure = "/carts.v1.CartService/GetCart"
+	// CartServiceCheckoutProcedure is the fully-qualified name of the CartService's Checkout RPC.
+	CartServiceCheckoutProcedure = "/carts.v1.CartService/Checkout"
+)
+
+// CartServiceClient is a client for the carts.v1.CartService service.
+type CartServiceClient interface {
+	// Adds a product to the cart, or adds to its quantity when it is already there.
+	AddItem(context.Context, *connect.Request[v1.AddItemRequest]) (*connect.Response[v1.AddItemResponse], error)
+	// Removes a product from the cart.
+	RemoveItem(context.Context, *connect.Request[v1.RemoveItemRequest]) (*connect.Response[v1.RemoveItemResponse], error)
+	// Sets the quantity of a product in the cart; zero removes it.
+	UpdateQuantity(context.Context, *connect.Request[v1.UpdateQuantityRequest]) (*connect.Response[v1.UpdateQuantityResponse], error)
+	// Retrieves the cart of a customer.
+	GetCart(context.Context, *connect.Request[v1.GetCartRequest]) (*connect.Response[v1.GetCartResponse], error)
+	// Checks the cart against current prices and stock and places it as an order.
+	Checkout(context.Context, *connect.Request[v1.CheckoutRequest]) (*connect.Response[v1.CheckoutResponse], error)
+}
+
+// NewCartServiceClient constructs a client for the carts.v1.CartService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCartServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CartServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	cartServiceMethods := v1.File_carts_v1_carts_proto.Services().ByName("CartService").Methods()
+	return &cartServiceClient{
+		addItem: connect.NewClient[v1.AddItemRequest, v1.AddItemResponse](
+			httpClient,
+			baseURL+CartServiceAddItemProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("AddItem")),
+			connect.WithClientOptions(opts...),
+		),
+		removeItem: connect.NewClient[v1.RemoveItemRequest, v1.RemoveItemResponse](
+			httpClient,
+			baseURL+CartServiceRemoveItemProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("RemoveItem")),
+			connect.WithClientOptions(opts...),
+		),
+		updateQuantity: connect.NewClient[v1.UpdateQuantityRequest, v1.UpdateQuantityResponse](
+			httpClient,
+			baseURL+CartServiceUpdateQuantityProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("UpdateQuantity")),
+			connect.WithClientOptions(opts...),
+		),
+		getCart: connect.NewClient[v1.GetCartRequest, v1.GetCartResponse](
+			httpClient,
+			baseURL+CartServiceGetCartProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("GetCart")),
+			connect.WithClientOptions(opts...),
+		),
+		checkout: connect.NewClient[v1.CheckoutRequest, v1.CheckoutResponse](
+			httpClient,
+			baseURL+CartServiceCheckoutProcedure,
+			connect.WithSchema(cartServiceMethods.ByName("Checkout")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// cartServiceClient implements CartServiceClient.
+type cartServiceClient struct {
+	addItem        *connect.Client[v1.AddItemRequest, v1.AddItemResponse]
+	removeItem     *connect.Client[v1.RemoveItemRequest, v1.RemoveItemResponse]
+	updateQuantity *connect.Client[v1.UpdateQuantityRequest, v1.UpdateQuantityResponse]
+	getCart        *connect.Client[v1.GetCartRequest, v1.GetCartResponse]
+	checkout       *connect.Client[v1.CheckoutRequest, v1.CheckoutResponse]
+}
+
+// AddItem calls carts.v1.CartService.AddItem.
+func (c *cartServiceClient) AddItem(ctx context.Context, req *connect.Request[v1.AddItemRequest]) (*connect.Response[v1.AddItemResponse], error) {
+	return c.addItem.CallUnary(ctx, req)
+}
+
+// RemoveItem calls carts.v1.CartService.RemoveItem.
+func (c *cartServiceClient) RemoveItem(ctx context.Context, req *connect.Request[v1.RemoveItemRequest]) (*connect.Response[v1.RemoveItemResponse], error) {
+	return c.removeItem.CallUnary(ctx, req)
+}
+
+// UpdateQuantity calls carts.v1.CartService.UpdateQuantity.
+func (c *cartServiceClient) UpdateQuantity(ctx context.Context, req *connect.Request[v1.UpdateQuantityRequest]) (*connect.Response[v1.UpdateQuantityResponse], error) {
+	return c.updateQuantity.CallUnary(ctx, req)
+}
+
+// GetCart calls carts.v1.CartService.GetCart.
+func (c *cartServiceClient) GetCart(ctx context.Context, req *connect.Request[v1.GetCartRequest]) (*connect.Response[v1.GetCartResponse], error) {
+	return c.getCart.CallUnary(ctx, req)
+}
+
+// Checkout calls carts.v1.CartService.Checkout.
+func (c *cartServiceClient) Checkout(ctx context.Context, req *connect.Request[v1.CheckoutRequest]) (*connect.Response[v1.CheckoutResponse], error) {
+	return c.checkout.CallUnary(ctx, req)
+}
+
+// CartServiceHandler is an implementation of the carts.v1.CartService service.
+type CartServiceHandler interface {
+	// Adds a product to the cart, or adds to its quantity when it is already there.
+	AddItem(context.Context, *connect.Request[v1.AddItemRequest]) (*connect.Response[v1.AddItemResponse], error)
+	// Removes a product from the cart.
+	RemoveItem(context.Context, *connect.Request[v1.RemoveItemRequest]) (*connect.Response[v1.RemoveItemResponse], error)
+	// Sets the quantity of a product in the cart; zero removes it.
+	UpdateQuantity(context.Context, *connect.Request[v1.UpdateQuantityRequest]) (*connect.Response[v1.UpdateQuantityResponse], error)
+	// Retrieves the cart of a customer.
+	GetCart(context.Context, *connect.Request[v1.GetCartRequest]) (*connect.Response[v1.GetCartResponse], error)
+	// Checks the cart against current prices and stock and places it as an order.
+	Checkout(context.Context, *connect.Request[v1.CheckoutRequest]) (*connect.Response[v1.CheckoutResponse], error)
+}
+
+// NewCartServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCartServiceHandler(svc CartServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	cartServiceMethods := v1.File_carts_v1_carts_proto.Services().ByName("CartService").Methods()
+	cartServiceAddItemHandler := connect.NewUnaryHandler(
+		CartServiceAddItemProcedure,
+		svc.AddItem,
+		connect.WithSchema(cartServiceMethods.ByName("AddItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceRemoveItemHandler := connect.NewUnaryHandler(
+		CartServiceRemoveItemProcedure,
+		svc.RemoveItem,
+		connect.WithSchema(cartServiceMethods.ByName("RemoveItem")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceUpdateQuantityHandler := connect.NewUnaryHandler(
+		CartServiceUpdateQuantityProcedure,
+		svc.UpdateQuantity,
+		connect.WithSchema(cartServiceMethods.ByName("UpdateQuantity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceGetCartHandler := connect.NewUnaryHandler(
+		CartServiceGetCartProcedure,
+		svc.GetCart,
+		connect.WithSchema(cartServiceMethods.ByName("GetCart")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cartServiceCheckoutHandler := connect.NewUnaryHandler(
+		CartServiceCheckoutProcedure,
+		svc.Checkout,
+		connect.WithSchema(cartServiceMethods.ByName("Checkout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/carts.v1.CartService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CartServiceAddItemProcedure:
+			cartServiceAddItemHandler.ServeHTTP(w, r)
+		case CartServiceRemoveItemProcedure:
+			cartServiceRemoveItemHandler.ServeHTTP(w, r)
+		case CartServiceUpdateQuantityProcedure:
+			cartServiceUpdateQuantityHandler.ServeHTTP(w, r)
+		case CartServiceGetCartProcedure:
+			cartServiceGetCartHandler.ServeHTTP(w, r)
+		case CartServiceCheckoutProcedure:
+			cartServiceCheckoutHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCartServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCartServiceHandler struct{}
+
+func (UnimplementedCartServiceHandler) AddItem(context.Context, *connect.Request[v1.AddItemRequest]) (*connect.Response[v1.AddItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("carts.v1.CartService.AddItem is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) RemoveItem(context.Context, *connect.Request[v1.RemoveItemRequest]) (*connect.Response[v1.RemoveItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("carts.v1.CartService.RemoveItem is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) UpdateQuantity(context.Context, *connect.Request[v1.UpdateQuantityRequest]) (*connect.Response[v1.UpdateQuantityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("carts.v1.CartService.UpdateQuantity is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) GetCart(context.Context, *connect.Request[v1.GetCartRequest]) (*connect.Response[v1.GetCartResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("carts.v1.CartService.GetCart is not implemented"))
+}
+
+func (UnimplementedCartServiceHandler) Checkout(context.Context, *connect.Request[v1.CheckoutRequest]) (*connect.Response[v1.CheckoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("carts.v1.CartService.Checkout is not implemented"))
+}
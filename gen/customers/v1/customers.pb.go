@@ -7,6 +7,7 @@
 package customersv1
 
 import (
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -22,6 +23,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ErasureStatus int32
+
+const (
+	ErasureStatus_ERASURE_STATUS_UNSPECIFIED ErasureStatus = 0
+	ErasureStatus_ERASURE_STATUS_PENDING     ErasureStatus = 1 // Waiting out the grace period.
+	ErasureStatus_ERASURE_STATUS_CANCELLED   ErasureStatus = 2
+	ErasureStatus_ERASURE_STATUS_COMPLETED   ErasureStatus = 3
+	ErasureStatus_ERASURE_STATUS_REJECTED    ErasureStatus = 4 // The customer placed an order during the grace period.
+)
+
+// Enum value maps for ErasureStatus.
+var (
+	ErasureStatus_name = map[int32]string{
+		0: "ERASURE_STATUS_UNSPECIFIED",
+		1: "ERASURE_STATUS_PENDING",
+		2: "ERASURE_STATUS_CANCELLED",
+		3: "ERASURE_STATUS_COMPLETED",
+		4: "ERASURE_STATUS_REJECTED",
+	}
+	ErasureStatus_value = map[string]int32{
+		"ERASURE_STATUS_UNSPECIFIED": 0,
+		"ERASURE_STATUS_PENDING":     1,
+		"ERASURE_STATUS_CANCELLED":   2,
+		"ERASURE_STATUS_COMPLETED":   3,
+		"ERASURE_STATUS_REJECTED":    4,
+	}
+)
+
+func (x ErasureStatus) Enum() *ErasureStatus {
+	p := new(ErasureStatus)
+	*p = x
+	return p
+}
+
+func (x ErasureStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErasureStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_customers_v1_customers_proto_enumTypes[0].Descriptor()
+}
+
+func (ErasureStatus) Type() protoreflect.EnumType {
+	return &file_customers_v1_customers_proto_enumTypes[0]
+}
+
+func (x ErasureStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErasureStatus.Descriptor instead.
+func (ErasureStatus) EnumDescriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportStatus int32
+
+const (
+	ExportStatus_EXPORT_STATUS_UNSPECIFIED ExportStatus = 0
+	ExportStatus_EXPORT_STATUS_RUNNING     ExportStatus = 1
+	ExportStatus_EXPORT_STATUS_COMPLETED   ExportStatus = 2
+	ExportStatus_EXPORT_STATUS_FAILED      ExportStatus = 3
+)
+
+// Enum value maps for ExportStatus.
+var (
+	ExportStatus_name = map[int32]string{
+		0: "EXPORT_STATUS_UNSPECIFIED",
+		1: "EXPORT_STATUS_RUNNING",
+		2: "EXPORT_STATUS_COMPLETED",
+		3: "EXPORT_STATUS_FAILED",
+	}
+	ExportStatus_value = map[string]int32{
+		"EXPORT_STATUS_UNSPECIFIED": 0,
+		"EXPORT_STATUS_RUNNING":     1,
+		"EXPORT_STATUS_COMPLETED":   2,
+		"EXPORT_STATUS_FAILED":      3,
+	}
+)
+
+func (x ExportStatus) Enum() *ExportStatus {
+	p := new(ExportStatus)
+	*p = x
+	return p
+}
+
+func (x ExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_customers_v1_customers_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportStatus) Type() protoreflect.EnumType {
+	return &file_customers_v1_customers_proto_enumTypes[1]
+}
+
+func (x ExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportStatus.Descriptor instead.
+func (ExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{1}
+}
+
 type CreateCustomerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -89,6 +197,7 @@ type Customer struct {
 	AliasName     string                 `protobuf:"bytes,3,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
 	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Set while the customer is deleted and can still be restored.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,6 +267,13 @@ func (x *Customer) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Customer) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 type CreateCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
@@ -203,10 +319,11 @@ func (x *CreateCustomerResponse) GetCustomer() *Customer {
 }
 
 type GetCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // Also return the customer during the grace period of their erasure.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetCustomerRequest) Reset() {
@@ -246,6 +363,13 @@ func (x *GetCustomerRequest) GetId() int64 {
 	return 0
 }
 
+func (x *GetCustomerRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
 type GetCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
@@ -290,9 +414,11 @@ func (x *GetCustomerResponse) GetCustomer() *Customer {
 	return nil
 }
 
+// Deleting a customer schedules their erasure, which can be cancelled until erase_after.
 type DeleteCustomerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Kept on the tombstone, e.g. a support ticket; must not contain personal data.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -334,9 +460,17 @@ func (x *DeleteCustomerRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteCustomerRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type DeleteCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Erasure       *CustomerErasure       `protobuf:"bytes,2,opt,name=erasure,proto3" json:"erasure,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -378,38 +512,1619 @@ func (x *DeleteCustomerResponse) GetSuccess() bool {
 	return false
 }
 
-var File_customers_v1_customers_proto protoreflect.FileDescriptor
+func (x *DeleteCustomerResponse) GetErasure() *CustomerErasure {
+	if x != nil {
+		return x.Erasure
+	}
+	return nil
+}
 
-const file_customers_v1_customers_proto_rawDesc = "" +
-	"\n" +
-	"\x1ccustomers/v1/customers.proto\x12\fcustomers.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"h\n" +
-	"\x15CreateCustomerRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1d\n" +
-	"\n" +
-	"alias_name\x18\x02 \x01(\tR\taliasName\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"\xa6\x01\n" +
-	"\bCustomer\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
-	"\n" +
-	"alias_name\x18\x03 \x01(\tR\taliasName\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"L\n" +
-	"\x16CreateCustomerResponse\x122\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x16.customers.v1.CustomerR\bcustomer\"$\n" +
-	"\x12GetCustomerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"I\n" +
-	"\x13GetCustomerResponse\x122\n" +
-	"\bcustomer\x18\x01 \x01(\v2\x16.customers.v1.CustomerR\bcustomer\"'\n" +
-	"\x15DeleteCustomerRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
-	"\x16DeleteCustomerResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa0\x02\n" +
-	"\x10CustomersService\x12[\n" +
-	"\x0eCreateCustomer\x12#.customers.v1.CreateCustomerRequest\x1a$.customers.v1.CreateCustomerResponse\x12R\n" +
-	"\vGetCustomer\x12 .customers.v1.GetCustomerRequest\x1a!.customers.v1.GetCustomerResponse\x12[\n" +
-	"\x0eDeleteCustomer\x12#.customers.v1.DeleteCustomerRequest\x1a$.customers.v1.DeleteCustomerResponseB\xb2\x01\n" +
+// The erasure of a customer's personal data. Kept as a tombstone once the data is gone.
+type CustomerErasure struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId       int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status           ErasureStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=customers.v1.ErasureStatus" json:"status,omitempty"`
+	Reason           string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	EraseAfter       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=erase_after,json=eraseAfter,proto3" json:"erase_after,omitempty"` // End of the grace period.
+	CompletedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	OrdersAnonymized int32                  `protobuf:"varint,7,opt,name=orders_anonymized,json=ordersAnonymized,proto3" json:"orders_anonymized,omitempty"`
+	Detail           string                 `protobuf:"bytes,8,opt,name=detail,proto3" json:"detail,omitempty"` // Why the erasure was rejected.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CustomerErasure) Reset() {
+	*x = CustomerErasure{}
+	mi := &file_customers_v1_customers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerErasure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerErasure) ProtoMessage() {}
+
+func (x *CustomerErasure) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerErasure.ProtoReflect.Descriptor instead.
+func (*CustomerErasure) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CustomerErasure) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CustomerErasure) GetStatus() ErasureStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ErasureStatus_ERASURE_STATUS_UNSPECIFIED
+}
+
+func (x *CustomerErasure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CustomerErasure) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *CustomerErasure) GetEraseAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EraseAfter
+	}
+	return nil
+}
+
+func (x *CustomerErasure) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CustomerErasure) GetOrdersAnonymized() int32 {
+	if x != nil {
+		return x.OrdersAnonymized
+	}
+	return 0
+}
+
+func (x *CustomerErasure) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type CancelCustomerDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCustomerDeletionRequest) Reset() {
+	*x = CancelCustomerDeletionRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCustomerDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCustomerDeletionRequest) ProtoMessage() {}
+
+func (x *CancelCustomerDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCustomerDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelCustomerDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelCustomerDeletionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelCustomerDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Erasure       *CustomerErasure       `protobuf:"bytes,1,opt,name=erasure,proto3" json:"erasure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCustomerDeletionResponse) Reset() {
+	*x = CancelCustomerDeletionResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCustomerDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCustomerDeletionResponse) ProtoMessage() {}
+
+func (x *CancelCustomerDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCustomerDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelCustomerDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelCustomerDeletionResponse) GetErasure() *CustomerErasure {
+	if x != nil {
+		return x.Erasure
+	}
+	return nil
+}
+
+// Restoring a customer cancels their erasure during its grace period.
+type RestoreCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCustomerRequest) Reset() {
+	*x = RestoreCustomerRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCustomerRequest) ProtoMessage() {}
+
+func (x *RestoreCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCustomerRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreCustomerRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Erasure       *CustomerErasure       `protobuf:"bytes,2,opt,name=erasure,proto3" json:"erasure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCustomerResponse) Reset() {
+	*x = RestoreCustomerResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCustomerResponse) ProtoMessage() {}
+
+func (x *RestoreCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCustomerResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *RestoreCustomerResponse) GetErasure() *CustomerErasure {
+	if x != nil {
+		return x.Erasure
+	}
+	return nil
+}
+
+type GetCustomerErasureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerErasureRequest) Reset() {
+	*x = GetCustomerErasureRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerErasureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerErasureRequest) ProtoMessage() {}
+
+func (x *GetCustomerErasureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerErasureRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerErasureRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCustomerErasureRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCustomerErasureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Erasure       *CustomerErasure       `protobuf:"bytes,1,opt,name=erasure,proto3" json:"erasure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerErasureResponse) Reset() {
+	*x = GetCustomerErasureResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerErasureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerErasureResponse) ProtoMessage() {}
+
+func (x *GetCustomerErasureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerErasureResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerErasureResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCustomerErasureResponse) GetErasure() *CustomerErasure {
+	if x != nil {
+		return x.Erasure
+	}
+	return nil
+}
+
+// An entry in the address book of a customer.
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"` // e.g. Home or Work.
+	Recipient     string                 `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Line1         string                 `protobuf:"bytes,5,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,6,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,8,opt,name=region,proto3" json:"region,omitempty"` // State or province code, e.g. CA; required where the country has regions.
+	PostalCode    string                 `protobuf:"bytes,9,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2 code, e.g. US.
+	Phone         string                 `protobuf:"bytes,11,opt,name=phone,proto3" json:"phone,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,12,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // Orders ship to the default address when they name none.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_customers_v1_customers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Address) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Address) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *Address) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Address) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Address) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AddAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                             // customer_id is required; id, is_default and timestamps are ignored.
+	MakeDefault   bool                   `protobuf:"varint,2,opt,name=make_default,json=makeDefault,proto3" json:"make_default,omitempty"` // The first address of a customer is always the default.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAddressRequest) Reset() {
+	*x = AddAddressRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAddressRequest) ProtoMessage() {}
+
+func (x *AddAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *AddAddressRequest) GetMakeDefault() bool {
+	if x != nil {
+		return x.MakeDefault
+	}
+	return false
+}
+
+type AddAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAddressResponse) Reset() {
+	*x = AddAddressResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAddressResponse) ProtoMessage() {}
+
+func (x *AddAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAddressResponse.ProtoReflect.Descriptor instead.
+func (*AddAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UpdateAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // Replaces the address with the same customer_id and id.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressRequest) Reset() {
+	*x = UpdateAddressRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressRequest) ProtoMessage() {}
+
+func (x *UpdateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UpdateAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAddressResponse) Reset() {
+	*x = UpdateAddressResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressResponse) ProtoMessage() {}
+
+func (x *UpdateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type DeleteAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressRequest) Reset() {
+	*x = DeleteAddressRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressRequest) ProtoMessage() {}
+
+func (x *DeleteAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteAddressRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *DeleteAddressRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+type DeleteAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressResponse) Reset() {
+	*x = DeleteAddressResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressResponse) ProtoMessage() {}
+
+func (x *DeleteAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteAddressResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesRequest) Reset() {
+	*x = ListAddressesRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesRequest) ProtoMessage() {}
+
+func (x *ListAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListAddressesRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+type ListAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*Address             `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressesResponse) Reset() {
+	*x = ListAddressesResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesResponse) ProtoMessage() {}
+
+func (x *ListAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAddressesResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type SetDefaultAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultAddressRequest) Reset() {
+	*x = SetDefaultAddressRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultAddressRequest) ProtoMessage() {}
+
+func (x *SetDefaultAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultAddressRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultAddressRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetDefaultAddressRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *SetDefaultAddressRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+type SetDefaultAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultAddressResponse) Reset() {
+	*x = SetDefaultAddressResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultAddressResponse) ProtoMessage() {}
+
+func (x *SetDefaultAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultAddressResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultAddressResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetDefaultAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+// A file of a customer data export.
+type ExportFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // e.g. customer-data.json.
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // json or csv.
+	Handle        string                 `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"` // Where to download the file from, e.g. file:///var/exports/1/2/customer-data.json.
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFile) Reset() {
+	*x = ExportFile{}
+	mi := &file_customers_v1_customers_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFile) ProtoMessage() {}
+
+func (x *ExportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFile.ProtoReflect.Descriptor instead.
+func (*ExportFile) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExportFile) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportFile) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *ExportFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ExportFile) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// An export of the data held about a customer, kept as an audit record.
+type CustomerDataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExportId      int64                  `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        ExportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=customers.v1.ExportStatus" json:"status,omitempty"`
+	IncludeCsv    bool                   `protobuf:"varint,4,opt,name=include_csv,json=includeCsv,proto3" json:"include_csv,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // Subject of the caller.
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Files         []*ExportFile          `protobuf:"bytes,8,rep,name=files,proto3" json:"files,omitempty"` // Set once completed.
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"` // Why the export failed.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerDataExport) Reset() {
+	*x = CustomerDataExport{}
+	mi := &file_customers_v1_customers_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerDataExport) ProtoMessage() {}
+
+func (x *CustomerDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerDataExport.ProtoReflect.Descriptor instead.
+func (*CustomerDataExport) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CustomerDataExport) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+func (x *CustomerDataExport) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CustomerDataExport) GetStatus() ExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ExportStatus_EXPORT_STATUS_UNSPECIFIED
+}
+
+func (x *CustomerDataExport) GetIncludeCsv() bool {
+	if x != nil {
+		return x.IncludeCsv
+	}
+	return false
+}
+
+func (x *CustomerDataExport) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CustomerDataExport) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *CustomerDataExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *CustomerDataExport) GetFiles() []*ExportFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *CustomerDataExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// The data held about a customer, as written to customer-data.json.
+type CustomerData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=customer,proto3" json:"customer,omitempty"`
+	Addresses     []*Address             `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Orders        []*v1.Order            `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty"` // Oldest first, with their items.
+	ExportedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=exported_at,json=exportedAt,proto3" json:"exported_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerData) Reset() {
+	*x = CustomerData{}
+	mi := &file_customers_v1_customers_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerData) ProtoMessage() {}
+
+func (x *CustomerData) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerData.ProtoReflect.Descriptor instead.
+func (*CustomerData) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CustomerData) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CustomerData) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *CustomerData) GetOrders() []*v1.Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *CustomerData) GetExportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return nil
+}
+
+type ExportCustomerDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	IncludeCsv    bool                   `protobuf:"varint,2,opt,name=include_csv,json=includeCsv,proto3" json:"include_csv,omitempty"` // Also write the addresses and orders as CSV files.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCustomerDataRequest) Reset() {
+	*x = ExportCustomerDataRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCustomerDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCustomerDataRequest) ProtoMessage() {}
+
+func (x *ExportCustomerDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCustomerDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportCustomerDataRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportCustomerDataRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ExportCustomerDataRequest) GetIncludeCsv() bool {
+	if x != nil {
+		return x.IncludeCsv
+	}
+	return false
+}
+
+type ExportCustomerDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *CustomerDataExport    `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"` // Running; poll GetCustomerDataExport until it completes.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCustomerDataResponse) Reset() {
+	*x = ExportCustomerDataResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCustomerDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCustomerDataResponse) ProtoMessage() {}
+
+func (x *ExportCustomerDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCustomerDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportCustomerDataResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ExportCustomerDataResponse) GetExport() *CustomerDataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetCustomerDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ExportId      int64                  `protobuf:"varint,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerDataExportRequest) Reset() {
+	*x = GetCustomerDataExportRequest{}
+	mi := &file_customers_v1_customers_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerDataExportRequest) ProtoMessage() {}
+
+func (x *GetCustomerDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCustomerDataExportRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *GetCustomerDataExportRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type GetCustomerDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *CustomerDataExport    `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerDataExportResponse) Reset() {
+	*x = GetCustomerDataExportResponse{}
+	mi := &file_customers_v1_customers_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerDataExportResponse) ProtoMessage() {}
+
+func (x *GetCustomerDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_customers_v1_customers_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_customers_v1_customers_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCustomerDataExportResponse) GetExport() *CustomerDataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+var File_customers_v1_customers_proto protoreflect.FileDescriptor
+
+const file_customers_v1_customers_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccustomers/v1/customers.proto\x12\fcustomers.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16orders/v1/orders.proto\"h\n" +
+	"\x15CreateCustomerRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"alias_name\x18\x02 \x01(\tR\taliasName\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\"\xe1\x01\n" +
+	"\bCustomer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"alias_name\x18\x03 \x01(\tR\taliasName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"L\n" +
+	"\x16CreateCustomerResponse\x122\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x16.customers.v1.CustomerR\bcustomer\"M\n" +
+	"\x12GetCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"I\n" +
+	"\x13GetCustomerResponse\x122\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x16.customers.v1.CustomerR\bcustomer\"?\n" +
+	"\x15DeleteCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"k\n" +
+	"\x16DeleteCustomerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x127\n" +
+	"\aerasure\x18\x02 \x01(\v2\x1d.customers.v1.CustomerErasureR\aerasure\"\xff\x02\n" +
+	"\x0fCustomerErasure\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x123\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1b.customers.v1.ErasureStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12=\n" +
+	"\frequested_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12;\n" +
+	"\verase_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"eraseAfter\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12+\n" +
+	"\x11orders_anonymized\x18\a \x01(\x05R\x10ordersAnonymized\x12\x16\n" +
+	"\x06detail\x18\b \x01(\tR\x06detail\"/\n" +
+	"\x1dCancelCustomerDeletionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Y\n" +
+	"\x1eCancelCustomerDeletionResponse\x127\n" +
+	"\aerasure\x18\x01 \x01(\v2\x1d.customers.v1.CustomerErasureR\aerasure\"(\n" +
+	"\x16RestoreCustomerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x86\x01\n" +
+	"\x17RestoreCustomerResponse\x122\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x16.customers.v1.CustomerR\bcustomer\x127\n" +
+	"\aerasure\x18\x02 \x01(\v2\x1d.customers.v1.CustomerErasureR\aerasure\"+\n" +
+	"\x19GetCustomerErasureRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"U\n" +
+	"\x1aGetCustomerErasureResponse\x127\n" +
+	"\aerasure\x18\x01 \x01(\v2\x1d.customers.v1.CustomerErasureR\aerasure\"\xac\x03\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x1c\n" +
+	"\trecipient\x18\x04 \x01(\tR\trecipient\x12\x14\n" +
+	"\x05line1\x18\x05 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x06 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\a \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\b \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\t \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x14\n" +
+	"\x05phone\x18\v \x01(\tR\x05phone\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\f \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"g\n" +
+	"\x11AddAddressRequest\x12/\n" +
+	"\aaddress\x18\x01 \x01(\v2\x15.customers.v1.AddressR\aaddress\x12!\n" +
+	"\fmake_default\x18\x02 \x01(\bR\vmakeDefault\"E\n" +
+	"\x12AddAddressResponse\x12/\n" +
+	"\aaddress\x18\x01 \x01(\v2\x15.customers.v1.AddressR\aaddress\"G\n" +
+	"\x14UpdateAddressRequest\x12/\n" +
+	"\aaddress\x18\x01 \x01(\v2\x15.customers.v1.AddressR\aaddress\"H\n" +
+	"\x15UpdateAddressResponse\x12/\n" +
+	"\aaddress\x18\x01 \x01(\v2\x15.customers.v1.AddressR\aaddress\"V\n" +
+	"\x14DeleteAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"1\n" +
+	"\x15DeleteAddressResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\x14ListAddressesRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\"L\n" +
+	"\x15ListAddressesResponse\x123\n" +
+	"\taddresses\x18\x01 \x03(\v2\x15.customers.v1.AddressR\taddresses\"Z\n" +
+	"\x18SetDefaultAddressRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\"L\n" +
+	"\x19SetDefaultAddressResponse\x12/\n" +
+	"\aaddress\x18\x01 \x01(\v2\x15.customers.v1.AddressR\aaddress\"\x87\x01\n" +
+	"\n" +
+	"ExportFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06handle\x18\x03 \x01(\tR\x06handle\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\"\x8e\x03\n" +
+	"\x12CustomerDataExport\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\x03R\bexportId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x122\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.customers.v1.ExportStatusR\x06status\x12\x1f\n" +
+	"\vinclude_csv\x18\x04 \x01(\bR\n" +
+	"includeCsv\x12!\n" +
+	"\frequested_by\x18\x05 \x01(\tR\vrequestedBy\x12=\n" +
+	"\frequested_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12.\n" +
+	"\x05files\x18\b \x03(\v2\x18.customers.v1.ExportFileR\x05files\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\xde\x01\n" +
+	"\fCustomerData\x122\n" +
+	"\bcustomer\x18\x01 \x01(\v2\x16.customers.v1.CustomerR\bcustomer\x123\n" +
+	"\taddresses\x18\x02 \x03(\v2\x15.customers.v1.AddressR\taddresses\x12(\n" +
+	"\x06orders\x18\x03 \x03(\v2\x10.orders.v1.OrderR\x06orders\x12;\n" +
+	"\vexported_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"exportedAt\"]\n" +
+	"\x19ExportCustomerDataRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1f\n" +
+	"\vinclude_csv\x18\x02 \x01(\bR\n" +
+	"includeCsv\"V\n" +
+	"\x1aExportCustomerDataResponse\x128\n" +
+	"\x06export\x18\x01 \x01(\v2 .customers.v1.CustomerDataExportR\x06export\"\\\n" +
+	"\x1cGetCustomerDataExportRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\x03R\bexportId\"Y\n" +
+	"\x1dGetCustomerDataExportResponse\x128\n" +
+	"\x06export\x18\x01 \x01(\v2 .customers.v1.CustomerDataExportR\x06export*\xa4\x01\n" +
+	"\rErasureStatus\x12\x1e\n" +
+	"\x1aERASURE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ERASURE_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18ERASURE_STATUS_CANCELLED\x10\x02\x12\x1c\n" +
+	"\x18ERASURE_STATUS_COMPLETED\x10\x03\x12\x1b\n" +
+	"\x17ERASURE_STATUS_REJECTED\x10\x04*\x7f\n" +
+	"\fExportStatus\x12\x1d\n" +
+	"\x19EXPORT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15EXPORT_STATUS_RUNNING\x10\x01\x12\x1b\n" +
+	"\x17EXPORT_STATUS_COMPLETED\x10\x02\x12\x18\n" +
+	"\x14EXPORT_STATUS_FAILED\x10\x032\x83\n" +
+	"\n" +
+	"\x10CustomersService\x12[\n" +
+	"\x0eCreateCustomer\x12#.customers.v1.CreateCustomerRequest\x1a$.customers.v1.CreateCustomerResponse\x12R\n" +
+	"\vGetCustomer\x12 .customers.v1.GetCustomerRequest\x1a!.customers.v1.GetCustomerResponse\x12[\n" +
+	"\x0eDeleteCustomer\x12#.customers.v1.DeleteCustomerRequest\x1a$.customers.v1.DeleteCustomerResponse\x12x\n" +
+	"\x16CancelCustomerDeletion\x12+.customers.v1.CancelCustomerDeletionRequest\x1a,.customers.v1.CancelCustomerDeletionResponse\"\x03\x88\x02\x01\x12^\n" +
+	"\x0fRestoreCustomer\x12$.customers.v1.RestoreCustomerRequest\x1a%.customers.v1.RestoreCustomerResponse\x12g\n" +
+	"\x12GetCustomerErasure\x12'.customers.v1.GetCustomerErasureRequest\x1a(.customers.v1.GetCustomerErasureResponse\x12g\n" +
+	"\x12ExportCustomerData\x12'.customers.v1.ExportCustomerDataRequest\x1a(.customers.v1.ExportCustomerDataResponse\x12p\n" +
+	"\x15GetCustomerDataExport\x12*.customers.v1.GetCustomerDataExportRequest\x1a+.customers.v1.GetCustomerDataExportResponse\x12O\n" +
+	"\n" +
+	"AddAddress\x12\x1f.customers.v1.AddAddressRequest\x1a .customers.v1.AddAddressResponse\x12X\n" +
+	"\rUpdateAddress\x12\".customers.v1.UpdateAddressRequest\x1a#.customers.v1.UpdateAddressResponse\x12X\n" +
+	"\rDeleteAddress\x12\".customers.v1.DeleteAddressRequest\x1a#.customers.v1.DeleteAddressResponse\x12X\n" +
+	"\rListAddresses\x12\".customers.v1.ListAddressesRequest\x1a#.customers.v1.ListAddressesResponse\x12d\n" +
+	"\x11SetDefaultAddress\x12&.customers.v1.SetDefaultAddressRequest\x1a'.customers.v1.SetDefaultAddressResponseB\xb2\x01\n" +
 	"\x10com.customers.v1B\x0eCustomersProtoP\x01Z=github.com/bufbuild/buf-examples/gen/customers/v1;customersv1\xa2\x02\x03CXX\xaa\x02\fCustomers.V1\xca\x02\fCustomers\\V1\xe2\x02\x18Customers\\V1\\GPBMetadata\xea\x02\rCustomers::V1b\x06proto3"
 
 var (
@@ -424,32 +2139,109 @@ func file_customers_v1_customers_proto_rawDescGZIP() []byte {
 	return file_customers_v1_customers_proto_rawDescData
 }
 
-var file_customers_v1_customers_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_customers_v1_customers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_customers_v1_customers_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_customers_v1_customers_proto_goTypes = []any{
-	(*CreateCustomerRequest)(nil),  // 0: customers.v1.CreateCustomerRequest
-	(*Customer)(nil),               // 1: customers.v1.Customer
-	(*CreateCustomerResponse)(nil), // 2: customers.v1.CreateCustomerResponse
-	(*GetCustomerRequest)(nil),     // 3: customers.v1.GetCustomerRequest
-	(*GetCustomerResponse)(nil),    // 4: customers.v1.GetCustomerResponse
-	(*DeleteCustomerRequest)(nil),  // 5: customers.v1.DeleteCustomerRequest
-	(*DeleteCustomerResponse)(nil), // 6: customers.v1.DeleteCustomerResponse
-	(*timestamppb.Timestamp)(nil),  // 7: google.protobuf.Timestamp
+	(ErasureStatus)(0),                     // 0: customers.v1.ErasureStatus
+	(ExportStatus)(0),                      // 1: customers.v1.ExportStatus
+	(*CreateCustomerRequest)(nil),          // 2: customers.v1.CreateCustomerRequest
+	(*Customer)(nil),                       // 3: customers.v1.Customer
+	(*CreateCustomerResponse)(nil),         // 4: customers.v1.CreateCustomerResponse
+	(*GetCustomerRequest)(nil),             // 5: customers.v1.GetCustomerRequest
+	(*GetCustomerResponse)(nil),            // 6: customers.v1.GetCustomerResponse
+	(*DeleteCustomerRequest)(nil),          // 7: customers.v1.DeleteCustomerRequest
+	(*DeleteCustomerResponse)(nil),         // 8: customers.v1.DeleteCustomerResponse
+	(*CustomerErasure)(nil),                // 9: customers.v1.CustomerErasure
+	(*CancelCustomerDeletionRequest)(nil),  // 10: customers.v1.CancelCustomerDeletionRequest
+	(*CancelCustomerDeletionResponse)(nil), // 11: customers.v1.CancelCustomerDeletionResponse
+	(*RestoreCustomerRequest)(nil),         // 12: customers.v1.RestoreCustomerRequest
+	(*RestoreCustomerResponse)(nil),        // 13: customers.v1.RestoreCustomerResponse
+	(*GetCustomerErasureRequest)(nil),      // 14: customers.v1.GetCustomerErasureRequest
+	(*GetCustomerErasureResponse)(nil),     // 15: customers.v1.GetCustomerErasureResponse
+	(*Address)(nil),                        // 16: customers.v1.Address
+	(*AddAddressRequest)(nil),              // 17: customers.v1.AddAddressRequest
+	(*AddAddressResponse)(nil),             // 18: customers.v1.AddAddressResponse
+	(*UpdateAddressRequest)(nil),           // 19: customers.v1.UpdateAddressRequest
+	(*UpdateAddressResponse)(nil),          // 20: customers.v1.UpdateAddressResponse
+	(*DeleteAddressRequest)(nil),           // 21: customers.v1.DeleteAddressRequest
+	(*DeleteAddressResponse)(nil),          // 22: customers.v1.DeleteAddressResponse
+	(*ListAddressesRequest)(nil),           // 23: customers.v1.ListAddressesRequest
+	(*ListAddressesResponse)(nil),          // 24: customers.v1.ListAddressesResponse
+	(*SetDefaultAddressRequest)(nil),       // 25: customers.v1.SetDefaultAddressRequest
+	(*SetDefaultAddressResponse)(nil),      // 26: customers.v1.SetDefaultAddressResponse
+	(*ExportFile)(nil),                     // 27: customers.v1.ExportFile
+	(*CustomerDataExport)(nil),             // 28: customers.v1.CustomerDataExport
+	(*CustomerData)(nil),                   // 29: customers.v1.CustomerData
+	(*ExportCustomerDataRequest)(nil),      // 30: customers.v1.ExportCustomerDataRequest
+	(*ExportCustomerDataResponse)(nil),     // 31: customers.v1.ExportCustomerDataResponse
+	(*GetCustomerDataExportRequest)(nil),   // 32: customers.v1.GetCustomerDataExportRequest
+	(*GetCustomerDataExportResponse)(nil),  // 33: customers.v1.GetCustomerDataExportResponse
+	(*timestamppb.Timestamp)(nil),          // 34: google.protobuf.Timestamp
+	(*v1.Order)(nil),                       // 35: orders.v1.Order
 }
 var file_customers_v1_customers_proto_depIdxs = []int32{
-	7, // 0: customers.v1.Customer.created_at:type_name -> google.protobuf.Timestamp
-	1, // 1: customers.v1.CreateCustomerResponse.customer:type_name -> customers.v1.Customer
-	1, // 2: customers.v1.GetCustomerResponse.customer:type_name -> customers.v1.Customer
-	0, // 3: customers.v1.CustomersService.CreateCustomer:input_type -> customers.v1.CreateCustomerRequest
-	3, // 4: customers.v1.CustomersService.GetCustomer:input_type -> customers.v1.GetCustomerRequest
-	5, // 5: customers.v1.CustomersService.DeleteCustomer:input_type -> customers.v1.DeleteCustomerRequest
-	2, // 6: customers.v1.CustomersService.CreateCustomer:output_type -> customers.v1.CreateCustomerResponse
-	4, // 7: customers.v1.CustomersService.GetCustomer:output_type -> customers.v1.GetCustomerResponse
-	6, // 8: customers.v1.CustomersService.DeleteCustomer:output_type -> customers.v1.DeleteCustomerResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	34, // 0: customers.v1.Customer.created_at:type_name -> google.protobuf.Timestamp
+	34, // 1: customers.v1.Customer.deleted_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: customers.v1.CreateCustomerResponse.customer:type_name -> customers.v1.Customer
+	3,  // 3: customers.v1.GetCustomerResponse.customer:type_name -> customers.v1.Customer
+	9,  // 4: customers.v1.DeleteCustomerResponse.erasure:type_name -> customers.v1.CustomerErasure
+	0,  // 5: customers.v1.CustomerErasure.status:type_name -> customers.v1.ErasureStatus
+	34, // 6: customers.v1.CustomerErasure.requested_at:type_name -> google.protobuf.Timestamp
+	34, // 7: customers.v1.CustomerErasure.erase_after:type_name -> google.protobuf.Timestamp
+	34, // 8: customers.v1.CustomerErasure.completed_at:type_name -> google.protobuf.Timestamp
+	9,  // 9: customers.v1.CancelCustomerDeletionResponse.erasure:type_name -> customers.v1.CustomerErasure
+	3,  // 10: customers.v1.RestoreCustomerResponse.customer:type_name -> customers.v1.Customer
+	9,  // 11: customers.v1.RestoreCustomerResponse.erasure:type_name -> customers.v1.CustomerErasure
+	9,  // 12: customers.v1.GetCustomerErasureResponse.erasure:type_name -> customers.v1.CustomerErasure
+	34, // 13: customers.v1.Address.created_at:type_name -> google.protobuf.Timestamp
+	34, // 14: customers.v1.Address.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 15: customers.v1.AddAddressRequest.address:type_name -> customers.v1.Address
+	16, // 16: customers.v1.AddAddressResponse.address:type_name -> customers.v1.Address
+	16, // 17: customers.v1.UpdateAddressRequest.address:type_name -> customers.v1.Address
+	16, // 18: customers.v1.UpdateAddressResponse.address:type_name -> customers.v1.Address
+	16, // 19: customers.v1.ListAddressesResponse.addresses:type_name -> customers.v1.Address
+	16, // 20: customers.v1.SetDefaultAddressResponse.address:type_name -> customers.v1.Address
+	1,  // 21: customers.v1.CustomerDataExport.status:type_name -> customers.v1.ExportStatus
+	34, // 22: customers.v1.CustomerDataExport.requested_at:type_name -> google.protobuf.Timestamp
+	34, // 23: customers.v1.CustomerDataExport.completed_at:type_name -> google.protobuf.Timestamp
+	27, // 24: customers.v1.CustomerDataExport.files:type_name -> customers.v1.ExportFile
+	3,  // 25: customers.v1.CustomerData.customer:type_name -> customers.v1.Customer
+	16, // 26: customers.v1.CustomerData.addresses:type_name -> customers.v1.Address
+	35, // 27: customers.v1.CustomerData.orders:type_name -> orders.v1.Order
+	34, // 28: customers.v1.CustomerData.exported_at:type_name -> google.protobuf.Timestamp
+	28, // 29: customers.v1.ExportCustomerDataResponse.export:type_name -> customers.v1.CustomerDataExport
+	28, // 30: customers.v1.GetCustomerDataExportResponse.export:type_name -> customers.v1.CustomerDataExport
+	2,  // 31: customers.v1.CustomersService.CreateCustomer:input_type -> customers.v1.CreateCustomerRequest
+	5,  // 32: customers.v1.CustomersService.GetCustomer:input_type -> customers.v1.GetCustomerRequest
+	7,  // 33: customers.v1.CustomersService.DeleteCustomer:input_type -> customers.v1.DeleteCustomerRequest
+	10, // 34: customers.v1.CustomersService.CancelCustomerDeletion:input_type -> customers.v1.CancelCustomerDeletionRequest
+	12, // 35: customers.v1.CustomersService.RestoreCustomer:input_type -> customers.v1.RestoreCustomerRequest
+	14, // 36: customers.v1.CustomersService.GetCustomerErasure:input_type -> customers.v1.GetCustomerErasureRequest
+	30, // 37: customers.v1.CustomersService.ExportCustomerData:input_type -> customers.v1.ExportCustomerDataRequest
+	32, // 38: customers.v1.CustomersService.GetCustomerDataExport:input_type -> customers.v1.GetCustomerDataExportRequest
+	17, // 39: customers.v1.CustomersService.AddAddress:input_type -> customers.v1.AddAddressRequest
+	19, // 40: customers.v1.CustomersService.UpdateAddress:input_type -> customers.v1.UpdateAddressRequest
+	21, // 41: customers.v1.CustomersService.DeleteAddress:input_type -> customers.v1.DeleteAddressRequest
+	23, // 42: customers.v1.CustomersService.ListAddresses:input_type -> customers.v1.ListAddressesRequest
+	25, // 43: customers.v1.CustomersService.SetDefaultAddress:input_type -> customers.v1.SetDefaultAddressRequest
+	4,  // 44: customers.v1.CustomersService.CreateCustomer:output_type -> customers.v1.CreateCustomerResponse
+	6,  // 45: customers.v1.CustomersService.GetCustomer:output_type -> customers.v1.GetCustomerResponse
+	8,  // 46: customers.v1.CustomersService.DeleteCustomer:output_type -> customers.v1.DeleteCustomerResponse
+	11, // 47: customers.v1.CustomersService.CancelCustomerDeletion:output_type -> customers.v1.CancelCustomerDeletionResponse
+	13, // 48: customers.v1.CustomersService.RestoreCustomer:output_type -> customers.v1.RestoreCustomerResponse
+	15, // 49: customers.v1.CustomersService.GetCustomerErasure:output_type -> customers.v1.GetCustomerErasureResponse
+	31, // 50: customers.v1.CustomersService.ExportCustomerData:output_type -> customers.v1.ExportCustomerDataResponse
+	33, // 51: customers.v1.CustomersService.GetCustomerDataExport:output_type -> customers.v1.GetCustomerDataExportResponse
+	18, // 52: customers.v1.CustomersService.AddAddress:output_type -> customers.v1.AddAddressResponse
+	20, // 53: customers.v1.CustomersService.UpdateAddress:output_type -> customers.v1.UpdateAddressResponse
+	22, // 54: customers.v1.CustomersService.DeleteAddress:output_type -> customers.v1.DeleteAddressResponse
+	24, // 55: customers.v1.CustomersService.ListAddresses:output_type -> customers.v1.ListAddressesResponse
+	26, // 56: customers.v1.CustomersService.SetDefaultAddress:output_type -> customers.v1.SetDefaultAddressResponse
+	44, // [44:57] is the sub-list for method output_type
+	31, // [31:44] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_customers_v1_customers_proto_init() }
@@ -462,13 +2254,14 @@ func file_customers_v1_customers_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_customers_v1_customers_proto_rawDesc), len(file_customers_v1_customers_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_customers_v1_customers_proto_goTypes,
 		DependencyIndexes: file_customers_v1_customers_proto_depIdxs,
+		EnumInfos:         file_customers_v1_customers_proto_enumTypes,
 		MessageInfos:      file_customers_v1_customers_proto_msgTypes,
 	}.Build()
 	File_customers_v1_customers_proto = out.File
@@ -43,6 +43,36 @@ const (
 	// CustomersServiceDeleteCustomerProcedure is the fully-qualified name of the CustomersService's
 	// DeleteCustomer RPC.
 	CustomersServiceDeleteCustomerProcedure = "/customers.v1.CustomersService/DeleteCustomer"
+	// CustomersServiceCancelCustomerDeletionProcedure is the fully-qualified name of the
+	// CustomersService's CancelCustomerDeletion RPC.
+	CustomersServiceCancelCustomerDeletionProcedure = "/customers.v1.CustomersService/CancelCustomerDeletion"
+	// CustomersServiceRestoreCustomerProcedure is the fully-qualified name of the CustomersService's
+	// RestoreCustomer RPC.
+	CustomersServiceRestoreCustomerProcedure = "/customers.v1.CustomersService/RestoreCustomer"
+	// CustomersServiceGetCustomerErasureProcedure is the fully-qualified name of the CustomersService's
+	// GetCustomerErasure RPC.
+	CustomersServiceGetCustomerErasureProcedure = "/customers.v1.CustomersService/GetCustomerErasure"
+	// CustomersServiceExportCustomerDataProcedure is the fully-qualified name of the CustomersService's
+	// ExportCustomerData RPC.
+	CustomersServiceExportCustomerDataProcedure = "/customers.v1.CustomersService/ExportCustomerData"
+	// CustomersServiceGetCustomerDataExportProcedure is the fully-qualified name of the
+	// CustomersService's GetCustomerDataExport RPC.
+	CustomersServiceGetCustomerDataExportProcedure = "/customers.v1.CustomersService/GetCustomerDataExport"
+	// CustomersServiceAddAddressProcedure is the fully-qualified name of the CustomersService's
+	// AddAddress RPC.
+	CustomersServiceAddAddressProcedure = "/customers.v1.CustomersService/AddAddress"
+	// CustomersServiceUpdateAddressProcedure is the fully-qualified name of the CustomersService's
+	// UpdateAddress RPC.
+	CustomersServiceUpdateAddressProcedure = "/customers.v1.CustomersService/UpdateAddress"
+	// CustomersServiceDeleteAddressProcedure is the fully-qualified name of the CustomersService's
+	// DeleteAddress RPC.
+	CustomersServiceDeleteAddressProcedure = "/customers.v1.CustomersService/DeleteAddress"
+	// CustomersServiceListAddressesProcedure is the fully-qualified name of the CustomersService's
+	// ListAddresses RPC.
+	CustomersServiceListAddressesProcedure = "/customers.v1.CustomersService/ListAddresses"
+	// CustomersServiceSetDefaultAddressProcedure is the fully-qualified name of the CustomersService's
+	// SetDefaultAddress RPC.
+	CustomersServiceSetDefaultAddressProcedure = "/customers.v1.CustomersService/SetDefaultAddress"
 )
 
 // CustomersServiceClient is a client for the customers.v1.CustomersService service.
@@ -50,6 +80,17 @@ type CustomersServiceClient interface {
 	CreateCustomer(context.Context, *connect.Request[v1.CreateCustomerRequest]) (*connect.Response[v1.CreateCustomerResponse], error)
 	GetCustomer(context.Context, *connect.Request[v1.GetCustomerRequest]) (*connect.Response[v1.GetCustomerResponse], error)
 	DeleteCustomer(context.Context, *connect.Request[v1.DeleteCustomerRequest]) (*connect.Response[v1.DeleteCustomerResponse], error)
+	// Deprecated: do not use.
+	CancelCustomerDeletion(context.Context, *connect.Request[v1.CancelCustomerDeletionRequest]) (*connect.Response[v1.CancelCustomerDeletionResponse], error)
+	RestoreCustomer(context.Context, *connect.Request[v1.RestoreCustomerRequest]) (*connect.Response[v1.RestoreCustomerResponse], error)
+	GetCustomerErasure(context.Context, *connect.Request[v1.GetCustomerErasureRequest]) (*connect.Response[v1.GetCustomerErasureResponse], error)
+	ExportCustomerData(context.Context, *connect.Request[v1.ExportCustomerDataRequest]) (*connect.Response[v1.ExportCustomerDataResponse], error)
+	GetCustomerDataExport(context.Context, *connect.Request[v1.GetCustomerDataExportRequest]) (*connect.Response[v1.GetCustomerDataExportResponse], error)
+	AddAddress(context.Context, *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error)
+	UpdateAddress(context.Context, *connect.Request[v1.UpdateAddressRequest]) (*connect.Response[v1.UpdateAddressResponse], error)
+	DeleteAddress(context.Context, *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error)
+	ListAddresses(context.Context, *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error)
+	SetDefaultAddress(context.Context, *connect.Request[v1.SetDefaultAddressRequest]) (*connect.Response[v1.SetDefaultAddressResponse], error)
 }
 
 // NewCustomersServiceClient constructs a client for the customers.v1.CustomersService service. By
@@ -81,14 +122,84 @@ func NewCustomersServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(customersServiceMethods.ByName("DeleteCustomer")),
 			connect.WithClientOptions(opts...),
 		),
+		cancelCustomerDeletion: connect.NewClient[v1.CancelCustomerDeletionRequest, v1.CancelCustomerDeletionResponse](
+			httpClient,
+			baseURL+CustomersServiceCancelCustomerDeletionProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("CancelCustomerDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreCustomer: connect.NewClient[v1.RestoreCustomerRequest, v1.RestoreCustomerResponse](
+			httpClient,
+			baseURL+CustomersServiceRestoreCustomerProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("RestoreCustomer")),
+			connect.WithClientOptions(opts...),
+		),
+		getCustomerErasure: connect.NewClient[v1.GetCustomerErasureRequest, v1.GetCustomerErasureResponse](
+			httpClient,
+			baseURL+CustomersServiceGetCustomerErasureProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("GetCustomerErasure")),
+			connect.WithClientOptions(opts...),
+		),
+		exportCustomerData: connect.NewClient[v1.ExportCustomerDataRequest, v1.ExportCustomerDataResponse](
+			httpClient,
+			baseURL+CustomersServiceExportCustomerDataProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("ExportCustomerData")),
+			connect.WithClientOptions(opts...),
+		),
+		getCustomerDataExport: connect.NewClient[v1.GetCustomerDataExportRequest, v1.GetCustomerDataExportResponse](
+			httpClient,
+			baseURL+CustomersServiceGetCustomerDataExportProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("GetCustomerDataExport")),
+			connect.WithClientOptions(opts...),
+		),
+		addAddress: connect.NewClient[v1.AddAddressRequest, v1.AddAddressResponse](
+			httpClient,
+			baseURL+CustomersServiceAddAddressProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("AddAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAddress: connect.NewClient[v1.UpdateAddressRequest, v1.UpdateAddressResponse](
+			httpClient,
+			baseURL+CustomersServiceUpdateAddressProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("UpdateAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAddress: connect.NewClient[v1.DeleteAddressRequest, v1.DeleteAddressResponse](
+			httpClient,
+			baseURL+CustomersServiceDeleteAddressProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("DeleteAddress")),
+			connect.WithClientOptions(opts...),
+		),
+		listAddresses: connect.NewClient[v1.ListAddressesRequest, v1.ListAddressesResponse](
+			httpClient,
+			baseURL+CustomersServiceListAddressesProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("ListAddresses")),
+			connect.WithClientOptions(opts...),
+		),
+		setDefaultAddress: connect.NewClient[v1.SetDefaultAddressRequest, v1.SetDefaultAddressResponse](
+			httpClient,
+			baseURL+CustomersServiceSetDefaultAddressProcedure,
+			connect.WithSchema(customersServiceMethods.ByName("SetDefaultAddress")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // customersServiceClient implements CustomersServiceClient.
 type customersServiceClient struct {
-	createCustomer *connect.Client[v1.CreateCustomerRequest, v1.CreateCustomerResponse]
-	getCustomer    *connect.Client[v1.GetCustomerRequest, v1.GetCustomerResponse]
-	deleteCustomer *connect.Client[v1.DeleteCustomerRequest, v1.DeleteCustomerResponse]
+	createCustomer         *connect.Client[v1.CreateCustomerRequest, v1.CreateCustomerResponse]
+	getCustomer            *connect.Client[v1.GetCustomerRequest, v1.GetCustomerResponse]
+	deleteCustomer         *connect.Client[v1.DeleteCustomerRequest, v1.DeleteCustomerResponse]
+	cancelCustomerDeletion *connect.Client[v1.CancelCustomerDeletionRequest, v1.CancelCustomerDeletionResponse]
+	restoreCustomer        *connect.Client[v1.RestoreCustomerRequest, v1.RestoreCustomerResponse]
+	getCustomerErasure     *connect.Client[v1.GetCustomerErasureRequest, v1.GetCustomerErasureResponse]
+	exportCustomerData     *connect.Client[v1.ExportCustomerDataRequest, v1.ExportCustomerDataResponse]
+	getCustomerDataExport  *connect.Client[v1.GetCustomerDataExportRequest, v1.GetCustomerDataExportResponse]
+	addAddress             *connect.Client[v1.AddAddressRequest, v1.AddAddressResponse]
+	updateAddress          *connect.Client[v1.UpdateAddressRequest, v1.UpdateAddressResponse]
+	deleteAddress          *connect.Client[v1.DeleteAddressRequest, v1.DeleteAddressResponse]
+	listAddresses          *connect.Client[v1.ListAddressesRequest, v1.ListAddressesResponse]
+	setDefaultAddress      *connect.Client[v1.SetDefaultAddressRequest, v1.SetDefaultAddressResponse]
 }
 
 // CreateCustomer calls customers.v1.CustomersService.CreateCustomer.
@@ -106,11 +217,74 @@ func (c *customersServiceClient) DeleteCustomer(ctx context.Context, req *connec
 	return c.deleteCustomer.CallUnary(ctx, req)
 }
 
+// CancelCustomerDeletion calls customers.v1.CustomersService.CancelCustomerDeletion.
+//
+// Deprecated: do not use.
+func (c *customersServiceClient) CancelCustomerDeletion(ctx context.Context, req *connect.Request[v1.CancelCustomerDeletionRequest]) (*connect.Response[v1.CancelCustomerDeletionResponse], error) {
+	return c.cancelCustomerDeletion.CallUnary(ctx, req)
+}
+
+// RestoreCustomer calls customers.v1.CustomersService.RestoreCustomer.
+func (c *customersServiceClient) RestoreCustomer(ctx context.Context, req *connect.Request[v1.RestoreCustomerRequest]) (*connect.Response[v1.RestoreCustomerResponse], error) {
+	return c.restoreCustomer.CallUnary(ctx, req)
+}
+
+// GetCustomerErasure calls customers.v1.CustomersService.GetCustomerErasure.
+func (c *customersServiceClient) GetCustomerErasure(ctx context.Context, req *connect.Request[v1.GetCustomerErasureRequest]) (*connect.Response[v1.GetCustomerErasureResponse], error) {
+	return c.getCustomerErasure.CallUnary(ctx, req)
+}
+
+// ExportCustomerData calls customers.v1.CustomersService.ExportCustomerData.
+func (c *customersServiceClient) ExportCustomerData(ctx context.Context, req *connect.Request[v1.ExportCustomerDataRequest]) (*connect.Response[v1.ExportCustomerDataResponse], error) {
+	return c.exportCustomerData.CallUnary(ctx, req)
+}
+
+// GetCustomerDataExport calls customers.v1.CustomersService.GetCustomerDataExport.
+func (c *customersServiceClient) GetCustomerDataExport(ctx context.Context, req *connect.Request[v1.GetCustomerDataExportRequest]) (*connect.Response[v1.GetCustomerDataExportResponse], error) {
+	return c.getCustomerDataExport.CallUnary(ctx, req)
+}
+
+// AddAddress calls customers.v1.CustomersService.AddAddress.
+func (c *customersServiceClient) AddAddress(ctx context.Context, req *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error) {
+	return c.addAddress.CallUnary(ctx, req)
+}
+
+// UpdateAddress calls customers.v1.CustomersService.UpdateAddress.
+func (c *customersServiceClient) UpdateAddress(ctx context.Context, req *connect.Request[v1.UpdateAddressRequest]) (*connect.Response[v1.UpdateAddressResponse], error) {
+	return c.updateAddress.CallUnary(ctx, req)
+}
+
+// DeleteAddress calls customers.v1.CustomersService.DeleteAddress.
+func (c *customersServiceClient) DeleteAddress(ctx context.Context, req *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error) {
+	return c.deleteAddress.CallUnary(ctx, req)
+}
+
+// ListAddresses calls customers.v1.CustomersService.ListAddresses.
+func (c *customersServiceClient) ListAddresses(ctx context.Context, req *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error) {
+	return c.listAddresses.CallUnary(ctx, req)
+}
+
+// SetDefaultAddress calls customers.v1.CustomersService.SetDefaultAddress.
+func (c *customersServiceClient) SetDefaultAddress(ctx context.Context, req *connect.Request[v1.SetDefaultAddressRequest]) (*connect.Response[v1.SetDefaultAddressResponse], error) {
+	return c.setDefaultAddress.CallUnary(ctx, req)
+}
+
 // CustomersServiceHandler is an implementation of the customers.v1.CustomersService service.
 type CustomersServiceHandler interface {
 	CreateCustomer(context.Context, *connect.Request[v1.CreateCustomerRequest]) (*connect.Response[v1.CreateCustomerResponse], error)
 	GetCustomer(context.Context, *connect.Request[v1.GetCustomerRequest]) (*connect.Response[v1.GetCustomerResponse], error)
 	DeleteCustomer(context.Context, *connect.Request[v1.DeleteCustomerRequest]) (*connect.Response[v1.DeleteCustomerResponse], error)
+	// Deprecated: do not use.
+	CancelCustomerDeletion(context.Context, *connect.Request[v1.CancelCustomerDeletionRequest]) (*connect.Response[v1.CancelCustomerDeletionResponse], error)
+	RestoreCustomer(context.Context, *connect.Request[v1.RestoreCustomerRequest]) (*connect.Response[v1.RestoreCustomerResponse], error)
+	GetCustomerErasure(context.Context, *connect.Request[v1.GetCustomerErasureRequest]) (*connect.Response[v1.GetCustomerErasureResponse], error)
+	ExportCustomerData(context.Context, *connect.Request[v1.ExportCustomerDataRequest]) (*connect.Response[v1.ExportCustomerDataResponse], error)
+	GetCustomerDataExport(context.Context, *connect.Request[v1.GetCustomerDataExportRequest]) (*connect.Response[v1.GetCustomerDataExportResponse], error)
+	AddAddress(context.Context, *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error)
+	UpdateAddress(context.Context, *connect.Request[v1.UpdateAddressRequest]) (*connect.Response[v1.UpdateAddressResponse], error)
+	DeleteAddress(context.Context, *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error)
+	ListAddresses(context.Context, *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error)
+	SetDefaultAddress(context.Context, *connect.Request[v1.SetDefaultAddressRequest]) (*connect.Response[v1.SetDefaultAddressResponse], error)
 }
 
 // NewCustomersServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -138,6 +312,66 @@ func NewCustomersServiceHandler(svc CustomersServiceHandler, opts ...connect.Han
 		connect.WithSchema(customersServiceMethods.ByName("DeleteCustomer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	customersServiceCancelCustomerDeletionHandler := connect.NewUnaryHandler(
+		CustomersServiceCancelCustomerDeletionProcedure,
+		svc.CancelCustomerDeletion,
+		connect.WithSchema(customersServiceMethods.ByName("CancelCustomerDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceRestoreCustomerHandler := connect.NewUnaryHandler(
+		CustomersServiceRestoreCustomerProcedure,
+		svc.RestoreCustomer,
+		connect.WithSchema(customersServiceMethods.ByName("RestoreCustomer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceGetCustomerErasureHandler := connect.NewUnaryHandler(
+		CustomersServiceGetCustomerErasureProcedure,
+		svc.GetCustomerErasure,
+		connect.WithSchema(customersServiceMethods.ByName("GetCustomerErasure")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceExportCustomerDataHandler := connect.NewUnaryHandler(
+		CustomersServiceExportCustomerDataProcedure,
+		svc.ExportCustomerData,
+		connect.WithSchema(customersServiceMethods.ByName("ExportCustomerData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceGetCustomerDataExportHandler := connect.NewUnaryHandler(
+		CustomersServiceGetCustomerDataExportProcedure,
+		svc.GetCustomerDataExport,
+		connect.WithSchema(customersServiceMethods.ByName("GetCustomerDataExport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceAddAddressHandler := connect.NewUnaryHandler(
+		CustomersServiceAddAddressProcedure,
+		svc.AddAddress,
+		connect.WithSchema(customersServiceMethods.ByName("AddAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceUpdateAddressHandler := connect.NewUnaryHandler(
+		CustomersServiceUpdateAddressProcedure,
+		svc.UpdateAddress,
+		connect.WithSchema(customersServiceMethods.ByName("UpdateAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceDeleteAddressHandler := connect.NewUnaryHandler(
+		CustomersServiceDeleteAddressProcedure,
+		svc.DeleteAddress,
+		connect.WithSchema(customersServiceMethods.ByName("DeleteAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceListAddressesHandler := connect.NewUnaryHandler(
+		CustomersServiceListAddressesProcedure,
+		svc.ListAddresses,
+		connect.WithSchema(customersServiceMethods.ByName("ListAddresses")),
+		connect.WithHandlerOptions(opts...),
+	)
+	customersServiceSetDefaultAddressHandler := connect.NewUnaryHandler(
+		CustomersServiceSetDefaultAddressProcedure,
+		svc.SetDefaultAddress,
+		connect.WithSchema(customersServiceMethods.ByName("SetDefaultAddress")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/customers.v1.CustomersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CustomersServiceCreateCustomerProcedure:
@@ -146,6 +380,26 @@ func NewCustomersServiceHandler(svc CustomersServiceHandler, opts ...connect.Han
 			customersServiceGetCustomerHandler.ServeHTTP(w, r)
 		case CustomersServiceDeleteCustomerProcedure:
 			customersServiceDeleteCustomerHandler.ServeHTTP(w, r)
+		case CustomersServiceCancelCustomerDeletionProcedure:
+			customersServiceCancelCustomerDeletionHandler.ServeHTTP(w, r)
+		case CustomersServiceRestoreCustomerProcedure:
+			customersServiceRestoreCustomerHandler.ServeHTTP(w, r)
+		case CustomersServiceGetCustomerErasureProcedure:
+			customersServiceGetCustomerErasureHandler.ServeHTTP(w, r)
+		case CustomersServiceExportCustomerDataProcedure:
+			customersServiceExportCustomerDataHandler.ServeHTTP(w, r)
+		case CustomersServiceGetCustomerDataExportProcedure:
+			customersServiceGetCustomerDataExportHandler.ServeHTTP(w, r)
+		case CustomersServiceAddAddressProcedure:
+			customersServiceAddAddressHandler.ServeHTTP(w, r)
+		case CustomersServiceUpdateAddressProcedure:
+			customersServiceUpdateAddressHandler.ServeHTTP(w, r)
+		case CustomersServiceDeleteAddressProcedure:
+			customersServiceDeleteAddressHandler.ServeHTTP(w, r)
+		case CustomersServiceListAddressesProcedure:
+			customersServiceListAddressesHandler.ServeHTTP(w, r)
+		case CustomersServiceSetDefaultAddressProcedure:
+			customersServiceSetDefaultAddressHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -166,3 +420,43 @@ func (UnimplementedCustomersServiceHandler) GetCustomer(context.Context, *connec
 func (UnimplementedCustomersServiceHandler) DeleteCustomer(context.Context, *connect.Request[v1.DeleteCustomerRequest]) (*connect.Response[v1.DeleteCustomerResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.DeleteCustomer is not implemented"))
 }
+
+func (UnimplementedCustomersServiceHandler) CancelCustomerDeletion(context.Context, *connect.Request[v1.CancelCustomerDeletionRequest]) (*connect.Response[v1.CancelCustomerDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.CancelCustomerDeletion is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) RestoreCustomer(context.Context, *connect.Request[v1.RestoreCustomerRequest]) (*connect.Response[v1.RestoreCustomerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.RestoreCustomer is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) GetCustomerErasure(context.Context, *connect.Request[v1.GetCustomerErasureRequest]) (*connect.Response[v1.GetCustomerErasureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.GetCustomerErasure is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) ExportCustomerData(context.Context, *connect.Request[v1.ExportCustomerDataRequest]) (*connect.Response[v1.ExportCustomerDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.ExportCustomerData is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) GetCustomerDataExport(context.Context, *connect.Request[v1.GetCustomerDataExportRequest]) (*connect.Response[v1.GetCustomerDataExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.GetCustomerDataExport is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) AddAddress(context.Context, *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.AddAddress is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) UpdateAddress(context.Context, *connect.Request[v1.UpdateAddressRequest]) (*connect.Response[v1.UpdateAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.UpdateAddress is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) DeleteAddress(context.Context, *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.DeleteAddress is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) ListAddresses(context.Context, *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.ListAddresses is not implemented"))
+}
+
+func (UnimplementedCustomersServiceHandler) SetDefaultAddress(context.Context, *connect.Request[v1.SetDefaultAddressRequest]) (*connect.Response[v1.SetDefaultAddressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("customers.v1.CustomersService.SetDefaultAddress is not implemented"))
+}
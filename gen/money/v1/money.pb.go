@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: money/v1/money.proto
+
+package moneyv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An amount of money.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`                           // ISO 4217 code, e.g. EUR.
+	AmountMinor   int64                  `protobuf:"varint,2,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"` // Amount in the currency's minor unit, e.g. cents; 0 digits for JPY, 3 for BHD.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_money_v1_money_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+// The value of one unit of a currency in another.
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseCurrency  string                 `protobuf:"bytes,1,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency string                 `protobuf:"bytes,2,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"` // Decimal, e.g. "1.0842" when one EUR is 1.0842 USD.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_money_v1_money_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExchangeRate) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request to set an exchange rate.
+type SetExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *ExchangeRate          `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateRequest) Reset() {
+	*x = SetExchangeRateRequest{}
+	mi := &file_money_v1_money_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateRequest) ProtoMessage() {}
+
+func (x *SetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetExchangeRateRequest) GetRate() *ExchangeRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+// Response for a set exchange rate request.
+type SetExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *ExchangeRate          `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetExchangeRateResponse) Reset() {
+	*x = SetExchangeRateResponse{}
+	mi := &file_money_v1_money_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeRateResponse) ProtoMessage() {}
+
+func (x *SetExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*SetExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetExchangeRateResponse) GetRate() *ExchangeRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+// Request to list exchange rates.
+type ListExchangeRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesRequest) Reset() {
+	*x = ListExchangeRatesRequest{}
+	mi := &file_money_v1_money_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesRequest) ProtoMessage() {}
+
+func (x *ListExchangeRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{4}
+}
+
+// Response for a list exchange rates request.
+type ListExchangeRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRatesResponse) Reset() {
+	*x = ListExchangeRatesResponse{}
+	mi := &file_money_v1_money_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRatesResponse) ProtoMessage() {}
+
+func (x *ListExchangeRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListExchangeRatesResponse) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// Request to convert an amount.
+type ConvertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *Money                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // Currency to convert into.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_money_v1_money_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConvertRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ConvertRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Response for a convert request.
+type ConvertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        *Money                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate          *ExchangeRate          `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"` // The rate the amount was converted at.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertResponse) Reset() {
+	*x = ConvertResponse{}
+	mi := &file_money_v1_money_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertResponse) ProtoMessage() {}
+
+func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_money_v1_money_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_money_v1_money_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConvertResponse) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ConvertResponse) GetRate() *ExchangeRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+var File_money_v1_money_proto protoreflect.FileDescriptor
+
+const file_money_v1_money_proto_rawDesc = "" +
+	"\n" +
+	"\x14money/v1/money.proto\x12\bmoney.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"F\n" +
+	"\x05Money\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12!\n" +
+	"\famount_minor\x18\x02 \x01(\x03R\vamountMinor\"\xa9\x01\n" +
+	"\fExchangeRate\x12#\n" +
+	"\rbase_currency\x18\x01 \x01(\tR\fbaseCurrency\x12%\n" +
+	"\x0equote_currency\x18\x02 \x01(\tR\rquoteCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
+	"\x16SetExchangeRateRequest\x12*\n" +
+	"\x04rate\x18\x01 \x01(\v2\x16.money.v1.ExchangeRateR\x04rate\"E\n" +
+	"\x17SetExchangeRateResponse\x12*\n" +
+	"\x04rate\x18\x01 \x01(\v2\x16.money.v1.ExchangeRateR\x04rate\"\x1a\n" +
+	"\x18ListExchangeRatesRequest\"I\n" +
+	"\x19ListExchangeRatesResponse\x12,\n" +
+	"\x05rates\x18\x01 \x03(\v2\x16.money.v1.ExchangeRateR\x05rates\"U\n" +
+	"\x0eConvertRequest\x12'\n" +
+	"\x06amount\x18\x01 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"f\n" +
+	"\x0fConvertResponse\x12'\n" +
+	"\x06amount\x18\x01 \x01(\v2\x0f.money.v1.MoneyR\x06amount\x12*\n" +
+	"\x04rate\x18\x02 \x01(\v2\x16.money.v1.ExchangeRateR\x04rate2\x87\x02\n" +
+	"\x0fCurrencyService\x12V\n" +
+	"\x0fSetExchangeRate\x12 .money.v1.SetExchangeRateRequest\x1a!.money.v1.SetExchangeRateResponse\x12\\\n" +
+	"\x11ListExchangeRates\x12\".money.v1.ListExchangeRatesRequest\x1a#.money.v1.ListExchangeRatesResponse\x12>\n" +
+	"\aConvert\x12\x18.money.v1.ConvertRequest\x1a\x19.money.v1.ConvertResponseB\x92\x01\n" +
+	"\fcom.money.v1B\n" +
+	"MoneyProtoP\x01Z5github.com/bufbuild/buf-examples/gen/money/v1;moneyv1\xa2\x02\x03MXX\xaa\x02\bMoney.V1\xca\x02\bMoney\\V1\xe2\x02\x14Money\\V1\\GPBMetadata\xea\x02\tMoney::V1b\x06proto3"
+
+var (
+	file_money_v1_money_proto_rawDescOnce sync.Once
+	file_money_v1_money_proto_rawDescData []byte
+)
+
+func file_money_v1_money_proto_rawDescGZIP() []byte {
+	file_money_v1_money_proto_rawDescOnce.Do(func() {
+		file_money_v1_money_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_money_v1_money_proto_rawDesc), len(file_money_v1_money_proto_rawDesc)))
+	})
+	return file_money_v1_money_proto_rawDescData
+}
+
+var file_money_v1_money_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_money_v1_money_proto_goTypes = []any{
+	(*Money)(nil),                     // 0: money.v1.Money
+	(*ExchangeRate)(nil),              // 1: money.v1.ExchangeRate
+	(*SetExchangeRateRequest)(nil),    // 2: money.v1.SetExchangeRateRequest
+	(*SetExchangeRateResponse)(nil),   // 3: money.v1.SetExchangeRateResponse
+	(*ListExchangeRatesRequest)(nil),  // 4: money.v1.ListExchangeRatesRequest
+	(*ListExchangeRatesResponse)(nil), // 5: money.v1.ListExchangeRatesResponse
+	(*ConvertRequest)(nil),            // 6: money.v1.ConvertRequest
+	(*ConvertResponse)(nil),           // 7: money.v1.ConvertResponse
+	(*timestamppb.Timestamp)(nil),     // 8: google.protobuf.Timestamp
+}
+var file_money_v1_money_proto_depIdxs = []int32{
+	8,  // 0: money.v1.ExchangeRate.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: money.v1.SetExchangeRateRequest.rate:type_name -> money.v1.ExchangeRate
+	1,  // 2: money.v1.SetExchangeRateResponse.rate:type_name -> money.v1.ExchangeRate
+	1,  // 3: money.v1.ListExchangeRatesResponse.rates:type_name -> money.v1.ExchangeRate
+	0,  // 4: money.v1.ConvertRequest.amount:type_name -> money.v1.Money
+	0,  // 5: money.v1.ConvertResponse.amount:type_name -> money.v1.Money
+	1,  // 6: money.v1.ConvertResponse.rate:type_name -> money.v1.ExchangeRate
+	2,  // 7: money.v1.CurrencyService.SetExchangeRate:input_type -> money.v1.SetExchangeRateRequest
+	4,  // 8: money.v1.CurrencyService.ListExchangeRates:input_type -> money.v1.ListExchangeRatesRequest
+	6,  // 9: money.v1.CurrencyService.Convert:input_type -> money.v1.ConvertRequest
+	3,  // 10: money.v1.CurrencyService.SetExchangeRate:output_type -> money.v1.SetExchangeRateResponse
+	5,  // 11: money.v1.CurrencyService.ListExchangeRates:output_type -> money.v1.ListExchangeRatesResponse
+	7,  // 12: money.v1.CurrencyService.Convert:output_type -> money.v1.ConvertResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_money_v1_money_proto_init() }
+func file_money_v1_money_proto_init() {
+	if File_money_v1_money_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_money_v1_money_proto_rawDesc), len(file_money_v1_money_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_money_v1_money_proto_goTypes,
+		DependencyIndexes: file_money_v1_money_proto_depIdxs,
+		MessageInfos:      file_money_v1_money_proto_msgTypes,
+	}.Build()
+	File_money_v1_money_proto = out.File
+	file_money_v1_money_proto_goTypes = nil
+	file_money_v1_money_proto_depIdxs = nil
+}
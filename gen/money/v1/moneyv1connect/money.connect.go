@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: money/v1/money.proto
+
+package moneyv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CurrencyServiceName is the fully-qualified name of the CurrencyService service.
+	CurrencyServiceName = "money.v1.CurrencyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CurrencyServiceSetExchangeRateProcedure is the fully-qualified name of the CurrencyService's
+	// SetExchangeRate RPC.
+	CurrencyServiceSetExchangeRateProcedure = "/money.v1.CurrencyService/SetExchangeRate"
+	// CurrencyServiceListExchangeRatesProcedure is the fully-qualified name of the CurrencyService's
+	// ListExchangeRates RPC.
+	CurrencyServiceListExchangeRatesProcedure = "/money.v1.CurrencyService/ListExchangeRates"
+	// CurrencyServiceConvertProcedure is the fully-qualified name of the CurrencyService's Convert RPC.
+	CurrencyServiceConvertProcedure = "/money.v1.CurrencyService/Convert"
+)
+
+// CurrencyServiceClient is a client for the money.v1.CurrencyService service.
+type CurrencyServiceClient interface {
+	// Creates or replaces the exchange rate from one currency to another.
+	SetExchangeRate(context.Context, *connect.Request[v1.SetExchangeRateRequest]) (*connect.Response[v1.SetExchangeRateResponse], error)
+	// Lists every exchange rate.
+	ListExchangeRates(context.Context, *connect.Request[v1.ListExchangeRatesRequest]) (*connect.Response[v1.ListExchangeRatesResponse], error)
+	// Converts an amount into another currency at the current rate.
+	Convert(context.Context, *connect.Request[v1.ConvertRequest]) (*connect.Response[v1.ConvertResponse], error)
+}
+
+// NewCurrencyServiceClient constructs a client for the money.v1.CurrencyService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCurrencyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CurrencyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	currencyServiceMethods := v1.File_money_v1_money_proto.Services().ByName("CurrencyService").Methods()
+	return &currencyServiceClient{
+		setExchangeRate: connect.NewClient[v1.SetExchangeRateRequest, v1.SetExchangeRateResponse](
+			httpClient,
+			baseURL+CurrencyServiceSetExchangeRateProcedure,
+			connect.WithSchema(currencyServiceMethods.ByName("SetExchangeRate")),
+			connect.WithClientOptions(opts...),
+		),
+		listExchangeRates: connect.NewClient[v1.ListExchangeRatesRequest, v1.ListExchangeRatesResponse](
+			httpClient,
+			baseURL+CurrencyServiceListExchangeRatesProcedure,
+			connect.WithSchema(currencyServiceMethods.ByName("ListExchangeRates")),
+			connect.WithClientOptions(opts...),
+		),
+		convert: connect.NewClient[v1.ConvertRequest, v1.ConvertResponse](
+			httpClient,
+			baseURL+CurrencyServiceConvertProcedure,
+			connect.WithSchema(currencyServiceMethods.ByName("Convert")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// currencyServiceClient implements CurrencyServiceClient.
+type currencyServiceClient struct {
+	setExchangeRate   *connect.Client[v1.SetExchangeRateRequest, v1.SetExchangeRateResponse]
+	listExchangeRates *connect.Client[v1.ListExchangeRatesRequest, v1.ListExchangeRatesResponse]
+	convert           *connect.Client[v1.ConvertRequest, v1.ConvertResponse]
+}
+
+// SetExchangeRate calls money.v1.CurrencyService.SetExchangeRate.
+func (c *currencyServiceClient) SetExchangeRate(ctx context.Context, req *connect.Request[v1.SetExchangeRateRequest]) (*connect.Response[v1.SetExchangeRateResponse], error) {
+	return c.setExchangeRate.CallUnary(ctx, req)
+}
+
+// ListExchangeRates calls money.v1.CurrencyService.ListExchangeRates.
+func (c *currencyServiceClient) ListExchangeRates(ctx context.Context, req *connect.Request[v1.ListExchangeRatesRequest]) (*connect.Response[v1.ListExchangeRatesResponse], error) {
+	return c.listExchangeRates.CallUnary(ctx, req)
+}
+
+// Convert calls money.v1.CurrencyService.Convert.
+func (c *currencyServiceClient) Convert(ctx context.Context, req *connect.Request[v1.ConvertRequest]) (*connect.Response[v1.ConvertResponse], error) {
+	return c.convert.CallUnary(ctx, req)
+}
+
+// CurrencyServiceHandler is an implementation of the money.v1.CurrencyService service.
+type CurrencyServiceHandler interface {
+	// Creates or replaces the exchange rate from one currency to another.
+	SetExchangeRate(context.Context, *connect.Request[v1.SetExchangeRateRequest]) (*connect.Response[v1.SetExchangeRateResponse], error)
+	// Lists every exchange rate.
+	ListExchangeRates(context.Context, *connect.Request[v1.ListExchangeRatesRequest]) (*connect.Response[v1.ListExchangeRatesResponse], error)
+	// Converts an amount into another currency at the current rate.
+	Convert(context.Context, *connect.Request[v1.ConvertRequest]) (*connect.Response[v1.ConvertResponse], error)
+}
+
+// NewCurrencyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCurrencyServiceHandler(svc CurrencyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	currencyServiceMethods := v1.File_money_v1_money_proto.Services().ByName("CurrencyService").Methods()
+	currencyServiceSetExchangeRateHandler := connect.NewUnaryHandler(
+		CurrencyServiceSetExchangeRateProcedure,
+		svc.SetExchangeRate,
+		connect.WithSchema(currencyServiceMethods.ByName("SetExchangeRate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	currencyServiceListExchangeRatesHandler := connect.NewUnaryHandler(
+		CurrencyServiceListExchangeRatesProcedure,
+		svc.ListExchangeRates,
+		connect.WithSchema(currencyServiceMethods.ByName("ListExchangeRates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	currencyServiceConvertHandler := connect.NewUnaryHandler(
+		CurrencyServiceConvertProcedure,
+		svc.Convert,
+		connect.WithSchema(currencyServiceMethods.ByName("Convert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/money.v1.CurrencyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CurrencyServiceSetExchangeRateProcedure:
+			currencyServiceSetExchangeRateHandler.ServeHTTP(w, r)
+		case CurrencyServiceListExchangeRatesProcedure:
+			currencyServiceListExchangeRatesHandler.ServeHTTP(w, r)
+		case CurrencyServiceConvertProcedure:
+			currencyServiceConvertHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCurrencyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCurrencyServiceHandler struct{}
+
+func (UnimplementedCurrencyServiceHandler) SetExchangeRate(context.Context, *connect.Request[v1.SetExchangeRateRequest]) (*connect.Response[v1.SetExchangeRateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("money.v1.CurrencyService.SetExchangeRate is not implemented"))
+}
+
+func (UnimplementedCurrencyServiceHandler) ListExchangeRates(context.Context, *connect.Request[v1.ListExchangeRatesRequest]) (*connect.Response[v1.ListExchangeRatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("money.v1.CurrencyService.ListExchangeRates is not implemented"))
+}
+
+func (UnimplementedCurrencyServiceHandler) Convert(context.Context, *connect.Request[v1.ConvertRequest]) (*connect.Response[v1.ConvertResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("money.v1.CurrencyService.Convert is not implemented"))
+}
@@ -7,8 +7,10 @@
 package ordersv1
 
 import (
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -32,6 +34,7 @@ const (
 	OrderStatus_ORDER_STATUS_SHIPPED     OrderStatus = 3
 	OrderStatus_ORDER_STATUS_DELIVERED   OrderStatus = 4
 	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 5
+	OrderStatus_ORDER_STATUS_BACKORDERED OrderStatus = 6 // Waiting for out-of-stock items before stock is reserved and payment taken.
 )
 
 // Enum value maps for OrderStatus.
@@ -43,6 +46,7 @@ var (
 		3: "ORDER_STATUS_SHIPPED",
 		4: "ORDER_STATUS_DELIVERED",
 		5: "ORDER_STATUS_CANCELLED",
+		6: "ORDER_STATUS_BACKORDERED",
 	}
 	OrderStatus_value = map[string]int32{
 		"ORDER_STATUS_UNSPECIFIED": 0,
@@ -51,6 +55,7 @@ var (
 		"ORDER_STATUS_SHIPPED":     3,
 		"ORDER_STATUS_DELIVERED":   4,
 		"ORDER_STATUS_CANCELLED":   5,
+		"ORDER_STATUS_BACKORDERED": 6,
 	}
 )
 
@@ -81,34 +86,2426 @@ func (OrderStatus) EnumDescriptor() ([]byte, []int) {
 	return file_orders_v1_orders_proto_rawDescGZIP(), []int{0}
 }
 
+// Enum for the status of the payment of an order.
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED     PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_PENDING         PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_REQUIRES_ACTION PaymentStatus = 2 // Waiting for 3-D Secure authentication.
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED      PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_CAPTURED        PaymentStatus = 4
+	PaymentStatus_PAYMENT_STATUS_VOIDED          PaymentStatus = 5
+	PaymentStatus_PAYMENT_STATUS_DECLINED        PaymentStatus = 6
+	PaymentStatus_PAYMENT_STATUS_FAILED          PaymentStatus = 7
+	PaymentStatus_PAYMENT_STATUS_REFUNDED        PaymentStatus = 8 // The captured amount was refunded because the order was cancelled.
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_PENDING",
+		2: "PAYMENT_STATUS_REQUIRES_ACTION",
+		3: "PAYMENT_STATUS_AUTHORIZED",
+		4: "PAYMENT_STATUS_CAPTURED",
+		5: "PAYMENT_STATUS_VOIDED",
+		6: "PAYMENT_STATUS_DECLINED",
+		7: "PAYMENT_STATUS_FAILED",
+		8: "PAYMENT_STATUS_REFUNDED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED":     0,
+		"PAYMENT_STATUS_PENDING":         1,
+		"PAYMENT_STATUS_REQUIRES_ACTION": 2,
+		"PAYMENT_STATUS_AUTHORIZED":      3,
+		"PAYMENT_STATUS_CAPTURED":        4,
+		"PAYMENT_STATUS_VOIDED":          5,
+		"PAYMENT_STATUS_DECLINED":        6,
+		"PAYMENT_STATUS_FAILED":          7,
+		"PAYMENT_STATUS_REFUNDED":        8,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v1_orders_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_orders_v1_orders_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{1}
+}
+
+// Enum for the status of the return of an order.
+type ReturnStatus int32
+
+const (
+	ReturnStatus_RETURN_STATUS_UNSPECIFIED ReturnStatus = 0
+	ReturnStatus_RETURN_STATUS_REQUESTED   ReturnStatus = 1
+	ReturnStatus_RETURN_STATUS_RECEIVED    ReturnStatus = 2
+	ReturnStatus_RETURN_STATUS_REFUNDED    ReturnStatus = 3
+	ReturnStatus_RETURN_STATUS_EXPIRED     ReturnStatus = 4 // The items were not received in time and the return was closed.
+)
+
+// Enum value maps for ReturnStatus.
+var (
+	ReturnStatus_name = map[int32]string{
+		0: "RETURN_STATUS_UNSPECIFIED",
+		1: "RETURN_STATUS_REQUESTED",
+		2: "RETURN_STATUS_RECEIVED",
+		3: "RETURN_STATUS_REFUNDED",
+		4: "RETURN_STATUS_EXPIRED",
+	}
+	ReturnStatus_value = map[string]int32{
+		"RETURN_STATUS_UNSPECIFIED": 0,
+		"RETURN_STATUS_REQUESTED":   1,
+		"RETURN_STATUS_RECEIVED":    2,
+		"RETURN_STATUS_REFUNDED":    3,
+		"RETURN_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x ReturnStatus) Enum() *ReturnStatus {
+	p := new(ReturnStatus)
+	*p = x
+	return p
+}
+
+func (x ReturnStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReturnStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v1_orders_proto_enumTypes[2].Descriptor()
+}
+
+func (ReturnStatus) Type() protoreflect.EnumType {
+	return &file_orders_v1_orders_proto_enumTypes[2]
+}
+
+func (x ReturnStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReturnStatus.Descriptor instead.
+func (ReturnStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{2}
+}
+
+// Enum for how a coupon takes money off.
+type CouponType int32
+
+const (
+	CouponType_COUPON_TYPE_UNSPECIFIED  CouponType = 0
+	CouponType_COUPON_TYPE_PERCENTAGE   CouponType = 1 // percent_off of the basket, or of product_id when set.
+	CouponType_COUPON_TYPE_FIXED_AMOUNT CouponType = 2 // amount_off_minor off the basket, or off product_id when set.
+	CouponType_COUPON_TYPE_BUY_X_GET_Y  CouponType = 3 // For every buy_quantity of product_id, get_quantity more are free.
+)
+
+// Enum value maps for CouponType.
+var (
+	CouponType_name = map[int32]string{
+		0: "COUPON_TYPE_UNSPECIFIED",
+		1: "COUPON_TYPE_PERCENTAGE",
+		2: "COUPON_TYPE_FIXED_AMOUNT",
+		3: "COUPON_TYPE_BUY_X_GET_Y",
+	}
+	CouponType_value = map[string]int32{
+		"COUPON_TYPE_UNSPECIFIED":  0,
+		"COUPON_TYPE_PERCENTAGE":   1,
+		"COUPON_TYPE_FIXED_AMOUNT": 2,
+		"COUPON_TYPE_BUY_X_GET_Y":  3,
+	}
+)
+
+func (x CouponType) Enum() *CouponType {
+	p := new(CouponType)
+	*p = x
+	return p
+}
+
+func (x CouponType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponType) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v1_orders_proto_enumTypes[3].Descriptor()
+}
+
+func (CouponType) Type() protoreflect.EnumType {
+	return &file_orders_v1_orders_proto_enumTypes[3]
+}
+
+func (x CouponType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponType.Descriptor instead.
+func (CouponType) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{3}
+}
+
+// Enum for the kind of status transition of an order.
+type OrderEventType int32
+
+const (
+	OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED             OrderEventType = 0
+	OrderEventType_ORDER_EVENT_TYPE_CUSTOMER_VERIFIED       OrderEventType = 1
+	OrderEventType_ORDER_EVENT_TYPE_STOCK_RESERVED          OrderEventType = 2
+	OrderEventType_ORDER_EVENT_TYPE_CREATED                 OrderEventType = 3
+	OrderEventType_ORDER_EVENT_TYPE_SHIPPED                 OrderEventType = 4
+	OrderEventType_ORDER_EVENT_TYPE_DELIVERED               OrderEventType = 5
+	OrderEventType_ORDER_EVENT_TYPE_CANCELLED               OrderEventType = 6
+	OrderEventType_ORDER_EVENT_TYPE_PAYMENT_ACTION_REQUIRED OrderEventType = 7
+	OrderEventType_ORDER_EVENT_TYPE_PAYMENT_AUTHORIZED      OrderEventType = 8
+	OrderEventType_ORDER_EVENT_TYPE_PAYMENT_CAPTURED        OrderEventType = 9
+	OrderEventType_ORDER_EVENT_TYPE_PAYMENT_VOIDED          OrderEventType = 10
+	OrderEventType_ORDER_EVENT_TYPE_BACKORDERED             OrderEventType = 11
+	OrderEventType_ORDER_EVENT_TYPE_STOCK_REPLENISHED       OrderEventType = 12 // The backordered items are back in stock.
+	OrderEventType_ORDER_EVENT_TYPE_PAYMENT_REFUNDED        OrderEventType = 13
+)
+
+// Enum value maps for OrderEventType.
+var (
+	OrderEventType_name = map[int32]string{
+		0:  "ORDER_EVENT_TYPE_UNSPECIFIED",
+		1:  "ORDER_EVENT_TYPE_CUSTOMER_VERIFIED",
+		2:  "ORDER_EVENT_TYPE_STOCK_RESERVED",
+		3:  "ORDER_EVENT_TYPE_CREATED",
+		4:  "ORDER_EVENT_TYPE_SHIPPED",
+		5:  "ORDER_EVENT_TYPE_DELIVERED",
+		6:  "ORDER_EVENT_TYPE_CANCELLED",
+		7:  "ORDER_EVENT_TYPE_PAYMENT_ACTION_REQUIRED",
+		8:  "ORDER_EVENT_TYPE_PAYMENT_AUTHORIZED",
+		9:  "ORDER_EVENT_TYPE_PAYMENT_CAPTURED",
+		10: "ORDER_EVENT_TYPE_PAYMENT_VOIDED",
+		11: "ORDER_EVENT_TYPE_BACKORDERED",
+		12: "ORDER_EVENT_TYPE_STOCK_REPLENISHED",
+		13: "ORDER_EVENT_TYPE_PAYMENT_REFUNDED",
+	}
+	OrderEventType_value = map[string]int32{
+		"ORDER_EVENT_TYPE_UNSPECIFIED":             0,
+		"ORDER_EVENT_TYPE_CUSTOMER_VERIFIED":       1,
+		"ORDER_EVENT_TYPE_STOCK_RESERVED":          2,
+		"ORDER_EVENT_TYPE_CREATED":                 3,
+		"ORDER_EVENT_TYPE_SHIPPED":                 4,
+		"ORDER_EVENT_TYPE_DELIVERED":               5,
+		"ORDER_EVENT_TYPE_CANCELLED":               6,
+		"ORDER_EVENT_TYPE_PAYMENT_ACTION_REQUIRED": 7,
+		"ORDER_EVENT_TYPE_PAYMENT_AUTHORIZED":      8,
+		"ORDER_EVENT_TYPE_PAYMENT_CAPTURED":        9,
+		"ORDER_EVENT_TYPE_PAYMENT_VOIDED":          10,
+		"ORDER_EVENT_TYPE_BACKORDERED":             11,
+		"ORDER_EVENT_TYPE_STOCK_REPLENISHED":       12,
+		"ORDER_EVENT_TYPE_PAYMENT_REFUNDED":        13,
+	}
+)
+
+func (x OrderEventType) Enum() *OrderEventType {
+	p := new(OrderEventType)
+	*p = x
+	return p
+}
+
+func (x OrderEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_v1_orders_proto_enumTypes[4].Descriptor()
+}
+
+func (OrderEventType) Type() protoreflect.EnumType {
+	return &file_orders_v1_orders_proto_enumTypes[4]
+}
+
+func (x OrderEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderEventType.Descriptor instead.
+func (OrderEventType) EnumDescriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{4}
+}
+
 // Represents a single order.
 type Order struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId       int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status           OrderStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=orders.v1.OrderStatus" json:"status,omitempty"`
+	Payment          *Payment               `protobuf:"bytes,7,opt,name=payment,proto3" json:"payment,omitempty"`
+	ReturnStatus     ReturnStatus           `protobuf:"varint,8,opt,name=return_status,json=returnStatus,proto3,enum=orders.v1.ReturnStatus" json:"return_status,omitempty"`
+	ShipTo           *GeoPoint              `protobuf:"bytes,9,opt,name=ship_to,json=shipTo,proto3" json:"ship_to,omitempty"`                                  // Where the order is delivered, used to pick the nearest warehouse.
+	Shipments        []*Shipment            `protobuf:"bytes,10,rep,name=shipments,proto3" json:"shipments,omitempty"`                                         // Set once stock is allocated to warehouses.
+	MaxBackorderWait *durationpb.Duration   `protobuf:"bytes,11,opt,name=max_backorder_wait,json=maxBackorderWait,proto3" json:"max_backorder_wait,omitempty"` // How long backordered items are waited for before the order is cancelled.
+	CouponCodes      []string               `protobuf:"bytes,12,rep,name=coupon_codes,json=couponCodes,proto3" json:"coupon_codes,omitempty"`
+	Discounts        []*Discount            `protobuf:"bytes,13,rep,name=discounts,proto3" json:"discounts,omitempty"`                                    // What the coupons took off; the payment is the items minus these.
+	ShippingAddress  *Address               `protobuf:"bytes,14,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"` // Where the order ships to, copied from the address book when it was placed. Decides the tax jurisdiction.
+	BillingAddress   *Address               `protobuf:"bytes,15,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`    // Decides the tax jurisdiction when there is no shipping address.
+	TaxLines         []*TaxLine             `protobuf:"bytes,16,rep,name=tax_lines,json=taxLines,proto3" json:"tax_lines,omitempty"`                      // Tax per item, after discounts.
+	TaxMinor         int64                  `protobuf:"varint,17,opt,name=tax_minor,json=taxMinor,proto3" json:"tax_minor,omitempty"`                     // Total tax in the currency's minor unit, e.g. cents, inclusive and exclusive.
+	Currency         string                 `protobuf:"bytes,18,opt,name=currency,proto3" json:"currency,omitempty"`                                      // ISO 4217 code the order is priced and paid in.
+	ExchangeRates    []*v1.ExchangeRate     `protobuf:"bytes,19,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty"`       // Rates items were converted at, as they were when the order was priced.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_orders_v1_orders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Order) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Order) GetReturnStatus() ReturnStatus {
+	if x != nil {
+		return x.ReturnStatus
+	}
+	return ReturnStatus_RETURN_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetShipTo() *GeoPoint {
+	if x != nil {
+		return x.ShipTo
+	}
+	return nil
+}
+
+func (x *Order) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+func (x *Order) GetMaxBackorderWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackorderWait
+	}
+	return nil
+}
+
+func (x *Order) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *Order) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *Order) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *Order) GetBillingAddress() *Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *Order) GetTaxLines() []*TaxLine {
+	if x != nil {
+		return x.TaxLines
+	}
+	return nil
+}
+
+func (x *Order) GetTaxMinor() int64 {
+	if x != nil {
+		return x.TaxMinor
+	}
+	return 0
+}
+
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Order) GetExchangeRates() []*v1.ExchangeRate {
+	if x != nil {
+		return x.ExchangeRates
+	}
+	return nil
+}
+
+// A postal address.
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line1         string                 `protobuf:"bytes,1,opt,name=line1,proto3" json:"line1,omitempty"`
+	Line2         string                 `protobuf:"bytes,2,opt,name=line2,proto3" json:"line2,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"` // State or province code, e.g. CA.
+	PostalCode    string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country       string                 `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty"` // ISO 3166-1 alpha-2 code, e.g. US.
+	Recipient     string                 `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Phone         string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	AddressId     int64                  `protobuf:"varint,9,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"` // The address book entry this is a copy of; 0 for an address given with the order.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_orders_v1_orders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetLine1() string {
+	if x != nil {
+		return x.Line1
+	}
+	return ""
+}
+
+func (x *Address) GetLine2() string {
+	if x != nil {
+		return x.Line2
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *Address) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Address) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+// A location on earth.
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_orders_v1_orders_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// Represents the items of an order shipped from one warehouse.
+type Shipment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_orders_v1_orders_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Shipment) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *Shipment) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Represents an item within an order.
+type OrderItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Deprecated: Marked as deprecated in orders/v1/orders.proto.
+	Price          float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                                          // Use unit_price_minor.
+	Backordered    bool    `protobuf:"varint,4,opt,name=backordered,proto3" json:"backordered,omitempty"`                               // Set while the order waits for this item to be back in stock.
+	UnitPriceMinor int64   `protobuf:"varint,5,opt,name=unit_price_minor,json=unitPriceMinor,proto3" json:"unit_price_minor,omitempty"` // In the currency of the order. Set when the order is priced; prices sent by clients are ignored.
+	Sku            string  `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`                                                // Orders a product variant by SKU; product_id is then set to the id of the variant.
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_orders_v1_orders_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OrderItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in orders/v1/orders.proto.
+func (x *OrderItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderItem) GetBackordered() bool {
+	if x != nil {
+		return x.Backordered
+	}
+	return false
+}
+
+func (x *OrderItem) GetUnitPriceMinor() int64 {
+	if x != nil {
+		return x.UnitPriceMinor
+	}
+	return 0
+}
+
+func (x *OrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// Represents the payment taken for an order.
+type Payment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationId string                 `protobuf:"bytes,1,opt,name=authorization_id,json=authorizationId,proto3" json:"authorization_id,omitempty"`
+	Status          PaymentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v1.PaymentStatus" json:"status,omitempty"`
+	AmountMinor     int64                  `protobuf:"varint,3,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"` // Amount in the currency's minor unit, e.g. cents.
+	Currency        string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	FailureReason   string                 `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	ActionUrl       string                 `protobuf:"bytes,6,opt,name=action_url,json=actionUrl,proto3" json:"action_url,omitempty"` // Where the customer completes 3-D Secure, when required.
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_orders_v1_orders_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Payment) GetAuthorizationId() string {
+	if x != nil {
+		return x.AuthorizationId
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Payment) GetActionUrl() string {
+	if x != nil {
+		return x.ActionUrl
+	}
+	return ""
+}
+
+// Represents the return of items of a delivered order.
+type OrderReturn struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	OrderId           int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId        int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items             []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Reason            string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status            ReturnStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=orders.v1.ReturnStatus" json:"status,omitempty"`
+	RefundAmountMinor int64                  `protobuf:"varint,6,opt,name=refund_amount_minor,json=refundAmountMinor,proto3" json:"refund_amount_minor,omitempty"` // Refund in the currency's minor unit, e.g. cents.
+	RequestedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OrderReturn) Reset() {
+	*x = OrderReturn{}
+	mi := &file_orders_v1_orders_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderReturn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderReturn) ProtoMessage() {}
+
+func (x *OrderReturn) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderReturn.ProtoReflect.Descriptor instead.
+func (*OrderReturn) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OrderReturn) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderReturn) GetStatus() ReturnStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReturnStatus_RETURN_STATUS_UNSPECIFIED
+}
+
+func (x *OrderReturn) GetRefundAmountMinor() int64 {
+	if x != nil {
+		return x.RefundAmountMinor
+	}
+	return 0
+}
+
+func (x *OrderReturn) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+func (x *OrderReturn) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Represents a discount that customers redeem with a code.
+type Coupon struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Type             CouponType             `protobuf:"varint,2,opt,name=type,proto3,enum=orders.v1.CouponType" json:"type,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PercentOff       int32                  `protobuf:"varint,4,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	AmountOffMinor   int64                  `protobuf:"varint,5,opt,name=amount_off_minor,json=amountOffMinor,proto3" json:"amount_off_minor,omitempty"` // Amount in the currency's minor unit, e.g. cents.
+	ProductId        int64                  `protobuf:"varint,6,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	BuyQuantity      int32                  `protobuf:"varint,7,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity      int32                  `protobuf:"varint,8,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	MinBasketMinor   int64                  `protobuf:"varint,9,opt,name=min_basket_minor,json=minBasketMinor,proto3" json:"min_basket_minor,omitempty"`        // The items must add up to at least this before any discount.
+	PerCustomerLimit int32                  `protobuf:"varint,10,opt,name=per_customer_limit,json=perCustomerLimit,proto3" json:"per_customer_limit,omitempty"` // How often one customer may redeem the coupon; 0 is unlimited.
+	StartsAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                            // Unset is valid right away.
+	EndsAt           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                                  // Unset never ends.
+	Stackable        bool                   `protobuf:"varint,13,opt,name=stackable,proto3" json:"stackable,omitempty"`                                         // Whether the coupon can be combined with other stackable coupons.
+	Active           bool                   `protobuf:"varint,14,opt,name=active,proto3" json:"active,omitempty"`
+	Currency         string                 `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"` // Currency of amount_off_minor and min_basket_minor; coupons with either only apply to orders in it.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Coupon) Reset() {
+	*x = Coupon{}
+	mi := &file_orders_v1_orders_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Coupon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coupon) ProtoMessage() {}
+
+func (x *Coupon) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coupon.ProtoReflect.Descriptor instead.
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Coupon) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coupon) GetType() CouponType {
+	if x != nil {
+		return x.Type
+	}
+	return CouponType_COUPON_TYPE_UNSPECIFIED
+}
+
+func (x *Coupon) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Coupon) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *Coupon) GetAmountOffMinor() int64 {
+	if x != nil {
+		return x.AmountOffMinor
+	}
+	return 0
+}
+
+func (x *Coupon) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Coupon) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *Coupon) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *Coupon) GetMinBasketMinor() int64 {
+	if x != nil {
+		return x.MinBasketMinor
+	}
+	return 0
+}
+
+func (x *Coupon) GetPerCustomerLimit() int32 {
+	if x != nil {
+		return x.PerCustomerLimit
+	}
+	return 0
+}
+
+func (x *Coupon) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Coupon) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Coupon) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *Coupon) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Coupon) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Represents what one coupon took off an order.
+type Discount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CouponCode    string                 `protobuf:"bytes,1,opt,name=coupon_code,json=couponCode,proto3" json:"coupon_code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`       // The discounted product, or 0 when the discount is on the whole basket.
+	AmountMinor   int64                  `protobuf:"varint,4,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"` // Amount in the currency's minor unit, e.g. cents.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_orders_v1_orders_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Discount) GetCouponCode() string {
+	if x != nil {
+		return x.CouponCode
+	}
+	return ""
+}
+
+func (x *Discount) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Discount) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Discount) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+// Represents the tax rate of a country, or of a region within it, for one tax category.
+type TaxRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`                 // ISO 3166-1 alpha-2 code, e.g. DE.
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`                   // Empty applies to the whole country, unless a region has its own rate.
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`               // Tax category of the products it applies to, e.g. standard or reduced.
+	RateBps       int32                  `protobuf:"varint,4,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"` // Rate in basis points, e.g. 1900 is 19%.
+	Inclusive     bool                   `protobuf:"varint,5,opt,name=inclusive,proto3" json:"inclusive,omitempty"`            // Prices already include the tax, as with VAT; otherwise it is added on top, as with US sales tax.
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxRate) Reset() {
+	*x = TaxRate{}
+	mi := &file_orders_v1_orders_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxRate) ProtoMessage() {}
+
+func (x *TaxRate) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxRate.ProtoReflect.Descriptor instead.
+func (*TaxRate) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TaxRate) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *TaxRate) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TaxRate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TaxRate) GetRateBps() int32 {
+	if x != nil {
+		return x.RateBps
+	}
+	return 0
+}
+
+func (x *TaxRate) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *TaxRate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Represents the tax on one item of an order.
+type TaxLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Jurisdiction  string                 `protobuf:"bytes,3,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"` // Country, and region when the rate is regional, e.g. US-CA.
+	RateBps       int32                  `protobuf:"varint,4,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	Inclusive     bool                   `protobuf:"varint,5,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+	TaxableMinor  int64                  `protobuf:"varint,6,opt,name=taxable_minor,json=taxableMinor,proto3" json:"taxable_minor,omitempty"` // The item after discounts, without the tax.
+	TaxMinor      int64                  `protobuf:"varint,7,opt,name=tax_minor,json=taxMinor,proto3" json:"tax_minor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaxLine) Reset() {
+	*x = TaxLine{}
+	mi := &file_orders_v1_orders_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxLine) ProtoMessage() {}
+
+func (x *TaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxLine.ProtoReflect.Descriptor instead.
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaxLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *TaxLine) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TaxLine) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *TaxLine) GetRateBps() int32 {
+	if x != nil {
+		return x.RateBps
+	}
+	return 0
+}
+
+func (x *TaxLine) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+func (x *TaxLine) GetTaxableMinor() int64 {
+	if x != nil {
+		return x.TaxableMinor
+	}
+	return 0
+}
+
+func (x *TaxLine) GetTaxMinor() int64 {
+	if x != nil {
+		return x.TaxMinor
+	}
+	return 0
+}
+
+// Request to create a new order.
+type CreateOrderRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId        int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Items             []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ShipTo            *GeoPoint              `protobuf:"bytes,3,opt,name=ship_to,json=shipTo,proto3" json:"ship_to,omitempty"`
+	MaxBackorderWait  *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_backorder_wait,json=maxBackorderWait,proto3" json:"max_backorder_wait,omitempty"` // Unset waits the default of 14 days; at most 90 days.
+	CouponCodes       []string               `protobuf:"bytes,5,rep,name=coupon_codes,json=couponCodes,proto3" json:"coupon_codes,omitempty"`                  // Only coupons that are stackable can be combined.
+	ShippingAddress   *Address               `protobuf:"bytes,6,opt,name=shipping_address,json=shippingAddress,proto3" json:"shipping_address,omitempty"`
+	BillingAddress    *Address               `protobuf:"bytes,7,opt,name=billing_address,json=billingAddress,proto3" json:"billing_address,omitempty"`
+	Currency          string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                               // ISO 4217 code to price and pay the order in; defaults to the configured currency.
+	ShippingAddressId int64                  `protobuf:"varint,9,opt,name=shipping_address_id,json=shippingAddressId,proto3" json:"shipping_address_id,omitempty"` // Address book entry to ship to, instead of shipping_address. Without either, the default address is used.
+	BillingAddressId  int64                  `protobuf:"varint,10,opt,name=billing_address_id,json=billingAddressId,proto3" json:"billing_address_id,omitempty"`   // Address book entry to bill, instead of billing_address. Without either, the shipping address is billed.
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateOrderRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShipTo() *GeoPoint {
+	if x != nil {
+		return x.ShipTo
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetMaxBackorderWait() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackorderWait
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetCouponCodes() []string {
+	if x != nil {
+		return x.CouponCodes
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetShippingAddress() *Address {
+	if x != nil {
+		return x.ShippingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetBillingAddress() *Address {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return nil
+}
+
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetShippingAddressId() int64 {
+	if x != nil {
+		return x.ShippingAddressId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetBillingAddressId() int64 {
+	if x != nil {
+		return x.BillingAddressId
+	}
+	return 0
+}
+
+// Response for a create order request.
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request to retrieve an order.
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+// Response for a get order request.
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request to update an order.
+type UpdateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v1.OrderStatus" json:"status,omitempty"`
+	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // Allows for updating the items in an order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderRequest) Reset() {
+	*x = UpdateOrderRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderRequest) ProtoMessage() {}
+
+func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UpdateOrderRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Response for an update order request.
+type UpdateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderResponse) Reset() {
+	*x = UpdateOrderResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderResponse) ProtoMessage() {}
+
+func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request to list the orders of a customer.
+type ListCustomerOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v1.OrderStatus" json:"status,omitempty"` // Only orders in this status; every order when unspecified.
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerOrdersRequest) Reset() {
+	*x = ListCustomerOrdersRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerOrdersRequest) ProtoMessage() {}
+
+func (x *ListCustomerOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomerOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCustomerOrdersRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *ListCustomerOrdersRequest) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *ListCustomerOrdersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCustomerOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response for a list customer orders request. Orders carry their status,
+// currency and timestamps but not their items; get those from the order.
+type ListCustomerOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomerOrdersResponse) Reset() {
+	*x = ListCustomerOrdersResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomerOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomerOrdersResponse) ProtoMessage() {}
+
+func (x *ListCustomerOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomerOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomerOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListCustomerOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListCustomerOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to watch the progress of an order.
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // When set, the order must belong to this customer.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *WatchOrderRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+// Represents a single status transition of an order.
+type OrderEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type          OrderEventType         `protobuf:"varint,2,opt,name=type,proto3,enum=orders.v1.OrderEventType" json:"type,omitempty"`
+	Status        OrderStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=orders.v1.OrderStatus" json:"status,omitempty"` // Status of the order after the transition.
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Details such as the cancellation reason.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_orders_v1_orders_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *OrderEvent) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetType() OrderEventType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *OrderEvent) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *OrderEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request to report the outcome of a 3-D Secure challenge.
+type CompletePaymentAuthenticationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // When set, the order must belong to this customer.
+	Authenticated bool                   `protobuf:"varint,3,opt,name=authenticated,proto3" json:"authenticated,omitempty"`             // Whether the challenge was passed; the gateway confirms it before the payment is captured.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletePaymentAuthenticationRequest) Reset() {
+	*x = CompletePaymentAuthenticationRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePaymentAuthenticationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePaymentAuthenticationRequest) ProtoMessage() {}
+
+func (x *CompletePaymentAuthenticationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePaymentAuthenticationRequest.ProtoReflect.Descriptor instead.
+func (*CompletePaymentAuthenticationRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CompletePaymentAuthenticationRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CompletePaymentAuthenticationRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CompletePaymentAuthenticationRequest) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+// Response for a complete payment authentication request.
+type CompletePaymentAuthenticationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"` // The order as it was before the outcome was applied.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompletePaymentAuthenticationResponse) Reset() {
+	*x = CompletePaymentAuthenticationResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePaymentAuthenticationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePaymentAuthenticationResponse) ProtoMessage() {}
+
+func (x *CompletePaymentAuthenticationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePaymentAuthenticationResponse.ProtoReflect.Descriptor instead.
+func (*CompletePaymentAuthenticationResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CompletePaymentAuthenticationResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// Request to return items of a delivered order.
+type RequestReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"` // When set, the order must belong to this customer.
+	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`                              // Items and quantities to return; empty returns the whole order. Prices are taken from the order.
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnRequest) Reset() {
+	*x = RequestReturnRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnRequest) ProtoMessage() {}
+
+func (x *RequestReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnRequest.ProtoReflect.Descriptor instead.
+func (*RequestReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RequestReturnRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *RequestReturnRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *RequestReturnRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RequestReturnRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Response for a request return request.
+type RequestReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderReturn   *OrderReturn           `protobuf:"bytes,1,opt,name=order_return,json=orderReturn,proto3" json:"order_return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReturnResponse) Reset() {
+	*x = RequestReturnResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReturnResponse) ProtoMessage() {}
+
+func (x *RequestReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReturnResponse.ProtoReflect.Descriptor instead.
+func (*RequestReturnResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RequestReturnResponse) GetOrderReturn() *OrderReturn {
+	if x != nil {
+		return x.OrderReturn
+	}
+	return nil
+}
+
+// Request to mark the items of a return as received.
+type ReceiveReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnRequest) Reset() {
+	*x = ReceiveReturnRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnRequest) ProtoMessage() {}
+
+func (x *ReceiveReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReceiveReturnRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+// Response for a receive return request.
+type ReceiveReturnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderReturn   *OrderReturn           `protobuf:"bytes,1,opt,name=order_return,json=orderReturn,proto3" json:"order_return,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveReturnResponse) Reset() {
+	*x = ReceiveReturnResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveReturnResponse) ProtoMessage() {}
+
+func (x *ReceiveReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveReturnResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveReturnResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReceiveReturnResponse) GetOrderReturn() *OrderReturn {
+	if x != nil {
+		return x.OrderReturn
+	}
+	return nil
+}
+
+// Request to create a coupon.
+type CreateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponRequest) Reset() {
+	*x = CreateCouponRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponRequest) ProtoMessage() {}
+
+func (x *CreateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponRequest.ProtoReflect.Descriptor instead.
+func (*CreateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateCouponRequest) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// Response for a create coupon request.
+type CreateCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCouponResponse) Reset() {
+	*x = CreateCouponResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCouponResponse) ProtoMessage() {}
+
+func (x *CreateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCouponResponse.ProtoReflect.Descriptor instead.
+func (*CreateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateCouponResponse) GetCoupon() *Coupon {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+// Request to retrieve a coupon.
+type GetCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCouponRequest) Reset() {
+	*x = GetCouponRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCouponRequest) ProtoMessage() {}
+
+func (x *GetCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCouponRequest.ProtoReflect.Descriptor instead.
+func (*GetCouponRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Response for a get coupon request.
+type GetCouponResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId    int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,6,opt,name=status,proto3,enum=orders.v1.OrderStatus" json:"status,omitempty"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_orders_v1_orders_proto_msgTypes[0]
+func (x *GetCouponResponse) Reset() {
+	*x = GetCouponResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *GetCouponResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*GetCouponResponse) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[0]
+func (x *GetCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,78 +2516,78 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetCouponResponse.ProtoReflect.Descriptor instead.
+func (*GetCouponResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Order) GetOrderId() int64 {
+func (x *GetCouponResponse) GetCoupon() *Coupon {
 	if x != nil {
-		return x.OrderId
+		return x.Coupon
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetCustomerId() int64 {
-	if x != nil {
-		return x.CustomerId
-	}
-	return 0
+// Request to list coupons.
+type ListCouponsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) GetItems() []*OrderItem {
-	if x != nil {
-		return x.Items
-	}
-	return nil
+func (x *ListCouponsRequest) Reset() {
+	*x = ListCouponsRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *ListCouponsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Order) GetUpdatedAt() *timestamppb.Timestamp {
+func (*ListCouponsRequest) ProtoMessage() {}
+
+func (x *ListCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[31]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetStatus() OrderStatus {
-	if x != nil {
-		return x.Status
-	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+// Deprecated: Use ListCouponsRequest.ProtoReflect.Descriptor instead.
+func (*ListCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{31}
 }
 
-// Represents an item within an order.
-type OrderItem struct {
+// Response for a list coupons request.
+type ListCouponsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Coupons       []*Coupon              `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
-	mi := &file_orders_v1_orders_proto_msgTypes[1]
+func (x *ListCouponsResponse) Reset() {
+	*x = ListCouponsResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *ListCouponsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*ListCouponsResponse) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[1]
+func (x *ListCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -201,56 +2598,86 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListCouponsResponse.ProtoReflect.Descriptor instead.
+func (*ListCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *OrderItem) GetProductId() int64 {
+func (x *ListCouponsResponse) GetCoupons() []*Coupon {
 	if x != nil {
-		return x.ProductId
+		return x.Coupons
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+// Request to deactivate a coupon.
+type DeactivateCouponRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateCouponRequest) Reset() {
+	*x = DeactivateCouponRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateCouponRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateCouponRequest) ProtoMessage() {}
+
+func (x *DeactivateCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[33]
 	if x != nil {
-		return x.Quantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OrderItem) GetPrice() float64 {
+// Deprecated: Use DeactivateCouponRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateCouponRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeactivateCouponRequest) GetCode() string {
 	if x != nil {
-		return x.Price
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-// Request to create a new order.
-type CreateOrderRequest struct {
+// Response for a deactivate coupon request.
+type DeactivateCouponResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CustomerId    int64                  `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Coupon        *Coupon                `protobuf:"bytes,1,opt,name=coupon,proto3" json:"coupon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderRequest) Reset() {
-	*x = CreateOrderRequest{}
-	mi := &file_orders_v1_orders_proto_msgTypes[2]
+func (x *DeactivateCouponResponse) Reset() {
+	*x = DeactivateCouponResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderRequest) String() string {
+func (x *DeactivateCouponResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderRequest) ProtoMessage() {}
+func (*DeactivateCouponResponse) ProtoMessage() {}
 
-func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[2]
+func (x *DeactivateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,48 +2688,86 @@ func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeactivateCouponResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateOrderRequest) GetCustomerId() int64 {
+func (x *DeactivateCouponResponse) GetCoupon() *Coupon {
 	if x != nil {
-		return x.CustomerId
+		return x.Coupon
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateOrderRequest) GetItems() []*OrderItem {
+// Request to set a tax rate.
+type SetTaxRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *TaxRate               `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaxRateRequest) Reset() {
+	*x = SetTaxRateRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaxRateRequest) ProtoMessage() {}
+
+func (x *SetTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[35]
 	if x != nil {
-		return x.Items
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTaxRateRequest) GetRate() *TaxRate {
+	if x != nil {
+		return x.Rate
 	}
 	return nil
 }
 
-// Response for a create order request.
-type CreateOrderResponse struct {
+// Response for a set tax rate request.
+type SetTaxRateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Rate          *TaxRate               `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOrderResponse) Reset() {
-	*x = CreateOrderResponse{}
-	mi := &file_orders_v1_orders_proto_msgTypes[3]
+func (x *SetTaxRateResponse) Reset() {
+	*x = SetTaxRateResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOrderResponse) String() string {
+func (x *SetTaxRateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOrderResponse) ProtoMessage() {}
+func (*SetTaxRateResponse) ProtoMessage() {}
 
-func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[3]
+func (x *SetTaxRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,41 +2778,41 @@ func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SetTaxRateResponse.ProtoReflect.Descriptor instead.
+func (*SetTaxRateResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateOrderResponse) GetOrder() *Order {
+func (x *SetTaxRateResponse) GetRate() *TaxRate {
 	if x != nil {
-		return x.Order
+		return x.Rate
 	}
 	return nil
 }
 
-// Request to retrieve an order.
-type GetOrderRequest struct {
+// Request to list tax rates.
+type ListTaxRatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"` // Empty lists every country.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_orders_v1_orders_proto_msgTypes[4]
+func (x *ListTaxRatesRequest) Reset() {
+	*x = ListTaxRatesRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *ListTaxRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*ListTaxRatesRequest) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[4]
+func (x *ListTaxRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,41 +2823,41 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListTaxRatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaxRatesRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetOrderRequest) GetOrderId() int64 {
+func (x *ListTaxRatesRequest) GetCountry() string {
 	if x != nil {
-		return x.OrderId
+		return x.Country
 	}
-	return 0
+	return ""
 }
 
-// Response for a get order request.
-type GetOrderResponse struct {
+// Response for a list tax rates request.
+type ListTaxRatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Rates         []*TaxRate             `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_orders_v1_orders_proto_msgTypes[5]
+func (x *ListTaxRatesResponse) Reset() {
+	*x = ListTaxRatesResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *ListTaxRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*ListTaxRatesResponse) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[5]
+func (x *ListTaxRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,43 +2868,43 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListTaxRatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaxRatesResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *ListTaxRatesResponse) GetRates() []*TaxRate {
 	if x != nil {
-		return x.Order
+		return x.Rates
 	}
 	return nil
 }
 
-// Request to update an order.
-type UpdateOrderRequest struct {
+// Request to delete a tax rate.
+type DeleteTaxRateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        OrderStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=orders.v1.OrderStatus" json:"status,omitempty"`
-	Items         []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"` // Allows for updating the items in an order
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderRequest) Reset() {
-	*x = UpdateOrderRequest{}
-	mi := &file_orders_v1_orders_proto_msgTypes[6]
+func (x *DeleteTaxRateRequest) Reset() {
+	*x = DeleteTaxRateRequest{}
+	mi := &file_orders_v1_orders_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderRequest) String() string {
+func (x *DeleteTaxRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderRequest) ProtoMessage() {}
+func (*DeleteTaxRateRequest) ProtoMessage() {}
 
-func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[6]
+func (x *DeleteTaxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,55 +2915,55 @@ func (x *UpdateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DeleteTaxRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRateRequest) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateOrderRequest) GetOrderId() int64 {
+func (x *DeleteTaxRateRequest) GetCountry() string {
 	if x != nil {
-		return x.OrderId
+		return x.Country
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateOrderRequest) GetStatus() OrderStatus {
+func (x *DeleteTaxRateRequest) GetRegion() string {
 	if x != nil {
-		return x.Status
+		return x.Region
 	}
-	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *UpdateOrderRequest) GetItems() []*OrderItem {
+func (x *DeleteTaxRateRequest) GetCategory() string {
 	if x != nil {
-		return x.Items
+		return x.Category
 	}
-	return nil
+	return ""
 }
 
-// Response for an update order request.
-type UpdateOrderResponse struct {
+// Response for a delete tax rate request.
+type DeleteTaxRateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderResponse) Reset() {
-	*x = UpdateOrderResponse{}
-	mi := &file_orders_v1_orders_proto_msgTypes[7]
+func (x *DeleteTaxRateResponse) Reset() {
+	*x = DeleteTaxRateResponse{}
+	mi := &file_orders_v1_orders_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderResponse) String() string {
+func (x *DeleteTaxRateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderResponse) ProtoMessage() {}
+func (*DeleteTaxRateResponse) ProtoMessage() {}
 
-func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_v1_orders_proto_msgTypes[7]
+func (x *DeleteTaxRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_v1_orders_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,23 +2974,23 @@ func (x *UpdateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_orders_v1_orders_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteTaxRateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaxRateResponse) Descriptor() ([]byte, []int) {
+	return file_orders_v1_orders_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UpdateOrderResponse) GetOrder() *Order {
+func (x *DeleteTaxRateResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Order
+		return x.Deleted
 	}
-	return nil
+	return false
 }
 
 var File_orders_v1_orders_proto protoreflect.FileDescriptor
 
 const file_orders_v1_orders_proto_rawDesc = "" +
 	"\n" +
-	"\x16orders/v1/orders.proto\x12\torders.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x02\n" +
+	"\x16orders/v1/orders.proto\x12\torders.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14money/v1/money.proto\"\xa6\a\n" +
 	"\x05Order\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
 	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
@@ -535,16 +3000,121 @@ const file_orders_v1_orders_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12.\n" +
-	"\x06status\x18\x06 \x01(\x0e2\x16.orders.v1.OrderStatusR\x06status\"\\\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.orders.v1.OrderStatusR\x06status\x12,\n" +
+	"\apayment\x18\a \x01(\v2\x12.orders.v1.PaymentR\apayment\x12<\n" +
+	"\rreturn_status\x18\b \x01(\x0e2\x17.orders.v1.ReturnStatusR\freturnStatus\x12,\n" +
+	"\aship_to\x18\t \x01(\v2\x13.orders.v1.GeoPointR\x06shipTo\x121\n" +
+	"\tshipments\x18\n" +
+	" \x03(\v2\x13.orders.v1.ShipmentR\tshipments\x12G\n" +
+	"\x12max_backorder_wait\x18\v \x01(\v2\x19.google.protobuf.DurationR\x10maxBackorderWait\x12!\n" +
+	"\fcoupon_codes\x18\f \x03(\tR\vcouponCodes\x121\n" +
+	"\tdiscounts\x18\r \x03(\v2\x13.orders.v1.DiscountR\tdiscounts\x12=\n" +
+	"\x10shipping_address\x18\x0e \x01(\v2\x12.orders.v1.AddressR\x0fshippingAddress\x12;\n" +
+	"\x0fbilling_address\x18\x0f \x01(\v2\x12.orders.v1.AddressR\x0ebillingAddress\x12/\n" +
+	"\ttax_lines\x18\x10 \x03(\v2\x12.orders.v1.TaxLineR\btaxLines\x12\x1b\n" +
+	"\ttax_minor\x18\x11 \x01(\x03R\btaxMinor\x12\x1a\n" +
+	"\bcurrency\x18\x12 \x01(\tR\bcurrency\x12=\n" +
+	"\x0eexchange_rates\x18\x13 \x03(\v2\x16.money.v1.ExchangeRateR\rexchangeRates\"\xef\x01\n" +
+	"\aAddress\x12\x14\n" +
+	"\x05line1\x18\x01 \x01(\tR\x05line1\x12\x14\n" +
+	"\x05line2\x18\x02 \x01(\tR\x05line2\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x05 \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x12\x1c\n" +
+	"\trecipient\x18\a \x01(\tR\trecipient\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\t \x01(\x03R\taddressId\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"Y\n" +
+	"\bShipment\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.orders.v1.OrderItemR\x05items\"\xbe\x01\n" +
 	"\tOrderItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\"a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x12 \n" +
+	"\vbackordered\x18\x04 \x01(\bR\vbackordered\x12(\n" +
+	"\x10unit_price_minor\x18\x05 \x01(\x03R\x0eunitPriceMinor\x12\x10\n" +
+	"\x03sku\x18\x06 \x01(\tR\x03sku\"\xeb\x01\n" +
+	"\aPayment\x12)\n" +
+	"\x10authorization_id\x18\x01 \x01(\tR\x0fauthorizationId\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.orders.v1.PaymentStatusR\x06status\x12!\n" +
+	"\famount_minor\x18\x03 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12%\n" +
+	"\x0efailure_reason\x18\x05 \x01(\tR\rfailureReason\x12\x1d\n" +
+	"\n" +
+	"action_url\x18\x06 \x01(\tR\tactionUrl\"\xe8\x02\n" +
+	"\vOrderReturn\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x12*\n" +
+	"\x05items\x18\x03 \x03(\v2\x14.orders.v1.OrderItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12/\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x17.orders.v1.ReturnStatusR\x06status\x12.\n" +
+	"\x13refund_amount_minor\x18\x06 \x01(\x03R\x11refundAmountMinor\x12=\n" +
+	"\frequested_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x04\n" +
+	"\x06Coupon\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.orders.v1.CouponTypeR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vpercent_off\x18\x04 \x01(\x05R\n" +
+	"percentOff\x12(\n" +
+	"\x10amount_off_minor\x18\x05 \x01(\x03R\x0eamountOffMinor\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x06 \x01(\x03R\tproductId\x12!\n" +
+	"\fbuy_quantity\x18\a \x01(\x05R\vbuyQuantity\x12!\n" +
+	"\fget_quantity\x18\b \x01(\x05R\vgetQuantity\x12(\n" +
+	"\x10min_basket_minor\x18\t \x01(\x03R\x0eminBasketMinor\x12,\n" +
+	"\x12per_customer_limit\x18\n" +
+	" \x01(\x05R\x10perCustomerLimit\x127\n" +
+	"\tstarts_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1c\n" +
+	"\tstackable\x18\r \x01(\bR\tstackable\x12\x16\n" +
+	"\x06active\x18\x0e \x01(\bR\x06active\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\"\x8f\x01\n" +
+	"\bDiscount\x12\x1f\n" +
+	"\vcoupon_code\x18\x01 \x01(\tR\n" +
+	"couponCode\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12!\n" +
+	"\famount_minor\x18\x04 \x01(\x03R\vamountMinor\"\xb2\x01\n" +
+	"\aTaxRate\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x19\n" +
+	"\brate_bps\x18\x04 \x01(\x05R\arateBps\x12\x1c\n" +
+	"\tinclusive\x18\x05 \x01(\bR\tinclusive\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xe3\x01\n" +
+	"\aTaxLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\"\n" +
+	"\fjurisdiction\x18\x03 \x01(\tR\fjurisdiction\x12\x19\n" +
+	"\brate_bps\x18\x04 \x01(\x05R\arateBps\x12\x1c\n" +
+	"\tinclusive\x18\x05 \x01(\bR\tinclusive\x12#\n" +
+	"\rtaxable_minor\x18\x06 \x01(\x03R\ftaxableMinor\x12\x1b\n" +
+	"\ttax_minor\x18\a \x01(\x03R\btaxMinor\"\xf1\x03\n" +
 	"\x12CreateOrderRequest\x12\x1f\n" +
 	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
 	"customerId\x12*\n" +
-	"\x05items\x18\x02 \x03(\v2\x14.orders.v1.OrderItemR\x05items\"=\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.orders.v1.OrderItemR\x05items\x12,\n" +
+	"\aship_to\x18\x03 \x01(\v2\x13.orders.v1.GeoPointR\x06shipTo\x12G\n" +
+	"\x12max_backorder_wait\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x10maxBackorderWait\x12!\n" +
+	"\fcoupon_codes\x18\x05 \x03(\tR\vcouponCodes\x12=\n" +
+	"\x10shipping_address\x18\x06 \x01(\v2\x12.orders.v1.AddressR\x0fshippingAddress\x12;\n" +
+	"\x0fbilling_address\x18\a \x01(\v2\x12.orders.v1.AddressR\x0ebillingAddress\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12.\n" +
+	"\x13shipping_address_id\x18\t \x01(\x03R\x11shippingAddressId\x12,\n" +
+	"\x12billing_address_id\x18\n" +
+	" \x01(\x03R\x10billingAddressId\"=\n" +
 	"\x13CreateOrderResponse\x12&\n" +
 	"\x05order\x18\x01 \x01(\v2\x10.orders.v1.OrderR\x05order\",\n" +
 	"\x0fGetOrderRequest\x12\x19\n" +
@@ -556,18 +3126,141 @@ const file_orders_v1_orders_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x0e2\x16.orders.v1.OrderStatusR\x06status\x12*\n" +
 	"\x05items\x18\x03 \x03(\v2\x14.orders.v1.OrderItemR\x05items\"=\n" +
 	"\x13UpdateOrderResponse\x12&\n" +
-	"\x05order\x18\x01 \x01(\v2\x10.orders.v1.OrderR\x05order*\xb4\x01\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.orders.v1.OrderR\x05order\"\xa8\x01\n" +
+	"\x19ListCustomerOrdersRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\x03R\n" +
+	"customerId\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.orders.v1.OrderStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"n\n" +
+	"\x1aListCustomerOrdersResponse\x12(\n" +
+	"\x06orders\x18\x01 \x03(\v2\x10.orders.v1.OrderR\x06orders\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"O\n" +
+	"\x11WatchOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\"\xdd\x01\n" +
+	"\n" +
+	"OrderEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.orders.v1.OrderEventTypeR\x04type\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.orders.v1.OrderStatusR\x06status\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\x88\x01\n" +
+	"$CompletePaymentAuthenticationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x12$\n" +
+	"\rauthenticated\x18\x03 \x01(\bR\rauthenticated\"O\n" +
+	"%CompletePaymentAuthenticationResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.orders.v1.OrderR\x05order\"\x96\x01\n" +
+	"\x14RequestReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\x03R\n" +
+	"customerId\x12*\n" +
+	"\x05items\x18\x03 \x03(\v2\x14.orders.v1.OrderItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"R\n" +
+	"\x15RequestReturnResponse\x129\n" +
+	"\forder_return\x18\x01 \x01(\v2\x16.orders.v1.OrderReturnR\vorderReturn\"1\n" +
+	"\x14ReceiveReturnRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\"R\n" +
+	"\x15ReceiveReturnResponse\x129\n" +
+	"\forder_return\x18\x01 \x01(\v2\x16.orders.v1.OrderReturnR\vorderReturn\"@\n" +
+	"\x13CreateCouponRequest\x12)\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x11.orders.v1.CouponR\x06coupon\"A\n" +
+	"\x14CreateCouponResponse\x12)\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x11.orders.v1.CouponR\x06coupon\"&\n" +
+	"\x10GetCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\">\n" +
+	"\x11GetCouponResponse\x12)\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x11.orders.v1.CouponR\x06coupon\"\x14\n" +
+	"\x12ListCouponsRequest\"B\n" +
+	"\x13ListCouponsResponse\x12+\n" +
+	"\acoupons\x18\x01 \x03(\v2\x11.orders.v1.CouponR\acoupons\"-\n" +
+	"\x17DeactivateCouponRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"E\n" +
+	"\x18DeactivateCouponResponse\x12)\n" +
+	"\x06coupon\x18\x01 \x01(\v2\x11.orders.v1.CouponR\x06coupon\";\n" +
+	"\x11SetTaxRateRequest\x12&\n" +
+	"\x04rate\x18\x01 \x01(\v2\x12.orders.v1.TaxRateR\x04rate\"<\n" +
+	"\x12SetTaxRateResponse\x12&\n" +
+	"\x04rate\x18\x01 \x01(\v2\x12.orders.v1.TaxRateR\x04rate\"/\n" +
+	"\x13ListTaxRatesRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\"@\n" +
+	"\x14ListTaxRatesResponse\x12(\n" +
+	"\x05rates\x18\x01 \x03(\v2\x12.orders.v1.TaxRateR\x05rates\"d\n" +
+	"\x14DeleteTaxRateRequest\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"1\n" +
+	"\x15DeleteTaxRateResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted*\xd2\x01\n" +
 	"\vOrderStatus\x12\x1c\n" +
 	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ORDER_STATUS_CREATED\x10\x01\x12\x1b\n" +
 	"\x17ORDER_STATUS_PROCESSING\x10\x02\x12\x18\n" +
 	"\x14ORDER_STATUS_SHIPPED\x10\x03\x12\x1a\n" +
 	"\x16ORDER_STATUS_DELIVERED\x10\x04\x12\x1a\n" +
-	"\x16ORDER_STATUS_CANCELLED\x10\x052\xef\x01\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x05\x12\x1c\n" +
+	"\x18ORDER_STATUS_BACKORDERED\x10\x06*\x9b\x02\n" +
+	"\rPaymentStatus\x12\x1e\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1ePAYMENT_STATUS_REQUIRES_ACTION\x10\x02\x12\x1d\n" +
+	"\x19PAYMENT_STATUS_AUTHORIZED\x10\x03\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_CAPTURED\x10\x04\x12\x19\n" +
+	"\x15PAYMENT_STATUS_VOIDED\x10\x05\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_DECLINED\x10\x06\x12\x19\n" +
+	"\x15PAYMENT_STATUS_FAILED\x10\a\x12\x1b\n" +
+	"\x17PAYMENT_STATUS_REFUNDED\x10\b*\x9d\x01\n" +
+	"\fReturnStatus\x12\x1d\n" +
+	"\x19RETURN_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17RETURN_STATUS_REQUESTED\x10\x01\x12\x1a\n" +
+	"\x16RETURN_STATUS_RECEIVED\x10\x02\x12\x1a\n" +
+	"\x16RETURN_STATUS_REFUNDED\x10\x03\x12\x19\n" +
+	"\x15RETURN_STATUS_EXPIRED\x10\x04*\x80\x01\n" +
+	"\n" +
+	"CouponType\x12\x1b\n" +
+	"\x17COUPON_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16COUPON_TYPE_PERCENTAGE\x10\x01\x12\x1c\n" +
+	"\x18COUPON_TYPE_FIXED_AMOUNT\x10\x02\x12\x1b\n" +
+	"\x17COUPON_TYPE_BUY_X_GET_Y\x10\x03*\x8f\x04\n" +
+	"\x0eOrderEventType\x12 \n" +
+	"\x1cORDER_EVENT_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"ORDER_EVENT_TYPE_CUSTOMER_VERIFIED\x10\x01\x12#\n" +
+	"\x1fORDER_EVENT_TYPE_STOCK_RESERVED\x10\x02\x12\x1c\n" +
+	"\x18ORDER_EVENT_TYPE_CREATED\x10\x03\x12\x1c\n" +
+	"\x18ORDER_EVENT_TYPE_SHIPPED\x10\x04\x12\x1e\n" +
+	"\x1aORDER_EVENT_TYPE_DELIVERED\x10\x05\x12\x1e\n" +
+	"\x1aORDER_EVENT_TYPE_CANCELLED\x10\x06\x12,\n" +
+	"(ORDER_EVENT_TYPE_PAYMENT_ACTION_REQUIRED\x10\a\x12'\n" +
+	"#ORDER_EVENT_TYPE_PAYMENT_AUTHORIZED\x10\b\x12%\n" +
+	"!ORDER_EVENT_TYPE_PAYMENT_CAPTURED\x10\t\x12#\n" +
+	"\x1fORDER_EVENT_TYPE_PAYMENT_VOIDED\x10\n" +
+	"\x12 \n" +
+	"\x1cORDER_EVENT_TYPE_BACKORDERED\x10\v\x12&\n" +
+	"\"ORDER_EVENT_TYPE_STOCK_REPLENISHED\x10\f\x12%\n" +
+	"!ORDER_EVENT_TYPE_PAYMENT_REFUNDED\x10\r2\xf8\t\n" +
 	"\fOrderService\x12L\n" +
 	"\vCreateOrder\x12\x1d.orders.v1.CreateOrderRequest\x1a\x1e.orders.v1.CreateOrderResponse\x12C\n" +
 	"\bGetOrder\x12\x1a.orders.v1.GetOrderRequest\x1a\x1b.orders.v1.GetOrderResponse\x12L\n" +
-	"\vUpdateOrder\x12\x1d.orders.v1.UpdateOrderRequest\x1a\x1e.orders.v1.UpdateOrderResponseB\x9a\x01\n" +
+	"\vUpdateOrder\x12\x1d.orders.v1.UpdateOrderRequest\x1a\x1e.orders.v1.UpdateOrderResponse\x12a\n" +
+	"\x12ListCustomerOrders\x12$.orders.v1.ListCustomerOrdersRequest\x1a%.orders.v1.ListCustomerOrdersResponse\x12C\n" +
+	"\n" +
+	"WatchOrder\x12\x1c.orders.v1.WatchOrderRequest\x1a\x15.orders.v1.OrderEvent0\x01\x12\x82\x01\n" +
+	"\x1dCompletePaymentAuthentication\x12/.orders.v1.CompletePaymentAuthenticationRequest\x1a0.orders.v1.CompletePaymentAuthenticationResponse\x12R\n" +
+	"\rRequestReturn\x12\x1f.orders.v1.RequestReturnRequest\x1a .orders.v1.RequestReturnResponse\x12R\n" +
+	"\rReceiveReturn\x12\x1f.orders.v1.ReceiveReturnRequest\x1a .orders.v1.ReceiveReturnResponse\x12O\n" +
+	"\fCreateCoupon\x12\x1e.orders.v1.CreateCouponRequest\x1a\x1f.orders.v1.CreateCouponResponse\x12F\n" +
+	"\tGetCoupon\x12\x1b.orders.v1.GetCouponRequest\x1a\x1c.orders.v1.GetCouponResponse\x12L\n" +
+	"\vListCoupons\x12\x1d.orders.v1.ListCouponsRequest\x1a\x1e.orders.v1.ListCouponsResponse\x12[\n" +
+	"\x10DeactivateCoupon\x12\".orders.v1.DeactivateCouponRequest\x1a#.orders.v1.DeactivateCouponResponse\x12I\n" +
+	"\n" +
+	"SetTaxRate\x12\x1c.orders.v1.SetTaxRateRequest\x1a\x1d.orders.v1.SetTaxRateResponse\x12O\n" +
+	"\fListTaxRates\x12\x1e.orders.v1.ListTaxRatesRequest\x1a\x1f.orders.v1.ListTaxRatesResponse\x12R\n" +
+	"\rDeleteTaxRate\x12\x1f.orders.v1.DeleteTaxRateRequest\x1a .orders.v1.DeleteTaxRateResponseB\x9a\x01\n" +
 	"\rcom.orders.v1B\vOrdersProtoP\x01Z7github.com/bufbuild/buf-examples/gen/orders/v1;ordersv1\xa2\x02\x03OXX\xaa\x02\tOrders.V1\xca\x02\tOrders\\V1\xe2\x02\x15Orders\\V1\\GPBMetadata\xea\x02\n" +
 	"Orders::V1b\x06proto3"
 
@@ -583,42 +3276,145 @@ func file_orders_v1_orders_proto_rawDescGZIP() []byte {
 	return file_orders_v1_orders_proto_rawDescData
 }
 
-var file_orders_v1_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_orders_v1_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_orders_v1_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_orders_v1_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_orders_v1_orders_proto_goTypes = []any{
-	(OrderStatus)(0),              // 0: orders.v1.OrderStatus
-	(*Order)(nil),                 // 1: orders.v1.Order
-	(*OrderItem)(nil),             // 2: orders.v1.OrderItem
-	(*CreateOrderRequest)(nil),    // 3: orders.v1.CreateOrderRequest
-	(*CreateOrderResponse)(nil),   // 4: orders.v1.CreateOrderResponse
-	(*GetOrderRequest)(nil),       // 5: orders.v1.GetOrderRequest
-	(*GetOrderResponse)(nil),      // 6: orders.v1.GetOrderResponse
-	(*UpdateOrderRequest)(nil),    // 7: orders.v1.UpdateOrderRequest
-	(*UpdateOrderResponse)(nil),   // 8: orders.v1.UpdateOrderResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(OrderStatus)(0),                              // 0: orders.v1.OrderStatus
+	(PaymentStatus)(0),                            // 1: orders.v1.PaymentStatus
+	(ReturnStatus)(0),                             // 2: orders.v1.ReturnStatus
+	(CouponType)(0),                               // 3: orders.v1.CouponType
+	(OrderEventType)(0),                           // 4: orders.v1.OrderEventType
+	(*Order)(nil),                                 // 5: orders.v1.Order
+	(*Address)(nil),                               // 6: orders.v1.Address
+	(*GeoPoint)(nil),                              // 7: orders.v1.GeoPoint
+	(*Shipment)(nil),                              // 8: orders.v1.Shipment
+	(*OrderItem)(nil),                             // 9: orders.v1.OrderItem
+	(*Payment)(nil),                               // 10: orders.v1.Payment
+	(*OrderReturn)(nil),                           // 11: orders.v1.OrderReturn
+	(*Coupon)(nil),                                // 12: orders.v1.Coupon
+	(*Discount)(nil),                              // 13: orders.v1.Discount
+	(*TaxRate)(nil),                               // 14: orders.v1.TaxRate
+	(*TaxLine)(nil),                               // 15: orders.v1.TaxLine
+	(*CreateOrderRequest)(nil),                    // 16: orders.v1.CreateOrderRequest
+	(*CreateOrderResponse)(nil),                   // 17: orders.v1.CreateOrderResponse
+	(*GetOrderRequest)(nil),                       // 18: orders.v1.GetOrderRequest
+	(*GetOrderResponse)(nil),                      // 19: orders.v1.GetOrderResponse
+	(*UpdateOrderRequest)(nil),                    // 20: orders.v1.UpdateOrderRequest
+	(*UpdateOrderResponse)(nil),                   // 21: orders.v1.UpdateOrderResponse
+	(*ListCustomerOrdersRequest)(nil),             // 22: orders.v1.ListCustomerOrdersRequest
+	(*ListCustomerOrdersResponse)(nil),            // 23: orders.v1.ListCustomerOrdersResponse
+	(*WatchOrderRequest)(nil),                     // 24: orders.v1.WatchOrderRequest
+	(*OrderEvent)(nil),                            // 25: orders.v1.OrderEvent
+	(*CompletePaymentAuthenticationRequest)(nil),  // 26: orders.v1.CompletePaymentAuthenticationRequest
+	(*CompletePaymentAuthenticationResponse)(nil), // 27: orders.v1.CompletePaymentAuthenticationResponse
+	(*RequestReturnRequest)(nil),                  // 28: orders.v1.RequestReturnRequest
+	(*RequestReturnResponse)(nil),                 // 29: orders.v1.RequestReturnResponse
+	(*ReceiveReturnRequest)(nil),                  // 30: orders.v1.ReceiveReturnRequest
+	(*ReceiveReturnResponse)(nil),                 // 31: orders.v1.ReceiveReturnResponse
+	(*CreateCouponRequest)(nil),                   // 32: orders.v1.CreateCouponRequest
+	(*CreateCouponResponse)(nil),                  // 33: orders.v1.CreateCouponResponse
+	(*GetCouponRequest)(nil),                      // 34: orders.v1.GetCouponRequest
+	(*GetCouponResponse)(nil),                     // 35: orders.v1.GetCouponResponse
+	(*ListCouponsRequest)(nil),                    // 36: orders.v1.ListCouponsRequest
+	(*ListCouponsResponse)(nil),                   // 37: orders.v1.ListCouponsResponse
+	(*DeactivateCouponRequest)(nil),               // 38: orders.v1.DeactivateCouponRequest
+	(*DeactivateCouponResponse)(nil),              // 39: orders.v1.DeactivateCouponResponse
+	(*SetTaxRateRequest)(nil),                     // 40: orders.v1.SetTaxRateRequest
+	(*SetTaxRateResponse)(nil),                    // 41: orders.v1.SetTaxRateResponse
+	(*ListTaxRatesRequest)(nil),                   // 42: orders.v1.ListTaxRatesRequest
+	(*ListTaxRatesResponse)(nil),                  // 43: orders.v1.ListTaxRatesResponse
+	(*DeleteTaxRateRequest)(nil),                  // 44: orders.v1.DeleteTaxRateRequest
+	(*DeleteTaxRateResponse)(nil),                 // 45: orders.v1.DeleteTaxRateResponse
+	(*timestamppb.Timestamp)(nil),                 // 46: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                   // 47: google.protobuf.Duration
+	(*v1.ExchangeRate)(nil),                       // 48: money.v1.ExchangeRate
 }
 var file_orders_v1_orders_proto_depIdxs = []int32{
-	2,  // 0: orders.v1.Order.items:type_name -> orders.v1.OrderItem
-	9,  // 1: orders.v1.Order.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 2: orders.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 0: orders.v1.Order.items:type_name -> orders.v1.OrderItem
+	46, // 1: orders.v1.Order.created_at:type_name -> google.protobuf.Timestamp
+	46, // 2: orders.v1.Order.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: orders.v1.Order.status:type_name -> orders.v1.OrderStatus
-	2,  // 4: orders.v1.CreateOrderRequest.items:type_name -> orders.v1.OrderItem
-	1,  // 5: orders.v1.CreateOrderResponse.order:type_name -> orders.v1.Order
-	1,  // 6: orders.v1.GetOrderResponse.order:type_name -> orders.v1.Order
-	0,  // 7: orders.v1.UpdateOrderRequest.status:type_name -> orders.v1.OrderStatus
-	2,  // 8: orders.v1.UpdateOrderRequest.items:type_name -> orders.v1.OrderItem
-	1,  // 9: orders.v1.UpdateOrderResponse.order:type_name -> orders.v1.Order
-	3,  // 10: orders.v1.OrderService.CreateOrder:input_type -> orders.v1.CreateOrderRequest
-	5,  // 11: orders.v1.OrderService.GetOrder:input_type -> orders.v1.GetOrderRequest
-	7,  // 12: orders.v1.OrderService.UpdateOrder:input_type -> orders.v1.UpdateOrderRequest
-	4,  // 13: orders.v1.OrderService.CreateOrder:output_type -> orders.v1.CreateOrderResponse
-	6,  // 14: orders.v1.OrderService.GetOrder:output_type -> orders.v1.GetOrderResponse
-	8,  // 15: orders.v1.OrderService.UpdateOrder:output_type -> orders.v1.UpdateOrderResponse
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	10, // 4: orders.v1.Order.payment:type_name -> orders.v1.Payment
+	2,  // 5: orders.v1.Order.return_status:type_name -> orders.v1.ReturnStatus
+	7,  // 6: orders.v1.Order.ship_to:type_name -> orders.v1.GeoPoint
+	8,  // 7: orders.v1.Order.shipments:type_name -> orders.v1.Shipment
+	47, // 8: orders.v1.Order.max_backorder_wait:type_name -> google.protobuf.Duration
+	13, // 9: orders.v1.Order.discounts:type_name -> orders.v1.Discount
+	6,  // 10: orders.v1.Order.shipping_address:type_name -> orders.v1.Address
+	6,  // 11: orders.v1.Order.billing_address:type_name -> orders.v1.Address
+	15, // 12: orders.v1.Order.tax_lines:type_name -> orders.v1.TaxLine
+	48, // 13: orders.v1.Order.exchange_rates:type_name -> money.v1.ExchangeRate
+	9,  // 14: orders.v1.Shipment.items:type_name -> orders.v1.OrderItem
+	1,  // 15: orders.v1.Payment.status:type_name -> orders.v1.PaymentStatus
+	9,  // 16: orders.v1.OrderReturn.items:type_name -> orders.v1.OrderItem
+	2,  // 17: orders.v1.OrderReturn.status:type_name -> orders.v1.ReturnStatus
+	46, // 18: orders.v1.OrderReturn.requested_at:type_name -> google.protobuf.Timestamp
+	46, // 19: orders.v1.OrderReturn.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 20: orders.v1.Coupon.type:type_name -> orders.v1.CouponType
+	46, // 21: orders.v1.Coupon.starts_at:type_name -> google.protobuf.Timestamp
+	46, // 22: orders.v1.Coupon.ends_at:type_name -> google.protobuf.Timestamp
+	9,  // 23: orders.v1.CreateOrderRequest.items:type_name -> orders.v1.OrderItem
+	7,  // 24: orders.v1.CreateOrderRequest.ship_to:type_name -> orders.v1.GeoPoint
+	47, // 25: orders.v1.CreateOrderRequest.max_backorder_wait:type_name -> google.protobuf.Duration
+	6,  // 26: orders.v1.CreateOrderRequest.shipping_address:type_name -> orders.v1.Address
+	6,  // 27: orders.v1.CreateOrderRequest.billing_address:type_name -> orders.v1.Address
+	5,  // 28: orders.v1.CreateOrderResponse.order:type_name -> orders.v1.Order
+	5,  // 29: orders.v1.GetOrderResponse.order:type_name -> orders.v1.Order
+	0,  // 30: orders.v1.UpdateOrderRequest.status:type_name -> orders.v1.OrderStatus
+	9,  // 31: orders.v1.UpdateOrderRequest.items:type_name -> orders.v1.OrderItem
+	5,  // 32: orders.v1.UpdateOrderResponse.order:type_name -> orders.v1.Order
+	0,  // 33: orders.v1.ListCustomerOrdersRequest.status:type_name -> orders.v1.OrderStatus
+	5,  // 34: orders.v1.ListCustomerOrdersResponse.orders:type_name -> orders.v1.Order
+	4,  // 35: orders.v1.OrderEvent.type:type_name -> orders.v1.OrderEventType
+	0,  // 36: orders.v1.OrderEvent.status:type_name -> orders.v1.OrderStatus
+	46, // 37: orders.v1.OrderEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	5,  // 38: orders.v1.CompletePaymentAuthenticationResponse.order:type_name -> orders.v1.Order
+	9,  // 39: orders.v1.RequestReturnRequest.items:type_name -> orders.v1.OrderItem
+	11, // 40: orders.v1.RequestReturnResponse.order_return:type_name -> orders.v1.OrderReturn
+	11, // 41: orders.v1.ReceiveReturnResponse.order_return:type_name -> orders.v1.OrderReturn
+	12, // 42: orders.v1.CreateCouponRequest.coupon:type_name -> orders.v1.Coupon
+	12, // 43: orders.v1.CreateCouponResponse.coupon:type_name -> orders.v1.Coupon
+	12, // 44: orders.v1.GetCouponResponse.coupon:type_name -> orders.v1.Coupon
+	12, // 45: orders.v1.ListCouponsResponse.coupons:type_name -> orders.v1.Coupon
+	12, // 46: orders.v1.DeactivateCouponResponse.coupon:type_name -> orders.v1.Coupon
+	14, // 47: orders.v1.SetTaxRateRequest.rate:type_name -> orders.v1.TaxRate
+	14, // 48: orders.v1.SetTaxRateResponse.rate:type_name -> orders.v1.TaxRate
+	14, // 49: orders.v1.ListTaxRatesResponse.rates:type_name -> orders.v1.TaxRate
+	16, // 50: orders.v1.OrderService.CreateOrder:input_type -> orders.v1.CreateOrderRequest
+	18, // 51: orders.v1.OrderService.GetOrder:input_type -> orders.v1.GetOrderRequest
+	20, // 52: orders.v1.OrderService.UpdateOrder:input_type -> orders.v1.UpdateOrderRequest
+	22, // 53: orders.v1.OrderService.ListCustomerOrders:input_type -> orders.v1.ListCustomerOrdersRequest
+	24, // 54: orders.v1.OrderService.WatchOrder:input_type -> orders.v1.WatchOrderRequest
+	26, // 55: orders.v1.OrderService.CompletePaymentAuthentication:input_type -> orders.v1.CompletePaymentAuthenticationRequest
+	28, // 56: orders.v1.OrderService.RequestReturn:input_type -> orders.v1.RequestReturnRequest
+	30, // 57: orders.v1.OrderService.ReceiveReturn:input_type -> orders.v1.ReceiveReturnRequest
+	32, // 58: orders.v1.OrderService.CreateCoupon:input_type -> orders.v1.CreateCouponRequest
+	34, // 59: orders.v1.OrderService.GetCoupon:input_type -> orders.v1.GetCouponRequest
+	36, // 60: orders.v1.OrderService.ListCoupons:input_type -> orders.v1.ListCouponsRequest
+	38, // 61: orders.v1.OrderService.DeactivateCoupon:input_type -> orders.v1.DeactivateCouponRequest
+	40, // 62: orders.v1.OrderService.SetTaxRate:input_type -> orders.v1.SetTaxRateRequest
+	42, // 63: orders.v1.OrderService.ListTaxRates:input_type -> orders.v1.ListTaxRatesRequest
+	44, // 64: orders.v1.OrderService.DeleteTaxRate:input_type -> orders.v1.DeleteTaxRateRequest
+	17, // 65: orders.v1.OrderService.CreateOrder:output_type -> orders.v1.CreateOrderResponse
+	19, // 66: orders.v1.OrderService.GetOrder:output_type -> orders.v1.GetOrderResponse
+	21, // 67: orders.v1.OrderService.UpdateOrder:output_type -> orders.v1.UpdateOrderResponse
+	23, // 68: orders.v1.OrderService.ListCustomerOrders:output_type -> orders.v1.ListCustomerOrdersResponse
+	25, // 69: orders.v1.OrderService.WatchOrder:output_type -> orders.v1.OrderEvent
+	27, // 70: orders.v1.OrderService.CompletePaymentAuthentication:output_type -> orders.v1.CompletePaymentAuthenticationResponse
+	29, // 71: orders.v1.OrderService.RequestReturn:output_type -> orders.v1.RequestReturnResponse
+	31, // 72: orders.v1.OrderService.ReceiveReturn:output_type -> orders.v1.ReceiveReturnResponse
+	33, // 73: orders.v1.OrderService.CreateCoupon:output_type -> orders.v1.CreateCouponResponse
+	35, // 74: orders.v1.OrderService.GetCoupon:output_type -> orders.v1.GetCouponResponse
+	37, // 75: orders.v1.OrderService.ListCoupons:output_type -> orders.v1.ListCouponsResponse
+	39, // 76: orders.v1.OrderService.DeactivateCoupon:output_type -> orders.v1.DeactivateCouponResponse
+	41, // 77: orders.v1.OrderService.SetTaxRate:output_type -> orders.v1.SetTaxRateResponse
+	43, // 78: orders.v1.OrderService.ListTaxRates:output_type -> orders.v1.ListTaxRatesResponse
+	45, // 79: orders.v1.OrderService.DeleteTaxRate:output_type -> orders.v1.DeleteTaxRateResponse
+	65, // [65:80] is the sub-list for method output_type
+	50, // [50:65] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_orders_v1_orders_proto_init() }
@@ -631,8 +3427,8 @@ func file_orders_v1_orders_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_orders_v1_orders_proto_rawDesc), len(file_orders_v1_orders_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
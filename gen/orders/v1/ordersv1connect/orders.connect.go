@@ -42,6 +42,39 @@ const (
 	// OrderServiceUpdateOrderProcedure is the fully-qualified name of the OrderService's UpdateOrder
 	// RPC.
 	OrderServiceUpdateOrderProcedure = "/orders.v1.OrderService/UpdateOrder"
+	// OrderServiceListCustomerOrdersProcedure is the fully-qualified name of the OrderService's
+	// ListCustomerOrders RPC.
+	OrderServiceListCustomerOrdersProcedure = "/orders.v1.OrderService/ListCustomerOrders"
+	// OrderServiceWatchOrderProcedure is the fully-qualified name of the OrderService's WatchOrder RPC.
+	OrderServiceWatchOrderProcedure = "/orders.v1.OrderService/WatchOrder"
+	// OrderServiceCompletePaymentAuthenticationProcedure is the fully-qualified name of the
+	// OrderService's CompletePaymentAuthentication RPC.
+	OrderServiceCompletePaymentAuthenticationProcedure = "/orders.v1.OrderService/CompletePaymentAuthentication"
+	// OrderServiceRequestReturnProcedure is the fully-qualified name of the OrderService's
+	// RequestReturn RPC.
+	OrderServiceRequestReturnProcedure = "/orders.v1.OrderService/RequestReturn"
+	// OrderServiceReceiveReturnProcedure is the fully-qualified name of the OrderService's
+	// ReceiveReturn RPC.
+	OrderServiceReceiveReturnProcedure = "/orders.v1.OrderService/ReceiveReturn"
+	// OrderServiceCreateCouponProcedure is the fully-qualified name of the OrderService's CreateCoupon
+	// RPC.
+	OrderServiceCreateCouponProcedure = "/orders.v1.OrderService/CreateCoupon"
+	// OrderServiceGetCouponProcedure is the fully-qualified name of the OrderService's GetCoupon RPC.
+	OrderServiceGetCouponProcedure = "/orders.v1.OrderService/GetCoupon"
+	// OrderServiceListCouponsProcedure is the fully-qualified name of the OrderService's ListCoupons
+	// RPC.
+	OrderServiceListCouponsProcedure = "/orders.v1.OrderService/ListCoupons"
+	// OrderServiceDeactivateCouponProcedure is the fully-qualified name of the OrderService's
+	// DeactivateCoupon RPC.
+	OrderServiceDeactivateCouponProcedure = "/orders.v1.OrderService/DeactivateCoupon"
+	// OrderServiceSetTaxRateProcedure is the fully-qualified name of the OrderService's SetTaxRate RPC.
+	OrderServiceSetTaxRateProcedure = "/orders.v1.OrderService/SetTaxRate"
+	// OrderServiceListTaxRatesProcedure is the fully-qualified name of the OrderService's ListTaxRates
+	// RPC.
+	OrderServiceListTaxRatesProcedure = "/orders.v1.OrderService/ListTaxRates"
+	// OrderServiceDeleteTaxRateProcedure is the fully-qualified name of the OrderService's
+	// DeleteTaxRate RPC.
+	OrderServiceDeleteTaxRateProcedure = "/orders.v1.OrderService/DeleteTaxRate"
 )
 
 // OrderServiceClient is a client for the orders.v1.OrderService service.
@@ -52,6 +85,30 @@ type OrderServiceClient interface {
 	GetOrder(context.Context, *connect.Request[v1.GetOrderRequest]) (*connect.Response[v1.GetOrderResponse], error)
 	// Updates an existing order.
 	UpdateOrder(context.Context, *connect.Request[v1.UpdateOrderRequest]) (*connect.Response[v1.UpdateOrderResponse], error)
+	// Lists the orders of a customer, newest first.
+	ListCustomerOrders(context.Context, *connect.Request[v1.ListCustomerOrdersRequest]) (*connect.Response[v1.ListCustomerOrdersResponse], error)
+	// Streams the status transitions of an order as they happen.
+	WatchOrder(context.Context, *connect.Request[v1.WatchOrderRequest]) (*connect.ServerStreamForClient[v1.OrderEvent], error)
+	// Reports the outcome of the 3-D Secure challenge of an order's payment.
+	CompletePaymentAuthentication(context.Context, *connect.Request[v1.CompletePaymentAuthenticationRequest]) (*connect.Response[v1.CompletePaymentAuthenticationResponse], error)
+	// Starts the return of some or all items of a delivered order.
+	RequestReturn(context.Context, *connect.Request[v1.RequestReturnRequest]) (*connect.Response[v1.RequestReturnResponse], error)
+	// Marks the returned items as received, which restocks them and issues the refund.
+	ReceiveReturn(context.Context, *connect.Request[v1.ReceiveReturnRequest]) (*connect.Response[v1.ReceiveReturnResponse], error)
+	// Creates a coupon.
+	CreateCoupon(context.Context, *connect.Request[v1.CreateCouponRequest]) (*connect.Response[v1.CreateCouponResponse], error)
+	// Retrieves a coupon by its code.
+	GetCoupon(context.Context, *connect.Request[v1.GetCouponRequest]) (*connect.Response[v1.GetCouponResponse], error)
+	// Lists every coupon.
+	ListCoupons(context.Context, *connect.Request[v1.ListCouponsRequest]) (*connect.Response[v1.ListCouponsResponse], error)
+	// Stops a coupon from being redeemed; orders that already used it keep their discount.
+	DeactivateCoupon(context.Context, *connect.Request[v1.DeactivateCouponRequest]) (*connect.Response[v1.DeactivateCouponResponse], error)
+	// Creates or replaces the tax rate of a country, region and tax category.
+	SetTaxRate(context.Context, *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error)
+	// Lists the tax rates of a country, or of every country.
+	ListTaxRates(context.Context, *connect.Request[v1.ListTaxRatesRequest]) (*connect.Response[v1.ListTaxRatesResponse], error)
+	// Removes a tax rate; orders that were taxed with it keep their tax lines.
+	DeleteTaxRate(context.Context, *connect.Request[v1.DeleteTaxRateRequest]) (*connect.Response[v1.DeleteTaxRateResponse], error)
 }
 
 // NewOrderServiceClient constructs a client for the orders.v1.OrderService service. By default, it
@@ -83,14 +140,98 @@ func NewOrderServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(orderServiceMethods.ByName("UpdateOrder")),
 			connect.WithClientOptions(opts...),
 		),
+		listCustomerOrders: connect.NewClient[v1.ListCustomerOrdersRequest, v1.ListCustomerOrdersResponse](
+			httpClient,
+			baseURL+OrderServiceListCustomerOrdersProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ListCustomerOrders")),
+			connect.WithClientOptions(opts...),
+		),
+		watchOrder: connect.NewClient[v1.WatchOrderRequest, v1.OrderEvent](
+			httpClient,
+			baseURL+OrderServiceWatchOrderProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("WatchOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		completePaymentAuthentication: connect.NewClient[v1.CompletePaymentAuthenticationRequest, v1.CompletePaymentAuthenticationResponse](
+			httpClient,
+			baseURL+OrderServiceCompletePaymentAuthenticationProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("CompletePaymentAuthentication")),
+			connect.WithClientOptions(opts...),
+		),
+		requestReturn: connect.NewClient[v1.RequestReturnRequest, v1.RequestReturnResponse](
+			httpClient,
+			baseURL+OrderServiceRequestReturnProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("RequestReturn")),
+			connect.WithClientOptions(opts...),
+		),
+		receiveReturn: connect.NewClient[v1.ReceiveReturnRequest, v1.ReceiveReturnResponse](
+			httpClient,
+			baseURL+OrderServiceReceiveReturnProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ReceiveReturn")),
+			connect.WithClientOptions(opts...),
+		),
+		createCoupon: connect.NewClient[v1.CreateCouponRequest, v1.CreateCouponResponse](
+			httpClient,
+			baseURL+OrderServiceCreateCouponProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("CreateCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		getCoupon: connect.NewClient[v1.GetCouponRequest, v1.GetCouponResponse](
+			httpClient,
+			baseURL+OrderServiceGetCouponProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("GetCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		listCoupons: connect.NewClient[v1.ListCouponsRequest, v1.ListCouponsResponse](
+			httpClient,
+			baseURL+OrderServiceListCouponsProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ListCoupons")),
+			connect.WithClientOptions(opts...),
+		),
+		deactivateCoupon: connect.NewClient[v1.DeactivateCouponRequest, v1.DeactivateCouponResponse](
+			httpClient,
+			baseURL+OrderServiceDeactivateCouponProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("DeactivateCoupon")),
+			connect.WithClientOptions(opts...),
+		),
+		setTaxRate: connect.NewClient[v1.SetTaxRateRequest, v1.SetTaxRateResponse](
+			httpClient,
+			baseURL+OrderServiceSetTaxRateProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("SetTaxRate")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaxRates: connect.NewClient[v1.ListTaxRatesRequest, v1.ListTaxRatesResponse](
+			httpClient,
+			baseURL+OrderServiceListTaxRatesProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("ListTaxRates")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTaxRate: connect.NewClient[v1.DeleteTaxRateRequest, v1.DeleteTaxRateResponse](
+			httpClient,
+			baseURL+OrderServiceDeleteTaxRateProcedure,
+			connect.WithSchema(orderServiceMethods.ByName("DeleteTaxRate")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // orderServiceClient implements OrderServiceClient.
 type orderServiceClient struct {
-	createOrder *connect.Client[v1.CreateOrderRequest, v1.CreateOrderResponse]
-	getOrder    *connect.Client[v1.GetOrderRequest, v1.GetOrderResponse]
-	updateOrder *connect.Client[v1.UpdateOrderRequest, v1.UpdateOrderResponse]
+	createOrder                   *connect.Client[v1.CreateOrderRequest, v1.CreateOrderResponse]
+	getOrder                      *connect.Client[v1.GetOrderRequest, v1.GetOrderResponse]
+	updateOrder                   *connect.Client[v1.UpdateOrderRequest, v1.UpdateOrderResponse]
+	listCustomerOrders            *connect.Client[v1.ListCustomerOrdersRequest, v1.ListCustomerOrdersResponse]
+	watchOrder                    *connect.Client[v1.WatchOrderRequest, v1.OrderEvent]
+	completePaymentAuthentication *connect.Client[v1.CompletePaymentAuthenticationRequest, v1.CompletePaymentAuthenticationResponse]
+	requestReturn                 *connect.Client[v1.RequestReturnRequest, v1.RequestReturnResponse]
+	receiveReturn                 *connect.Client[v1.ReceiveReturnRequest, v1.ReceiveReturnResponse]
+	createCoupon                  *connect.Client[v1.CreateCouponRequest, v1.CreateCouponResponse]
+	getCoupon                     *connect.Client[v1.GetCouponRequest, v1.GetCouponResponse]
+	listCoupons                   *connect.Client[v1.ListCouponsRequest, v1.ListCouponsResponse]
+	deactivateCoupon              *connect.Client[v1.DeactivateCouponRequest, v1.DeactivateCouponResponse]
+	setTaxRate                    *connect.Client[v1.SetTaxRateRequest, v1.SetTaxRateResponse]
+	listTaxRates                  *connect.Client[v1.ListTaxRatesRequest, v1.ListTaxRatesResponse]
+	deleteTaxRate                 *connect.Client[v1.DeleteTaxRateRequest, v1.DeleteTaxRateResponse]
 }
 
 // CreateOrder calls orders.v1.OrderService.CreateOrder.
@@ -108,6 +249,66 @@ func (c *orderServiceClient) UpdateOrder(ctx context.Context, req *connect.Reque
 	return c.updateOrder.CallUnary(ctx, req)
 }
 
+// ListCustomerOrders calls orders.v1.OrderService.ListCustomerOrders.
+func (c *orderServiceClient) ListCustomerOrders(ctx context.Context, req *connect.Request[v1.ListCustomerOrdersRequest]) (*connect.Response[v1.ListCustomerOrdersResponse], error) {
+	return c.listCustomerOrders.CallUnary(ctx, req)
+}
+
+// WatchOrder calls orders.v1.OrderService.WatchOrder.
+func (c *orderServiceClient) WatchOrder(ctx context.Context, req *connect.Request[v1.WatchOrderRequest]) (*connect.ServerStreamForClient[v1.OrderEvent], error) {
+	return c.watchOrder.CallServerStream(ctx, req)
+}
+
+// CompletePaymentAuthentication calls orders.v1.OrderService.CompletePaymentAuthentication.
+func (c *orderServiceClient) CompletePaymentAuthentication(ctx context.Context, req *connect.Request[v1.CompletePaymentAuthenticationRequest]) (*connect.Response[v1.CompletePaymentAuthenticationResponse], error) {
+	return c.completePaymentAuthentication.CallUnary(ctx, req)
+}
+
+// RequestReturn calls orders.v1.OrderService.RequestReturn.
+func (c *orderServiceClient) RequestReturn(ctx context.Context, req *connect.Request[v1.RequestReturnRequest]) (*connect.Response[v1.RequestReturnResponse], error) {
+	return c.requestReturn.CallUnary(ctx, req)
+}
+
+// ReceiveReturn calls orders.v1.OrderService.ReceiveReturn.
+func (c *orderServiceClient) ReceiveReturn(ctx context.Context, req *connect.Request[v1.ReceiveReturnRequest]) (*connect.Response[v1.ReceiveReturnResponse], error) {
+	return c.receiveReturn.CallUnary(ctx, req)
+}
+
+// CreateCoupon calls orders.v1.OrderService.CreateCoupon.
+func (c *orderServiceClient) CreateCoupon(ctx context.Context, req *connect.Request[v1.CreateCouponRequest]) (*connect.Response[v1.CreateCouponResponse], error) {
+	return c.createCoupon.CallUnary(ctx, req)
+}
+
+// GetCoupon calls orders.v1.OrderService.GetCoupon.
+func (c *orderServiceClient) GetCoupon(ctx context.Context, req *connect.Request[v1.GetCouponRequest]) (*connect.Response[v1.GetCouponResponse], error) {
+	return c.getCoupon.CallUnary(ctx, req)
+}
+
+// ListCoupons calls orders.v1.OrderService.ListCoupons.
+func (c *orderServiceClient) ListCoupons(ctx context.Context, req *connect.Request[v1.ListCouponsRequest]) (*connect.Response[v1.ListCouponsResponse], error) {
+	return c.listCoupons.CallUnary(ctx, req)
+}
+
+// DeactivateCoupon calls orders.v1.OrderService.DeactivateCoupon.
+func (c *orderServiceClient) DeactivateCoupon(ctx context.Context, req *connect.Request[v1.DeactivateCouponRequest]) (*connect.Response[v1.DeactivateCouponResponse], error) {
+	return c.deactivateCoupon.CallUnary(ctx, req)
+}
+
+// SetTaxRate calls orders.v1.OrderService.SetTaxRate.
+func (c *orderServiceClient) SetTaxRate(ctx context.Context, req *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error) {
+	return c.setTaxRate.CallUnary(ctx, req)
+}
+
+// ListTaxRates calls orders.v1.OrderService.ListTaxRates.
+func (c *orderServiceClient) ListTaxRates(ctx context.Context, req *connect.Request[v1.ListTaxRatesRequest]) (*connect.Response[v1.ListTaxRatesResponse], error) {
+	return c.listTaxRates.CallUnary(ctx, req)
+}
+
+// DeleteTaxRate calls orders.v1.OrderService.DeleteTaxRate.
+func (c *orderServiceClient) DeleteTaxRate(ctx context.Context, req *connect.Request[v1.DeleteTaxRateRequest]) (*connect.Response[v1.DeleteTaxRateResponse], error) {
+	return c.deleteTaxRate.CallUnary(ctx, req)
+}
+
 // OrderServiceHandler is an implementation of the orders.v1.OrderService service.
 type OrderServiceHandler interface {
 	// Creates a new order.
@@ -116,6 +317,30 @@ type OrderServiceHandler interface {
 	GetOrder(context.Context, *connect.Request[v1.GetOrderRequest]) (*connect.Response[v1.GetOrderResponse], error)
 	// Updates an existing order.
 	UpdateOrder(context.Context, *connect.Request[v1.UpdateOrderRequest]) (*connect.Response[v1.UpdateOrderResponse], error)
+	// Lists the orders of a customer, newest first.
+	ListCustomerOrders(context.Context, *connect.Request[v1.ListCustomerOrdersRequest]) (*connect.Response[v1.ListCustomerOrdersResponse], error)
+	// Streams the status transitions of an order as they happen.
+	WatchOrder(context.Context, *connect.Request[v1.WatchOrderRequest], *connect.ServerStream[v1.OrderEvent]) error
+	// Reports the outcome of the 3-D Secure challenge of an order's payment.
+	CompletePaymentAuthentication(context.Context, *connect.Request[v1.CompletePaymentAuthenticationRequest]) (*connect.Response[v1.CompletePaymentAuthenticationResponse], error)
+	// Starts the return of some or all items of a delivered order.
+	RequestReturn(context.Context, *connect.Request[v1.RequestReturnRequest]) (*connect.Response[v1.RequestReturnResponse], error)
+	// Marks the returned items as received, which restocks them and issues the refund.
+	ReceiveReturn(context.Context, *connect.Request[v1.ReceiveReturnRequest]) (*connect.Response[v1.ReceiveReturnResponse], error)
+	// Creates a coupon.
+	CreateCoupon(context.Context, *connect.Request[v1.CreateCouponRequest]) (*connect.Response[v1.CreateCouponResponse], error)
+	// Retrieves a coupon by its code.
+	GetCoupon(context.Context, *connect.Request[v1.GetCouponRequest]) (*connect.Response[v1.GetCouponResponse], error)
+	// Lists every coupon.
+	ListCoupons(context.Context, *connect.Request[v1.ListCouponsRequest]) (*connect.Response[v1.ListCouponsResponse], error)
+	// Stops a coupon from being redeemed; orders that already used it keep their discount.
+	DeactivateCoupon(context.Context, *connect.Request[v1.DeactivateCouponRequest]) (*connect.Response[v1.DeactivateCouponResponse], error)
+	// Creates or replaces the tax rate of a country, region and tax category.
+	SetTaxRate(context.Context, *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error)
+	// Lists the tax rates of a country, or of every country.
+	ListTaxRates(context.Context, *connect.Request[v1.ListTaxRatesRequest]) (*connect.Response[v1.ListTaxRatesResponse], error)
+	// Removes a tax rate; orders that were taxed with it keep their tax lines.
+	DeleteTaxRate(context.Context, *connect.Request[v1.DeleteTaxRateRequest]) (*connect.Response[v1.DeleteTaxRateResponse], error)
 }
 
 // NewOrderServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +368,78 @@ func NewOrderServiceHandler(svc OrderServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(orderServiceMethods.ByName("UpdateOrder")),
 		connect.WithHandlerOptions(opts...),
 	)
+	orderServiceListCustomerOrdersHandler := connect.NewUnaryHandler(
+		OrderServiceListCustomerOrdersProcedure,
+		svc.ListCustomerOrders,
+		connect.WithSchema(orderServiceMethods.ByName("ListCustomerOrders")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceWatchOrderHandler := connect.NewServerStreamHandler(
+		OrderServiceWatchOrderProcedure,
+		svc.WatchOrder,
+		connect.WithSchema(orderServiceMethods.ByName("WatchOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceCompletePaymentAuthenticationHandler := connect.NewUnaryHandler(
+		OrderServiceCompletePaymentAuthenticationProcedure,
+		svc.CompletePaymentAuthentication,
+		connect.WithSchema(orderServiceMethods.ByName("CompletePaymentAuthentication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceRequestReturnHandler := connect.NewUnaryHandler(
+		OrderServiceRequestReturnProcedure,
+		svc.RequestReturn,
+		connect.WithSchema(orderServiceMethods.ByName("RequestReturn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceReceiveReturnHandler := connect.NewUnaryHandler(
+		OrderServiceReceiveReturnProcedure,
+		svc.ReceiveReturn,
+		connect.WithSchema(orderServiceMethods.ByName("ReceiveReturn")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceCreateCouponHandler := connect.NewUnaryHandler(
+		OrderServiceCreateCouponProcedure,
+		svc.CreateCoupon,
+		connect.WithSchema(orderServiceMethods.ByName("CreateCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceGetCouponHandler := connect.NewUnaryHandler(
+		OrderServiceGetCouponProcedure,
+		svc.GetCoupon,
+		connect.WithSchema(orderServiceMethods.ByName("GetCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceListCouponsHandler := connect.NewUnaryHandler(
+		OrderServiceListCouponsProcedure,
+		svc.ListCoupons,
+		connect.WithSchema(orderServiceMethods.ByName("ListCoupons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceDeactivateCouponHandler := connect.NewUnaryHandler(
+		OrderServiceDeactivateCouponProcedure,
+		svc.DeactivateCoupon,
+		connect.WithSchema(orderServiceMethods.ByName("DeactivateCoupon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceSetTaxRateHandler := connect.NewUnaryHandler(
+		OrderServiceSetTaxRateProcedure,
+		svc.SetTaxRate,
+		connect.WithSchema(orderServiceMethods.ByName("SetTaxRate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceListTaxRatesHandler := connect.NewUnaryHandler(
+		OrderServiceListTaxRatesProcedure,
+		svc.ListTaxRates,
+		connect.WithSchema(orderServiceMethods.ByName("ListTaxRates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	orderServiceDeleteTaxRateHandler := connect.NewUnaryHandler(
+		OrderServiceDeleteTaxRateProcedure,
+		svc.DeleteTaxRate,
+		connect.WithSchema(orderServiceMethods.ByName("DeleteTaxRate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/orders.v1.OrderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OrderServiceCreateOrderProcedure:
@@ -151,6 +448,30 @@ func NewOrderServiceHandler(svc OrderServiceHandler, opts ...connect.HandlerOpti
 			orderServiceGetOrderHandler.ServeHTTP(w, r)
 		case OrderServiceUpdateOrderProcedure:
 			orderServiceUpdateOrderHandler.ServeHTTP(w, r)
+		case OrderServiceListCustomerOrdersProcedure:
+			orderServiceListCustomerOrdersHandler.ServeHTTP(w, r)
+		case OrderServiceWatchOrderProcedure:
+			orderServiceWatchOrderHandler.ServeHTTP(w, r)
+		case OrderServiceCompletePaymentAuthenticationProcedure:
+			orderServiceCompletePaymentAuthenticationHandler.ServeHTTP(w, r)
+		case OrderServiceRequestReturnProcedure:
+			orderServiceRequestReturnHandler.ServeHTTP(w, r)
+		case OrderServiceReceiveReturnProcedure:
+			orderServiceReceiveReturnHandler.ServeHTTP(w, r)
+		case OrderServiceCreateCouponProcedure:
+			orderServiceCreateCouponHandler.ServeHTTP(w, r)
+		case OrderServiceGetCouponProcedure:
+			orderServiceGetCouponHandler.ServeHTTP(w, r)
+		case OrderServiceListCouponsProcedure:
+			orderServiceListCouponsHandler.ServeHTTP(w, r)
+		case OrderServiceDeactivateCouponProcedure:
+			orderServiceDeactivateCouponHandler.ServeHTTP(w, r)
+		case OrderServiceSetTaxRateProcedure:
+			orderServiceSetTaxRateHandler.ServeHTTP(w, r)
+		case OrderServiceListTaxRatesProcedure:
+			orderServiceListTaxRatesHandler.ServeHTTP(w, r)
+		case OrderServiceDeleteTaxRateProcedure:
+			orderServiceDeleteTaxRateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +492,51 @@ func (UnimplementedOrderServiceHandler) GetOrder(context.Context, *connect.Reque
 func (UnimplementedOrderServiceHandler) UpdateOrder(context.Context, *connect.Request[v1.UpdateOrderRequest]) (*connect.Response[v1.UpdateOrderResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.UpdateOrder is not implemented"))
 }
+
+func (UnimplementedOrderServiceHandler) ListCustomerOrders(context.Context, *connect.Request[v1.ListCustomerOrdersRequest]) (*connect.Response[v1.ListCustomerOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.ListCustomerOrders is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) WatchOrder(context.Context, *connect.Request[v1.WatchOrderRequest], *connect.ServerStream[v1.OrderEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.WatchOrder is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) CompletePaymentAuthentication(context.Context, *connect.Request[v1.CompletePaymentAuthenticationRequest]) (*connect.Response[v1.CompletePaymentAuthenticationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.CompletePaymentAuthentication is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) RequestReturn(context.Context, *connect.Request[v1.RequestReturnRequest]) (*connect.Response[v1.RequestReturnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.RequestReturn is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) ReceiveReturn(context.Context, *connect.Request[v1.ReceiveReturnRequest]) (*connect.Response[v1.ReceiveReturnResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.ReceiveReturn is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) CreateCoupon(context.Context, *connect.Request[v1.CreateCouponRequest]) (*connect.Response[v1.CreateCouponResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.CreateCoupon is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) GetCoupon(context.Context, *connect.Request[v1.GetCouponRequest]) (*connect.Response[v1.GetCouponResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.GetCoupon is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) ListCoupons(context.Context, *connect.Request[v1.ListCouponsRequest]) (*connect.Response[v1.ListCouponsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.ListCoupons is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) DeactivateCoupon(context.Context, *connect.Request[v1.DeactivateCouponRequest]) (*connect.Response[v1.DeactivateCouponResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.DeactivateCoupon is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) SetTaxRate(context.Context, *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.SetTaxRate is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) ListTaxRates(context.Context, *connect.Request[v1.ListTaxRatesRequest]) (*connect.Response[v1.ListTaxRatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.ListTaxRates is not implemented"))
+}
+
+func (UnimplementedOrderServiceHandler) DeleteTaxRate(context.Context, *connect.Request[v1.DeleteTaxRateRequest]) (*connect.Response[v1.DeleteTaxRateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orders.v1.OrderService.DeleteTaxRate is not implemented"))
+}
@@ -7,6 +7,7 @@
 package productsv1
 
 import (
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -23,35 +24,2827 @@ const (
 )
 
 type Product struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in products/v1/products.proto.
+	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"` // use base_price
+	// Deprecated: Marked as deprecated in products/v1/products.proto.
+	Currency          string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // use base_price
+	ImageUrl          string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock             int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AvailableStock    int32                  `protobuf:"varint,10,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`           // stock minus active order reservations
+	ReorderThreshold  int32                  `protobuf:"varint,11,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`     // reorder when available stock falls below this; 0 disables reordering
+	ReorderQuantity   int32                  `protobuf:"varint,12,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`        // how much to order from the supplier
+	AllowBackorder    bool                   `protobuf:"varint,13,opt,name=allow_backorder,json=allowBackorder,proto3" json:"allow_backorder,omitempty"`           // orders may wait for stock instead of failing when it runs out
+	ExpectedRestockAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=expected_restock_at,json=expectedRestockAt,proto3" json:"expected_restock_at,omitempty"` // when stock is expected back, or a pre-order is released
+	TaxCategory       string                 `protobuf:"bytes,15,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`                     // picks the tax rate, e.g. standard, reduced or exempt
+	BasePrice         *v1.Money              `protobuf:"bytes,16,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`                           // the price in the product's own currency
+	PriceList         []*v1.Money            `protobuf:"bytes,17,rep,name=price_list,json=priceList,proto3" json:"price_list,omitempty"`                           // prices set for other currencies; any other currency is converted from base_price
+	DeletedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                           // set while the product is deleted and can still be restored
+	CategoryId        int64                  `protobuf:"varint,19,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                       // 0 when uncategorised
+	Tags              []string               `protobuf:"bytes,20,rep,name=tags,proto3" json:"tags,omitempty"`                                                      // lower case, sorted
+	Attributes        []*Attribute           `protobuf:"bytes,21,rep,name=attributes,proto3" json:"attributes,omitempty"`                                          // sorted by name
+	ParentId          int64                  `protobuf:"varint,22,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                             // set on a variant, to the product it is a variant of
+	Sku               string                 `protobuf:"bytes,23,opt,name=sku,proto3" json:"sku,omitempty"`                                                        // set on a variant; unique across all variants
+	PriceOverride     bool                   `protobuf:"varint,24,opt,name=price_override,json=priceOverride,proto3" json:"price_override,omitempty"`              // a variant priced on its own instead of at the price of its product
+	Variants          []*Product             `protobuf:"bytes,25,rep,name=variants,proto3" json:"variants,omitempty"`                                              // the variants of a product, by id
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_products_v1_products_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in products/v1/products.proto.
+func (x *Product) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in products/v1/products.proto.
+func (x *Product) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Product) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Product) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetAvailableStock() int32 {
+	if x != nil {
+		return x.AvailableStock
+	}
+	return 0
+}
+
+func (x *Product) GetReorderThreshold() int32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Product) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *Product) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *Product) GetExpectedRestockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedRestockAt
+	}
+	return nil
+}
+
+func (x *Product) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *Product) GetBasePrice() *v1.Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *Product) GetPriceList() []*v1.Money {
+	if x != nil {
+		return x.PriceList
+	}
+	return nil
+}
+
+func (x *Product) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Product) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Product) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Product) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Product) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetPriceOverride() bool {
+	if x != nil {
+		return x.PriceOverride
+	}
+	return false
+}
+
+func (x *Product) GetVariants() []*Product {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// A typed property of a product, e.g. size M, colour red or weight 0.2 kg.
+type Attribute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // lower case, unique per product
+	// Types that are valid to be assigned to Value:
+	//
+	//	*Attribute_Text
+	//	*Attribute_Number
+	//	*Attribute_Flag
+	Value         isAttribute_Value `protobuf_oneof:"value"`
+	Unit          string            `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"` // of a number, e.g. kg or cm
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	mi := &file_products_v1_products_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Attribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attribute) GetValue() isAttribute_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Attribute) GetText() string {
+	if x != nil {
+		if x, ok := x.Value.(*Attribute_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *Attribute) GetNumber() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*Attribute_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *Attribute) GetFlag() bool {
+	if x != nil {
+		if x, ok := x.Value.(*Attribute_Flag); ok {
+			return x.Flag
+		}
+	}
+	return false
+}
+
+func (x *Attribute) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type isAttribute_Value interface {
+	isAttribute_Value()
+}
+
+type Attribute_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type Attribute_Number struct {
+	Number float64 `protobuf:"fixed64,3,opt,name=number,proto3,oneof"`
+}
+
+type Attribute_Flag struct {
+	Flag bool `protobuf:"varint,4,opt,name=flag,proto3,oneof"`
+}
+
+func (*Attribute_Text) isAttribute_Value() {}
+
+func (*Attribute_Number) isAttribute_Value() {}
+
+func (*Attribute_Flag) isAttribute_Value() {}
+
+// A node of the category tree.
+type Category struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Stock         int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`                          // unique among its siblings; derived from the name when not set
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 for a root category
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_products_v1_products_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateProductRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Deprecated: Marked as deprecated in products/v1/products.proto.
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // use base_price
+	// Deprecated: Marked as deprecated in products/v1/products.proto.
+	Currency          string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // use base_price
+	ImageUrl          string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock             int32                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	ReorderThreshold  int32                  `protobuf:"varint,7,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	ReorderQuantity   int32                  `protobuf:"varint,8,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	AllowBackorder    bool                   `protobuf:"varint,9,opt,name=allow_backorder,json=allowBackorder,proto3" json:"allow_backorder,omitempty"`
+	ExpectedRestockAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expected_restock_at,json=expectedRestockAt,proto3" json:"expected_restock_at,omitempty"`
+	TaxCategory       string                 `protobuf:"bytes,11,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"` // defaults to standard
+	BasePrice         *v1.Money              `protobuf:"bytes,12,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
+	PriceList         []*v1.Money            `protobuf:"bytes,13,rep,name=price_list,json=priceList,proto3" json:"price_list,omitempty"`
+	CategoryId        int64                  `protobuf:"varint,14,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Tags              []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attributes        []*Attribute           `protobuf:"bytes,16,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in products/v1/products.proto.
+func (x *CreateProductRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in products/v1/products.proto.
+func (x *CreateProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetReorderThreshold() int32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetExpectedRestockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedRestockAt
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBasePrice() *v1.Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetPriceList() []*v1.Money {
+	if x != nil {
+		return x.PriceList
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"` // also return the product when it is deleted
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteProductResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RestoreProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductResponse) ProtoMessage() {}
+
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Stock of a product at one warehouse.
+type InventoryLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LocationId    string                 `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryLevel) Reset() {
+	*x = InventoryLevel{}
+	mi := &file_products_v1_products_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryLevel) ProtoMessage() {}
+
+func (x *InventoryLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryLevel.ProtoReflect.Descriptor instead.
+func (*InventoryLevel) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InventoryLevel) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *InventoryLevel) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AdjustInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"` // negative to remove stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustInventoryRequest) Reset() {
+	*x = AdjustInventoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustInventoryRequest) ProtoMessage() {}
+
+func (x *AdjustInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustInventoryRequest.ProtoReflect.Descriptor instead.
+func (*AdjustInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AdjustInventoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdjustInventoryRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *AdjustInventoryRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type AdjustInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         *InventoryLevel        `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Stock         int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"` // stock across all warehouses
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustInventoryResponse) Reset() {
+	*x = AdjustInventoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustInventoryResponse) ProtoMessage() {}
+
+func (x *AdjustInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustInventoryResponse.ProtoReflect.Descriptor instead.
+func (*AdjustInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AdjustInventoryResponse) GetLevel() *InventoryLevel {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+func (x *AdjustInventoryResponse) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type GetInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryRequest) Reset() {
+	*x = GetInventoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryRequest) ProtoMessage() {}
+
+func (x *GetInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetInventoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetInventoryResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Levels         []*InventoryLevel      `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	Stock          int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	AvailableStock int32                  `protobuf:"varint,3,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInventoryResponse) Reset() {
+	*x = GetInventoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryResponse) ProtoMessage() {}
+
+func (x *GetInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetInventoryResponse) GetLevels() []*InventoryLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *GetInventoryResponse) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *GetInventoryResponse) GetAvailableStock() int32 {
+	if x != nil {
+		return x.AvailableStock
+	}
+	return 0
+}
+
+// One change to the on-hand stock of a product.
+type StockMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LocationId    string                 `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"` // initial_stock, adjustment, order_confirmed, return_restock or reconciliation
+	OrderId       int64                  `protobuf:"varint,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Actor         string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_products_v1_products_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StockMovement) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *StockMovement) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // inclusive, defaults to the beginning
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // exclusive, defaults to now
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStockMovementsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListStockMovementsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateReorderPolicyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReorderThreshold int32                  `protobuf:"varint,2,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	ReorderQuantity  int32                  `protobuf:"varint,3,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateReorderPolicyRequest) Reset() {
+	*x = UpdateReorderPolicyRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReorderPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReorderPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateReorderPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReorderPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReorderPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateReorderPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateReorderPolicyRequest) GetReorderThreshold() int32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *UpdateReorderPolicyRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+type UpdateReorderPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReorderPolicyResponse) Reset() {
+	*x = UpdateReorderPolicyResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReorderPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReorderPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateReorderPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReorderPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReorderPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateReorderPolicyResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateBackorderPolicyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AllowBackorder    bool                   `protobuf:"varint,2,opt,name=allow_backorder,json=allowBackorder,proto3" json:"allow_backorder,omitempty"`
+	ExpectedRestockAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_restock_at,json=expectedRestockAt,proto3" json:"expected_restock_at,omitempty"` // unset clears the date
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateBackorderPolicyRequest) Reset() {
+	*x = UpdateBackorderPolicyRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBackorderPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBackorderPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateBackorderPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBackorderPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBackorderPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateBackorderPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBackorderPolicyRequest) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *UpdateBackorderPolicyRequest) GetExpectedRestockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedRestockAt
+	}
+	return nil
+}
+
+type UpdateBackorderPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBackorderPolicyResponse) Reset() {
+	*x = UpdateBackorderPolicyResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBackorderPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBackorderPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateBackorderPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBackorderPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBackorderPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateBackorderPolicyResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateTaxCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,2,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaxCategoryRequest) Reset() {
+	*x = UpdateTaxCategoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaxCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaxCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateTaxCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaxCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaxCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateTaxCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaxCategoryRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+type UpdateTaxCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaxCategoryResponse) Reset() {
+	*x = UpdateTaxCategoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaxCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaxCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateTaxCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaxCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaxCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateTaxCategoryResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type SetPriceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prices        []*v1.Money            `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"` // replaces the whole price list; empty clears it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceListRequest) Reset() {
+	*x = SetPriceListRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceListRequest) ProtoMessage() {}
+
+func (x *SetPriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceListRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceListRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetPriceListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPriceListRequest) GetPrices() []*v1.Money {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type SetPriceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceListResponse) Reset() {
+	*x = SetPriceListResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceListResponse) ProtoMessage() {}
+
+func (x *SetPriceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceListResponse.ProtoReflect.Descriptor instead.
+func (*SetPriceListResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetPriceListResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// A variant is stocked, reserved and ordered under its own id, like any product.
+type CreateVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	PriceOverride *v1.Money              `protobuf:"bytes,3,opt,name=price_override,json=priceOverride,proto3" json:"price_override,omitempty"` // in the currency of the product; unset: the price of the product
+	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Attributes    []*Attribute           `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty"` // what sets the variant apart from its siblings, e.g. size M
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVariantRequest) Reset() {
+	*x = CreateVariantRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVariantRequest) ProtoMessage() {}
+
+func (x *CreateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateVariantRequest) GetPriceOverride() *v1.Money {
+	if x != nil {
+		return x.PriceOverride
+	}
+	return nil
+}
+
+func (x *CreateVariantRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateVariantRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreateVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *Product               `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVariantResponse) Reset() {
+	*x = CreateVariantResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVariantResponse) ProtoMessage() {}
+
+func (x *CreateVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVariantResponse.ProtoReflect.Descriptor instead.
+func (*CreateVariantResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateVariantResponse) GetVariant() *Product {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+// Replaces the price override and attributes of a variant. Its stock is adjusted with AdjustInventory.
+type UpdateVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PriceOverride *v1.Money              `protobuf:"bytes,2,opt,name=price_override,json=priceOverride,proto3" json:"price_override,omitempty"`
+	Attributes    []*Attribute           `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetPriceOverride() *v1.Money {
+	if x != nil {
+		return x.PriceOverride
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdateVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *Product               `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantResponse) Reset() {
+	*x = UpdateVariantResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantResponse) ProtoMessage() {}
+
+func (x *UpdateVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVariantResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateVariantResponse) GetVariant() *Product {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type GetProductBySkuRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySkuRequest) Reset() {
+	*x = GetProductBySkuRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySkuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySkuRequest) ProtoMessage() {}
+
+func (x *GetProductBySkuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySkuRequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySkuRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetProductBySkuRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type GetProductBySkuResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // with its variants
+	Variant       *Product               `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySkuResponse) Reset() {
+	*x = GetProductBySkuResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySkuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySkuResponse) ProtoMessage() {}
+
+func (x *GetProductBySkuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySkuResponse.ProtoReflect.Descriptor instead.
+func (*GetProductBySkuResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetProductBySkuResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductBySkuResponse) GetVariant() *Product {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type SearchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                                // matched against name, description, tags and attributes, allowing typos; empty matches every product
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`   // products of this category only, not of its subcategories
+	PriceBucket   string                 `protobuf:"bytes,3,opt,name=price_bucket,json=priceBucket,proto3" json:"price_bucket,omitempty"` // a value of the price facet
+	InStockOnly   bool                   `protobuf:"varint,4,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetPriceBucket() string {
+	if x != nil {
+		return x.PriceBucket
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *SearchProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` // most relevant first
+	TotalHits     int64                  `protobuf:"varint,2,opt,name=total_hits,json=totalHits,proto3" json:"total_hits,omitempty"`
+	Facets        []*SearchFacet         `protobuf:"bytes,3,rep,name=facets,proto3" json:"facets,omitempty"`                                      // category, price and in_stock, counted over every hit
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SearchProductsResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetTotalHits() int64 {
+	if x != nil {
+		return x.TotalHits
+	}
+	return 0
+}
+
+func (x *SearchProductsResponse) GetFacets() []*SearchFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // with its variants
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_products_v1_products_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SearchHit) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []*SearchFacetValue    `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacet) Reset() {
+	*x = SearchFacet{}
+	mi := &file_products_v1_products_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacet) ProtoMessage() {}
+
+func (x *SearchFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacet.ProtoReflect.Descriptor instead.
+func (*SearchFacet) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SearchFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchFacet) GetValues() []*SearchFacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchFacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"` // what to filter on, e.g. the category id or price bucket
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"` // e.g. the category name
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacetValue) Reset() {
+	*x = SearchFacetValue{}
+	mi := &file_products_v1_products_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacetValue) ProtoMessage() {}
+
+func (x *SearchFacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacetValue.ProtoReflect.Descriptor instead.
+func (*SearchFacetValue) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SearchFacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SearchFacetValue) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SearchFacetValue) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UpdateProductCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0 removes the product from its category
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`                                // replaces every tag
+	Attributes    []*Attribute           `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`                    // replaces every attribute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductCatalogRequest) Reset() {
+	*x = UpdateProductCatalogRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductCatalogRequest) ProtoMessage() {}
+
+func (x *UpdateProductCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductCatalogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateProductCatalogRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductCatalogRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateProductCatalogRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateProductCatalogRequest) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type UpdateProductCatalogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductCatalogResponse) Reset() {
+	*x = UpdateProductCatalogResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductCatalogResponse) ProtoMessage() {}
+
+func (x *UpdateProductCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductCatalogResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateProductCatalogResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsByCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // products of this category only, not of its subcategories
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsByCategoryRequest) Reset() {
+	*x = ListProductsByCategoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByCategoryRequest) ProtoMessage() {}
+
+func (x *ListProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListProductsByCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListProductsByCategoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsByCategoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Listed products carry their id, name, image, base price, category and tags.
+type ListProductsByCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsByCategoryResponse) Reset() {
+	*x = ListProductsByCategoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByCategoryResponse) ProtoMessage() {}
+
+func (x *ListProductsByCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsByCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListProductsByCategoryResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsByCategoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListProductsByTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsByTagRequest) Reset() {
+	*x = ListProductsByTagRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByTagRequest) ProtoMessage() {}
+
+func (x *ListProductsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByTagRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListProductsByTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListProductsByTagRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsByTagRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListProductsByTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsByTagResponse) Reset() {
+	*x = ListProductsByTagResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByTagResponse) ProtoMessage() {}
+
+func (x *ListProductsByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByTagResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsByTagResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListProductsByTagResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsByTagResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_products_v1_products_proto_msgTypes[0]
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *CreateCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*CreateCategoryRequest) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[0]
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,101 +2855,151 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Product) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Product) GetName() string {
+func (x *CreateCategoryRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Product) GetDescription() string {
+func (x *CreateCategoryRequest) GetSlug() string {
 	if x != nil {
-		return x.Description
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *Product) GetPrice() float64 {
+func (x *CreateCategoryRequest) GetParentId() int64 {
 	if x != nil {
-		return x.Price
+		return x.ParentId
 	}
 	return 0
 }
 
-func (x *Product) GetCurrency() string {
+func (x *CreateCategoryRequest) GetDescription() string {
 	if x != nil {
-		return x.Currency
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Product) GetImageUrl() string {
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[45]
 	if x != nil {
-		return x.ImageUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Product) GetStock() int32 {
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Stock
+		return x.Category
 	}
-	return 0
+	return nil
 }
 
-func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[46]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCategoryRequest) GetId() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type CreateProductRequest struct {
+type GetCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Stock         int32                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Ancestors     []*Category            `protobuf:"bytes,2,rep,name=ancestors,proto3" json:"ancestors,omitempty"` // from the root down to the parent, for breadcrumbs
+	Children      []*Category            `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
-	mi := &file_products_v1_products_proto_msgTypes[1]
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *GetCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*GetCategoryResponse) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[1]
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,75 +3010,131 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *GetCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Name
+		return x.Category
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *GetCategoryResponse) GetAncestors() []*Category {
 	if x != nil {
-		return x.Description
+		return x.Ancestors
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateProductRequest) GetPrice() float64 {
+func (x *GetCategoryResponse) GetChildren() []*Category {
 	if x != nil {
-		return x.Price
+		return x.Children
+	}
+	return nil
+}
+
+// Replaces every field of a category; changing parent_id moves it with its subtree.
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateProductRequest) GetCurrency() string {
+func (x *UpdateCategoryRequest) GetName() string {
 	if x != nil {
-		return x.Currency
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetImageUrl() string {
+func (x *UpdateCategoryRequest) GetSlug() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetStock() int32 {
+func (x *UpdateCategoryRequest) GetParentId() int64 {
 	if x != nil {
-		return x.Stock
+		return x.ParentId
 	}
 	return 0
 }
 
-type CreateProductResponse struct {
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
-	mi := &file_products_v1_products_proto_msgTypes[2]
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *UpdateCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*UpdateCategoryResponse) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[2]
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,40 +3145,40 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateProductResponse) GetProduct() *Product {
+func (x *UpdateCategoryResponse) GetCategory() *Category {
 	if x != nil {
-		return x.Product
+		return x.Category
 	}
 	return nil
 }
 
-type GetProductResponse struct {
+type DeleteCategoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
-	mi := &file_products_v1_products_proto_msgTypes[3]
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductResponse) String() string {
+func (x *DeleteCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (*DeleteCategoryRequest) ProtoMessage() {}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[3]
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,40 +3189,40 @@ func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *DeleteCategoryRequest) GetId() int64 {
 	if x != nil {
-		return x.Product
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetProductRequest struct {
+type DeleteCategoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_products_v1_products_proto_msgTypes[4]
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *DeleteCategoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*DeleteCategoryResponse) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[4]
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,40 +3233,40 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetProductRequest) GetId() string {
+func (x *DeleteCategoryResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Id
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
-type DeleteProductRequest struct {
+type ListCategoriesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 lists the root categories
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_products_v1_products_proto_msgTypes[5]
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_products_v1_products_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *ListCategoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*ListCategoriesRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[5]
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,40 +3277,40 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *DeleteProductRequest) GetId() string {
+func (x *ListCategoriesRequest) GetParentId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ParentId
 	}
-	return ""
+	return 0
 }
 
-type DeleteProductResponse struct {
+type ListCategoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
-	mi := &file_products_v1_products_proto_msgTypes[6]
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_products_v1_products_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ListCategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ListCategoriesResponse) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_products_v1_products_proto_msgTypes[6]
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_products_v1_products_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,57 +3321,308 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_products_v1_products_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_products_v1_products_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DeleteProductResponse) GetDeleted() bool {
+func (x *ListCategoriesResponse) GetCategories() []*Category {
 	if x != nil {
-		return x.Deleted
+		return x.Categories
 	}
-	return false
+	return nil
 }
 
 var File_products_v1_products_proto protoreflect.FileDescriptor
 
 const file_products_v1_products_proto_rawDesc = "" +
 	"\n" +
-	"\x1aproducts/v1/products.proto\x12\vproducts.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x02\n" +
+	"\x1aproducts/v1/products.proto\x12\vproducts.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14money/v1/money.proto\"\xdb\a\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x04 \x01(\x01B\x02\x18\x01R\x05price\x12\x1e\n" +
+	"\bcurrency\x18\x05 \x01(\tB\x02\x18\x01R\bcurrency\x12\x1b\n" +
 	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x14\n" +
 	"\x05stock\x18\a \x01(\x05R\x05stock\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12'\n" +
+	"\x0favailable_stock\x18\n" +
+	" \x01(\x05R\x0eavailableStock\x12+\n" +
+	"\x11reorder_threshold\x18\v \x01(\x05R\x10reorderThreshold\x12)\n" +
+	"\x10reorder_quantity\x18\f \x01(\x05R\x0freorderQuantity\x12'\n" +
+	"\x0fallow_backorder\x18\r \x01(\bR\x0eallowBackorder\x12J\n" +
+	"\x13expected_restock_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedRestockAt\x12!\n" +
+	"\ftax_category\x18\x0f \x01(\tR\vtaxCategory\x12.\n" +
+	"\n" +
+	"base_price\x18\x10 \x01(\v2\x0f.money.v1.MoneyR\tbasePrice\x12.\n" +
+	"\n" +
+	"price_list\x18\x11 \x03(\v2\x0f.money.v1.MoneyR\tpriceList\x129\n" +
+	"\n" +
+	"deleted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1f\n" +
+	"\vcategory_id\x18\x13 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04tags\x18\x14 \x03(\tR\x04tags\x126\n" +
+	"\n" +
+	"attributes\x18\x15 \x03(\v2\x16.products.v1.AttributeR\n" +
+	"attributes\x12\x1b\n" +
+	"\tparent_id\x18\x16 \x01(\x03R\bparentId\x12\x10\n" +
+	"\x03sku\x18\x17 \x01(\tR\x03sku\x12%\n" +
+	"\x0eprice_override\x18\x18 \x01(\bR\rpriceOverride\x120\n" +
+	"\bvariants\x18\x19 \x03(\v2\x14.products.v1.ProductR\bvariants\"\x82\x01\n" +
+	"\tAttribute\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x03 \x01(\x01H\x00R\x06number\x12\x14\n" +
+	"\x04flag\x18\x04 \x01(\bH\x00R\x04flag\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unitB\a\n" +
+	"\x05value\"\xf7\x01\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf6\x04\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x01B\x02\x18\x01R\x05price\x12\x1e\n" +
+	"\bcurrency\x18\x04 \x01(\tB\x02\x18\x01R\bcurrency\x12\x1b\n" +
 	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x14\n" +
-	"\x05stock\x18\x06 \x01(\x05R\x05stock\"G\n" +
+	"\x05stock\x18\x06 \x01(\x05R\x05stock\x12+\n" +
+	"\x11reorder_threshold\x18\a \x01(\x05R\x10reorderThreshold\x12)\n" +
+	"\x10reorder_quantity\x18\b \x01(\x05R\x0freorderQuantity\x12'\n" +
+	"\x0fallow_backorder\x18\t \x01(\bR\x0eallowBackorder\x12J\n" +
+	"\x13expected_restock_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedRestockAt\x12!\n" +
+	"\ftax_category\x18\v \x01(\tR\vtaxCategory\x12.\n" +
+	"\n" +
+	"base_price\x18\f \x01(\v2\x0f.money.v1.MoneyR\tbasePrice\x12.\n" +
+	"\n" +
+	"price_list\x18\r \x03(\v2\x0f.money.v1.MoneyR\tpriceList\x12\x1f\n" +
+	"\vcategory_id\x18\x0e \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x126\n" +
+	"\n" +
+	"attributes\x18\x10 \x03(\v2\x16.products.v1.AttributeR\n" +
+	"attributes\"G\n" +
 	"\x15CreateProductResponse\x12.\n" +
 	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"D\n" +
 	"\x12GetProductResponse\x12.\n" +
-	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"#\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"L\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0finclude_deleted\x18\x02 \x01(\bR\x0eincludeDeleted\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\adeleted\x18\x02 \x01(\bR\adeleted2\x8f\x02\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\"'\n" +
+	"\x15RestoreProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x16RestoreProductResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"M\n" +
+	"\x0eInventoryLevel\x12\x1f\n" +
+	"\vlocation_id\x18\x01 \x01(\tR\n" +
+	"locationId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"_\n" +
+	"\x16AdjustInventoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\"b\n" +
+	"\x17AdjustInventoryResponse\x121\n" +
+	"\x05level\x18\x01 \x01(\v2\x1b.products.v1.InventoryLevelR\x05level\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\"%\n" +
+	"\x13GetInventoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8a\x01\n" +
+	"\x14GetInventoryResponse\x123\n" +
+	"\x06levels\x18\x01 \x03(\v2\x1b.products.v1.InventoryLevelR\x06levels\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\x12'\n" +
+	"\x0favailable_stock\x18\x03 \x01(\x05R\x0eavailableStock\"\xeb\x01\n" +
+	"\rStockMovement\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vlocation_id\x18\x02 \x01(\tR\n" +
+	"locationId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\x03R\aorderId\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xd9\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"~\n" +
+	"\x1aListStockMovementsResponse\x128\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1a.products.v1.StockMovementR\tmovements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x84\x01\n" +
+	"\x1aUpdateReorderPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x11reorder_threshold\x18\x02 \x01(\x05R\x10reorderThreshold\x12)\n" +
+	"\x10reorder_quantity\x18\x03 \x01(\x05R\x0freorderQuantity\"M\n" +
+	"\x1bUpdateReorderPolicyResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"\xa3\x01\n" +
+	"\x1cUpdateBackorderPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fallow_backorder\x18\x02 \x01(\bR\x0eallowBackorder\x12J\n" +
+	"\x13expected_restock_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11expectedRestockAt\"O\n" +
+	"\x1dUpdateBackorderPolicyResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"M\n" +
+	"\x18UpdateTaxCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\ftax_category\x18\x02 \x01(\tR\vtaxCategory\"K\n" +
+	"\x19UpdateTaxCategoryResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"N\n" +
+	"\x13SetPriceListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x06prices\x18\x02 \x03(\v2\x0f.money.v1.MoneyR\x06prices\"F\n" +
+	"\x14SetPriceListResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"\xcd\x01\n" +
+	"\x14CreateVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x126\n" +
+	"\x0eprice_override\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\rpriceOverride\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x05R\x05stock\x126\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2\x16.products.v1.AttributeR\n" +
+	"attributes\"G\n" +
+	"\x15CreateVariantResponse\x12.\n" +
+	"\avariant\x18\x01 \x01(\v2\x14.products.v1.ProductR\avariant\"\x96\x01\n" +
+	"\x14UpdateVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x126\n" +
+	"\x0eprice_override\x18\x02 \x01(\v2\x0f.money.v1.MoneyR\rpriceOverride\x126\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2\x16.products.v1.AttributeR\n" +
+	"attributes\"G\n" +
+	"\x15UpdateVariantResponse\x12.\n" +
+	"\avariant\x18\x01 \x01(\v2\x14.products.v1.ProductR\avariant\"*\n" +
+	"\x16GetProductBySkuRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"y\n" +
+	"\x17GetProductBySkuResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\x12.\n" +
+	"\avariant\x18\x02 \x01(\v2\x14.products.v1.ProductR\avariant\"\xd1\x01\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12!\n" +
+	"\fprice_bucket\x18\x03 \x01(\tR\vpriceBucket\x12\"\n" +
+	"\rin_stock_only\x18\x04 \x01(\bR\vinStockOnly\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xbd\x01\n" +
+	"\x16SearchProductsResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.products.v1.SearchHitR\x04hits\x12\x1d\n" +
+	"\n" +
+	"total_hits\x18\x02 \x01(\x03R\ttotalHits\x120\n" +
+	"\x06facets\x18\x03 \x03(\v2\x18.products.v1.SearchFacetR\x06facets\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"Q\n" +
+	"\tSearchHit\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"X\n" +
+	"\vSearchFacet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\x06values\x18\x02 \x03(\v2\x1d.products.v1.SearchFacetValueR\x06values\"T\n" +
+	"\x10SearchFacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\x9a\x01\n" +
+	"\x1bUpdateProductCatalogRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x126\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2\x16.products.v1.AttributeR\n" +
+	"attributes\"N\n" +
+	"\x1cUpdateProductCatalogResponse\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.products.v1.ProductR\aproduct\"|\n" +
+	"\x1dListProductsByCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"z\n" +
+	"\x1eListProductsByCategoryResponse\x120\n" +
+	"\bproducts\x18\x01 \x03(\v2\x14.products.v1.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"h\n" +
+	"\x18ListProductsByTagRequest\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"u\n" +
+	"\x19ListProductsByTagResponse\x120\n" +
+	"\bproducts\x18\x01 \x03(\v2\x14.products.v1.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"~\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"K\n" +
+	"\x16CreateCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.products.v1.CategoryR\bcategory\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb0\x01\n" +
+	"\x13GetCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.products.v1.CategoryR\bcategory\x123\n" +
+	"\tancestors\x18\x02 \x03(\v2\x15.products.v1.CategoryR\tancestors\x121\n" +
+	"\bchildren\x18\x03 \x03(\v2\x15.products.v1.CategoryR\bchildren\"\x8e\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"K\n" +
+	"\x16UpdateCategoryResponse\x121\n" +
+	"\bcategory\x18\x01 \x01(\v2\x15.products.v1.CategoryR\bcategory\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"4\n" +
+	"\x15ListCategoriesRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\x03R\bparentId\"O\n" +
+	"\x16ListCategoriesResponse\x125\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x15.products.v1.CategoryR\n" +
+	"categories2\xc4\r\n" +
 	"\x0eProductService\x12V\n" +
 	"\rCreateProduct\x12!.products.v1.CreateProductRequest\x1a\".products.v1.CreateProductResponse\x12M\n" +
 	"\n" +
 	"GetProduct\x12\x1e.products.v1.GetProductRequest\x1a\x1f.products.v1.GetProductResponse\x12V\n" +
-	"\rDeleteProduct\x12!.products.v1.DeleteProductRequest\x1a\".products.v1.DeleteProductResponseB\xaa\x01\n" +
+	"\rDeleteProduct\x12!.products.v1.DeleteProductRequest\x1a\".products.v1.DeleteProductResponse\x12Y\n" +
+	"\x0eRestoreProduct\x12\".products.v1.RestoreProductRequest\x1a#.products.v1.RestoreProductResponse\x12\\\n" +
+	"\x0fAdjustInventory\x12#.products.v1.AdjustInventoryRequest\x1a$.products.v1.AdjustInventoryResponse\x12S\n" +
+	"\fGetInventory\x12 .products.v1.GetInventoryRequest\x1a!.products.v1.GetInventoryResponse\x12e\n" +
+	"\x12ListStockMovements\x12&.products.v1.ListStockMovementsRequest\x1a'.products.v1.ListStockMovementsResponse\x12h\n" +
+	"\x13UpdateReorderPolicy\x12'.products.v1.UpdateReorderPolicyRequest\x1a(.products.v1.UpdateReorderPolicyResponse\x12n\n" +
+	"\x15UpdateBackorderPolicy\x12).products.v1.UpdateBackorderPolicyRequest\x1a*.products.v1.UpdateBackorderPolicyResponse\x12b\n" +
+	"\x11UpdateTaxCategory\x12%.products.v1.UpdateTaxCategoryRequest\x1a&.products.v1.UpdateTaxCategoryResponse\x12S\n" +
+	"\fSetPriceList\x12 .products.v1.SetPriceListRequest\x1a!.products.v1.SetPriceListResponse\x12k\n" +
+	"\x14UpdateProductCatalog\x12(.products.v1.UpdateProductCatalogRequest\x1a).products.v1.UpdateProductCatalogResponse\x12q\n" +
+	"\x16ListProductsByCategory\x12*.products.v1.ListProductsByCategoryRequest\x1a+.products.v1.ListProductsByCategoryResponse\x12b\n" +
+	"\x11ListProductsByTag\x12%.products.v1.ListProductsByTagRequest\x1a&.products.v1.ListProductsByTagResponse\x12V\n" +
+	"\rCreateVariant\x12!.products.v1.CreateVariantRequest\x1a\".products.v1.CreateVariantResponse\x12V\n" +
+	"\rUpdateVariant\x12!.products.v1.UpdateVariantRequest\x1a\".products.v1.UpdateVariantResponse\x12\\\n" +
+	"\x0fGetProductBySku\x12#.products.v1.GetProductBySkuRequest\x1a$.products.v1.GetProductBySkuResponse\x12Y\n" +
+	"\x0eSearchProducts\x12\".products.v1.SearchProductsRequest\x1a#.products.v1.SearchProductsResponse2\xcf\x03\n" +
+	"\x0fCategoryService\x12Y\n" +
+	"\x0eCreateCategory\x12\".products.v1.CreateCategoryRequest\x1a#.products.v1.CreateCategoryResponse\x12P\n" +
+	"\vGetCategory\x12\x1f.products.v1.GetCategoryRequest\x1a .products.v1.GetCategoryResponse\x12Y\n" +
+	"\x0eUpdateCategory\x12\".products.v1.UpdateCategoryRequest\x1a#.products.v1.UpdateCategoryResponse\x12Y\n" +
+	"\x0eDeleteCategory\x12\".products.v1.DeleteCategoryRequest\x1a#.products.v1.DeleteCategoryResponse\x12Y\n" +
+	"\x0eListCategories\x12\".products.v1.ListCategoriesRequest\x1a#.products.v1.ListCategoriesResponseB\xaa\x01\n" +
 	"\x0fcom.products.v1B\rProductsProtoP\x01Z;github.com/bufbuild/buf-examples/gen/products/v1;productsv1\xa2\x02\x03PXX\xaa\x02\vProducts.V1\xca\x02\vProducts\\V1\xe2\x02\x17Products\\V1\\GPBMetadata\xea\x02\fProducts::V1b\x06proto3"
 
 var (
@@ -487,33 +3637,168 @@ func file_products_v1_products_proto_rawDescGZIP() []byte {
 	return file_products_v1_products_proto_rawDescData
 }
 
-var file_products_v1_products_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_products_v1_products_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_products_v1_products_proto_goTypes = []any{
-	(*Product)(nil),               // 0: products.v1.Product
-	(*CreateProductRequest)(nil),  // 1: products.v1.CreateProductRequest
-	(*CreateProductResponse)(nil), // 2: products.v1.CreateProductResponse
-	(*GetProductResponse)(nil),    // 3: products.v1.GetProductResponse
-	(*GetProductRequest)(nil),     // 4: products.v1.GetProductRequest
-	(*DeleteProductRequest)(nil),  // 5: products.v1.DeleteProductRequest
-	(*DeleteProductResponse)(nil), // 6: products.v1.DeleteProductResponse
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*Product)(nil),                        // 0: products.v1.Product
+	(*Attribute)(nil),                      // 1: products.v1.Attribute
+	(*Category)(nil),                       // 2: products.v1.Category
+	(*CreateProductRequest)(nil),           // 3: products.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),          // 4: products.v1.CreateProductResponse
+	(*GetProductResponse)(nil),             // 5: products.v1.GetProductResponse
+	(*GetProductRequest)(nil),              // 6: products.v1.GetProductRequest
+	(*DeleteProductRequest)(nil),           // 7: products.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),          // 8: products.v1.DeleteProductResponse
+	(*RestoreProductRequest)(nil),          // 9: products.v1.RestoreProductRequest
+	(*RestoreProductResponse)(nil),         // 10: products.v1.RestoreProductResponse
+	(*InventoryLevel)(nil),                 // 11: products.v1.InventoryLevel
+	(*AdjustInventoryRequest)(nil),         // 12: products.v1.AdjustInventoryRequest
+	(*AdjustInventoryResponse)(nil),        // 13: products.v1.AdjustInventoryResponse
+	(*GetInventoryRequest)(nil),            // 14: products.v1.GetInventoryRequest
+	(*GetInventoryResponse)(nil),           // 15: products.v1.GetInventoryResponse
+	(*StockMovement)(nil),                  // 16: products.v1.StockMovement
+	(*ListStockMovementsRequest)(nil),      // 17: products.v1.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),     // 18: products.v1.ListStockMovementsResponse
+	(*UpdateReorderPolicyRequest)(nil),     // 19: products.v1.UpdateReorderPolicyRequest
+	(*UpdateReorderPolicyResponse)(nil),    // 20: products.v1.UpdateReorderPolicyResponse
+	(*UpdateBackorderPolicyRequest)(nil),   // 21: products.v1.UpdateBackorderPolicyRequest
+	(*UpdateBackorderPolicyResponse)(nil),  // 22: products.v1.UpdateBackorderPolicyResponse
+	(*UpdateTaxCategoryRequest)(nil),       // 23: products.v1.UpdateTaxCategoryRequest
+	(*UpdateTaxCategoryResponse)(nil),      // 24: products.v1.UpdateTaxCategoryResponse
+	(*SetPriceListRequest)(nil),            // 25: products.v1.SetPriceListRequest
+	(*SetPriceListResponse)(nil),           // 26: products.v1.SetPriceListResponse
+	(*CreateVariantRequest)(nil),           // 27: products.v1.CreateVariantRequest
+	(*CreateVariantResponse)(nil),          // 28: products.v1.CreateVariantResponse
+	(*UpdateVariantRequest)(nil),           // 29: products.v1.UpdateVariantRequest
+	(*UpdateVariantResponse)(nil),          // 30: products.v1.UpdateVariantResponse
+	(*GetProductBySkuRequest)(nil),         // 31: products.v1.GetProductBySkuRequest
+	(*GetProductBySkuResponse)(nil),        // 32: products.v1.GetProductBySkuResponse
+	(*SearchProductsRequest)(nil),          // 33: products.v1.SearchProductsRequest
+	(*SearchProductsResponse)(nil),         // 34: products.v1.SearchProductsResponse
+	(*SearchHit)(nil),                      // 35: products.v1.SearchHit
+	(*SearchFacet)(nil),                    // 36: products.v1.SearchFacet
+	(*SearchFacetValue)(nil),               // 37: products.v1.SearchFacetValue
+	(*UpdateProductCatalogRequest)(nil),    // 38: products.v1.UpdateProductCatalogRequest
+	(*UpdateProductCatalogResponse)(nil),   // 39: products.v1.UpdateProductCatalogResponse
+	(*ListProductsByCategoryRequest)(nil),  // 40: products.v1.ListProductsByCategoryRequest
+	(*ListProductsByCategoryResponse)(nil), // 41: products.v1.ListProductsByCategoryResponse
+	(*ListProductsByTagRequest)(nil),       // 42: products.v1.ListProductsByTagRequest
+	(*ListProductsByTagResponse)(nil),      // 43: products.v1.ListProductsByTagResponse
+	(*CreateCategoryRequest)(nil),          // 44: products.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),         // 45: products.v1.CreateCategoryResponse
+	(*GetCategoryRequest)(nil),             // 46: products.v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),            // 47: products.v1.GetCategoryResponse
+	(*UpdateCategoryRequest)(nil),          // 48: products.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),         // 49: products.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),          // 50: products.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),         // 51: products.v1.DeleteCategoryResponse
+	(*ListCategoriesRequest)(nil),          // 52: products.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),         // 53: products.v1.ListCategoriesResponse
+	(*timestamppb.Timestamp)(nil),          // 54: google.protobuf.Timestamp
+	(*v1.Money)(nil),                       // 55: money.v1.Money
 }
 var file_products_v1_products_proto_depIdxs = []int32{
-	7, // 0: products.v1.Product.created_at:type_name -> google.protobuf.Timestamp
-	7, // 1: products.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 2: products.v1.CreateProductResponse.product:type_name -> products.v1.Product
-	0, // 3: products.v1.GetProductResponse.product:type_name -> products.v1.Product
-	1, // 4: products.v1.ProductService.CreateProduct:input_type -> products.v1.CreateProductRequest
-	4, // 5: products.v1.ProductService.GetProduct:input_type -> products.v1.GetProductRequest
-	5, // 6: products.v1.ProductService.DeleteProduct:input_type -> products.v1.DeleteProductRequest
-	2, // 7: products.v1.ProductService.CreateProduct:output_type -> products.v1.CreateProductResponse
-	3, // 8: products.v1.ProductService.GetProduct:output_type -> products.v1.GetProductResponse
-	6, // 9: products.v1.ProductService.DeleteProduct:output_type -> products.v1.DeleteProductResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	54, // 0: products.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	54, // 1: products.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 2: products.v1.Product.expected_restock_at:type_name -> google.protobuf.Timestamp
+	55, // 3: products.v1.Product.base_price:type_name -> money.v1.Money
+	55, // 4: products.v1.Product.price_list:type_name -> money.v1.Money
+	54, // 5: products.v1.Product.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: products.v1.Product.attributes:type_name -> products.v1.Attribute
+	0,  // 7: products.v1.Product.variants:type_name -> products.v1.Product
+	54, // 8: products.v1.Category.created_at:type_name -> google.protobuf.Timestamp
+	54, // 9: products.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	54, // 10: products.v1.CreateProductRequest.expected_restock_at:type_name -> google.protobuf.Timestamp
+	55, // 11: products.v1.CreateProductRequest.base_price:type_name -> money.v1.Money
+	55, // 12: products.v1.CreateProductRequest.price_list:type_name -> money.v1.Money
+	1,  // 13: products.v1.CreateProductRequest.attributes:type_name -> products.v1.Attribute
+	0,  // 14: products.v1.CreateProductResponse.product:type_name -> products.v1.Product
+	0,  // 15: products.v1.GetProductResponse.product:type_name -> products.v1.Product
+	0,  // 16: products.v1.RestoreProductResponse.product:type_name -> products.v1.Product
+	11, // 17: products.v1.AdjustInventoryResponse.level:type_name -> products.v1.InventoryLevel
+	11, // 18: products.v1.GetInventoryResponse.levels:type_name -> products.v1.InventoryLevel
+	54, // 19: products.v1.StockMovement.occurred_at:type_name -> google.protobuf.Timestamp
+	54, // 20: products.v1.ListStockMovementsRequest.start_time:type_name -> google.protobuf.Timestamp
+	54, // 21: products.v1.ListStockMovementsRequest.end_time:type_name -> google.protobuf.Timestamp
+	16, // 22: products.v1.ListStockMovementsResponse.movements:type_name -> products.v1.StockMovement
+	0,  // 23: products.v1.UpdateReorderPolicyResponse.product:type_name -> products.v1.Product
+	54, // 24: products.v1.UpdateBackorderPolicyRequest.expected_restock_at:type_name -> google.protobuf.Timestamp
+	0,  // 25: products.v1.UpdateBackorderPolicyResponse.product:type_name -> products.v1.Product
+	0,  // 26: products.v1.UpdateTaxCategoryResponse.product:type_name -> products.v1.Product
+	55, // 27: products.v1.SetPriceListRequest.prices:type_name -> money.v1.Money
+	0,  // 28: products.v1.SetPriceListResponse.product:type_name -> products.v1.Product
+	55, // 29: products.v1.CreateVariantRequest.price_override:type_name -> money.v1.Money
+	1,  // 30: products.v1.CreateVariantRequest.attributes:type_name -> products.v1.Attribute
+	0,  // 31: products.v1.CreateVariantResponse.variant:type_name -> products.v1.Product
+	55, // 32: products.v1.UpdateVariantRequest.price_override:type_name -> money.v1.Money
+	1,  // 33: products.v1.UpdateVariantRequest.attributes:type_name -> products.v1.Attribute
+	0,  // 34: products.v1.UpdateVariantResponse.variant:type_name -> products.v1.Product
+	0,  // 35: products.v1.GetProductBySkuResponse.product:type_name -> products.v1.Product
+	0,  // 36: products.v1.GetProductBySkuResponse.variant:type_name -> products.v1.Product
+	35, // 37: products.v1.SearchProductsResponse.hits:type_name -> products.v1.SearchHit
+	36, // 38: products.v1.SearchProductsResponse.facets:type_name -> products.v1.SearchFacet
+	0,  // 39: products.v1.SearchHit.product:type_name -> products.v1.Product
+	37, // 40: products.v1.SearchFacet.values:type_name -> products.v1.SearchFacetValue
+	1,  // 41: products.v1.UpdateProductCatalogRequest.attributes:type_name -> products.v1.Attribute
+	0,  // 42: products.v1.UpdateProductCatalogResponse.product:type_name -> products.v1.Product
+	0,  // 43: products.v1.ListProductsByCategoryResponse.products:type_name -> products.v1.Product
+	0,  // 44: products.v1.ListProductsByTagResponse.products:type_name -> products.v1.Product
+	2,  // 45: products.v1.CreateCategoryResponse.category:type_name -> products.v1.Category
+	2,  // 46: products.v1.GetCategoryResponse.category:type_name -> products.v1.Category
+	2,  // 47: products.v1.GetCategoryResponse.ancestors:type_name -> products.v1.Category
+	2,  // 48: products.v1.GetCategoryResponse.children:type_name -> products.v1.Category
+	2,  // 49: products.v1.UpdateCategoryResponse.category:type_name -> products.v1.Category
+	2,  // 50: products.v1.ListCategoriesResponse.categories:type_name -> products.v1.Category
+	3,  // 51: products.v1.ProductService.CreateProduct:input_type -> products.v1.CreateProductRequest
+	6,  // 52: products.v1.ProductService.GetProduct:input_type -> products.v1.GetProductRequest
+	7,  // 53: products.v1.ProductService.DeleteProduct:input_type -> products.v1.DeleteProductRequest
+	9,  // 54: products.v1.ProductService.RestoreProduct:input_type -> products.v1.RestoreProductRequest
+	12, // 55: products.v1.ProductService.AdjustInventory:input_type -> products.v1.AdjustInventoryRequest
+	14, // 56: products.v1.ProductService.GetInventory:input_type -> products.v1.GetInventoryRequest
+	17, // 57: products.v1.ProductService.ListStockMovements:input_type -> products.v1.ListStockMovementsRequest
+	19, // 58: products.v1.ProductService.UpdateReorderPolicy:input_type -> products.v1.UpdateReorderPolicyRequest
+	21, // 59: products.v1.ProductService.UpdateBackorderPolicy:input_type -> products.v1.UpdateBackorderPolicyRequest
+	23, // 60: products.v1.ProductService.UpdateTaxCategory:input_type -> products.v1.UpdateTaxCategoryRequest
+	25, // 61: products.v1.ProductService.SetPriceList:input_type -> products.v1.SetPriceListRequest
+	38, // 62: products.v1.ProductService.UpdateProductCatalog:input_type -> products.v1.UpdateProductCatalogRequest
+	40, // 63: products.v1.ProductService.ListProductsByCategory:input_type -> products.v1.ListProductsByCategoryRequest
+	42, // 64: products.v1.ProductService.ListProductsByTag:input_type -> products.v1.ListProductsByTagRequest
+	27, // 65: products.v1.ProductService.CreateVariant:input_type -> products.v1.CreateVariantRequest
+	29, // 66: products.v1.ProductService.UpdateVariant:input_type -> products.v1.UpdateVariantRequest
+	31, // 67: products.v1.ProductService.GetProductBySku:input_type -> products.v1.GetProductBySkuRequest
+	33, // 68: products.v1.ProductService.SearchProducts:input_type -> products.v1.SearchProductsRequest
+	44, // 69: products.v1.CategoryService.CreateCategory:input_type -> products.v1.CreateCategoryRequest
+	46, // 70: products.v1.CategoryService.GetCategory:input_type -> products.v1.GetCategoryRequest
+	48, // 71: products.v1.CategoryService.UpdateCategory:input_type -> products.v1.UpdateCategoryRequest
+	50, // 72: products.v1.CategoryService.DeleteCategory:input_type -> products.v1.DeleteCategoryRequest
+	52, // 73: products.v1.CategoryService.ListCategories:input_type -> products.v1.ListCategoriesRequest
+	4,  // 74: products.v1.ProductService.CreateProduct:output_type -> products.v1.CreateProductResponse
+	5,  // 75: products.v1.ProductService.GetProduct:output_type -> products.v1.GetProductResponse
+	8,  // 76: products.v1.ProductService.DeleteProduct:output_type -> products.v1.DeleteProductResponse
+	10, // 77: products.v1.ProductService.RestoreProduct:output_type -> products.v1.RestoreProductResponse
+	13, // 78: products.v1.ProductService.AdjustInventory:output_type -> products.v1.AdjustInventoryResponse
+	15, // 79: products.v1.ProductService.GetInventory:output_type -> products.v1.GetInventoryResponse
+	18, // 80: products.v1.ProductService.ListStockMovements:output_type -> products.v1.ListStockMovementsResponse
+	20, // 81: products.v1.ProductService.UpdateReorderPolicy:output_type -> products.v1.UpdateReorderPolicyResponse
+	22, // 82: products.v1.ProductService.UpdateBackorderPolicy:output_type -> products.v1.UpdateBackorderPolicyResponse
+	24, // 83: products.v1.ProductService.UpdateTaxCategory:output_type -> products.v1.UpdateTaxCategoryResponse
+	26, // 84: products.v1.ProductService.SetPriceList:output_type -> products.v1.SetPriceListResponse
+	39, // 85: products.v1.ProductService.UpdateProductCatalog:output_type -> products.v1.UpdateProductCatalogResponse
+	41, // 86: products.v1.ProductService.ListProductsByCategory:output_type -> products.v1.ListProductsByCategoryResponse
+	43, // 87: products.v1.ProductService.ListProductsByTag:output_type -> products.v1.ListProductsByTagResponse
+	28, // 88: products.v1.ProductService.CreateVariant:output_type -> products.v1.CreateVariantResponse
+	30, // 89: products.v1.ProductService.UpdateVariant:output_type -> products.v1.UpdateVariantResponse
+	32, // 90: products.v1.ProductService.GetProductBySku:output_type -> products.v1.GetProductBySkuResponse
+	34, // 91: products.v1.ProductService.SearchProducts:output_type -> products.v1.SearchProductsResponse
+	45, // 92: products.v1.CategoryService.CreateCategory:output_type -> products.v1.CreateCategoryResponse
+	47, // 93: products.v1.CategoryService.GetCategory:output_type -> products.v1.GetCategoryResponse
+	49, // 94: products.v1.CategoryService.UpdateCategory:output_type -> products.v1.UpdateCategoryResponse
+	51, // 95: products.v1.CategoryService.DeleteCategory:output_type -> products.v1.DeleteCategoryResponse
+	53, // 96: products.v1.CategoryService.ListCategories:output_type -> products.v1.ListCategoriesResponse
+	74, // [74:97] is the sub-list for method output_type
+	51, // [51:74] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_products_v1_products_proto_init() }
@@ -521,15 +3806,20 @@ func file_products_v1_products_proto_init() {
 	if File_products_v1_products_proto != nil {
 		return
 	}
+	file_products_v1_products_proto_msgTypes[1].OneofWrappers = []any{
+		(*Attribute_Text)(nil),
+		(*Attribute_Number)(nil),
+		(*Attribute_Flag)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_products_v1_products_proto_rawDesc), len(file_products_v1_products_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   54,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_products_v1_products_proto_goTypes,
 		DependencyIndexes: file_products_v1_products_proto_depIdxs,
@@ -24,6 +24,8 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// ProductServiceName is the fully-qualified name of the ProductService service.
 	ProductServiceName = "products.v1.ProductService"
+	// CategoryServiceName is the fully-qualified name of the CategoryService service.
+	CategoryServiceName = "products.v1.CategoryService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -43,13 +45,103 @@ const (
 	// ProductServiceDeleteProductProcedure is the fully-qualified name of the ProductService's
 	// DeleteProduct RPC.
 	ProductServiceDeleteProductProcedure = "/products.v1.ProductService/DeleteProduct"
+	// ProductServiceRestoreProductProcedure is the fully-qualified name of the ProductService's
+	// RestoreProduct RPC.
+	ProductServiceRestoreProductProcedure = "/products.v1.ProductService/RestoreProduct"
+	// ProductServiceAdjustInventoryProcedure is the fully-qualified name of the ProductService's
+	// AdjustInventory RPC.
+	ProductServiceAdjustInventoryProcedure = "/products.v1.ProductService/AdjustInventory"
+	// ProductServiceGetInventoryProcedure is the fully-qualified name of the ProductService's
+	// GetInventory RPC.
+	ProductServiceGetInventoryProcedure = "/products.v1.ProductService/GetInventory"
+	// ProductServiceListStockMovementsProcedure is the fully-qualified name of the ProductService's
+	// ListStockMovements RPC.
+	ProductServiceListStockMovementsProcedure = "/products.v1.ProductService/ListStockMovements"
+	// ProductServiceUpdateReorderPolicyProcedure is the fully-qualified name of the ProductService's
+	// UpdateReorderPolicy RPC.
+	ProductServiceUpdateReorderPolicyProcedure = "/products.v1.ProductService/UpdateReorderPolicy"
+	// ProductServiceUpdateBackorderPolicyProcedure is the fully-qualified name of the ProductService's
+	// UpdateBackorderPolicy RPC.
+	ProductServiceUpdateBackorderPolicyProcedure = "/products.v1.ProductService/UpdateBackorderPolicy"
+	// ProductServiceUpdateTaxCategoryProcedure is the fully-qualified name of the ProductService's
+	// UpdateTaxCategory RPC.
+	ProductServiceUpdateTaxCategoryProcedure = "/products.v1.ProductService/UpdateTaxCategory"
+	// ProductServiceSetPriceListProcedure is the fully-qualified name of the ProductService's
+	// SetPriceList RPC.
+	ProductServiceSetPriceListProcedure = "/products.v1.ProductService/SetPriceList"
+	// ProductServiceUpdateProductCatalogProcedure is the fully-qualified name of the ProductService's
+	// UpdateProductCatalog RPC.
+	ProductServiceUpdateProductCatalogProcedure = "/products.v1.ProductService/UpdateProductCatalog"
+	// ProductServiceListProductsByCategoryProcedure is the fully-qualified name of the ProductService's
+	// ListProductsByCategory RPC.
+	ProductServiceListProductsByCategoryProcedure = "/products.v1.ProductService/ListProductsByCategory"
+	// ProductServiceListProductsByTagProcedure is the fully-qualified name of the ProductService's
+	// ListProductsByTag RPC.
+	ProductServiceListProductsByTagProcedure = "/products.v1.ProductService/ListProductsByTag"
+	// ProductServiceCreateVariantProcedure is the fully-qualified name of the ProductService's
+	// CreateVariant RPC.
+	ProductServiceCreateVariantProcedure = "/products.v1.ProductService/CreateVariant"
+	// ProductServiceUpdateVariantProcedure is the fully-qualified name of the ProductService's
+	// UpdateVariant RPC.
+	ProductServiceUpdateVariantProcedure = "/products.v1.ProductService/UpdateVariant"
+	// ProductServiceGetProductBySkuProcedure is the fully-qualified name of the ProductService's
+	// GetProductBySku RPC.
+	ProductServiceGetProductBySkuProcedure = "/products.v1.ProductService/GetProductBySku"
+	// ProductServiceSearchProductsProcedure is the fully-qualified name of the ProductService's
+	// SearchProducts RPC.
+	ProductServiceSearchProductsProcedure = "/products.v1.ProductService/SearchProducts"
+	// CategoryServiceCreateCategoryProcedure is the fully-qualified name of the CategoryService's
+	// CreateCategory RPC.
+	CategoryServiceCreateCategoryProcedure = "/products.v1.CategoryService/CreateCategory"
+	// CategoryServiceGetCategoryProcedure is the fully-qualified name of the CategoryService's
+	// GetCategory RPC.
+	CategoryServiceGetCategoryProcedure = "/products.v1.CategoryService/GetCategory"
+	// CategoryServiceUpdateCategoryProcedure is the fully-qualified name of the CategoryService's
+	// UpdateCategory RPC.
+	CategoryServiceUpdateCategoryProcedure = "/products.v1.CategoryService/UpdateCategory"
+	// CategoryServiceDeleteCategoryProcedure is the fully-qualified name of the CategoryService's
+	// DeleteCategory RPC.
+	CategoryServiceDeleteCategoryProcedure = "/products.v1.CategoryService/DeleteCategory"
+	// CategoryServiceListCategoriesProcedure is the fully-qualified name of the CategoryService's
+	// ListCategories RPC.
+	CategoryServiceListCategoriesProcedure = "/products.v1.CategoryService/ListCategories"
 )
 
 // ProductServiceClient is a client for the products.v1.ProductService service.
 type ProductServiceClient interface {
 	CreateProduct(context.Context, *connect.Request[v1.CreateProductRequest]) (*connect.Response[v1.CreateProductResponse], error)
 	GetProduct(context.Context, *connect.Request[v1.GetProductRequest]) (*connect.Response[v1.GetProductResponse], error)
+	// Hides a product until it is restored or purged; products in orders are never purged.
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
+	// Undoes the deletion of a product that has not been purged yet.
+	RestoreProduct(context.Context, *connect.Request[v1.RestoreProductRequest]) (*connect.Response[v1.RestoreProductResponse], error)
+	// Adds to or removes from the stock of a product at one warehouse.
+	AdjustInventory(context.Context, *connect.Request[v1.AdjustInventoryRequest]) (*connect.Response[v1.AdjustInventoryResponse], error)
+	// Lists the stock of a product per warehouse.
+	GetInventory(context.Context, *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error)
+	// Lists the stock movements of a product in a time range, newest first.
+	ListStockMovements(context.Context, *connect.Request[v1.ListStockMovementsRequest]) (*connect.Response[v1.ListStockMovementsResponse], error)
+	// Sets when and how much of a product is reordered.
+	UpdateReorderPolicy(context.Context, *connect.Request[v1.UpdateReorderPolicyRequest]) (*connect.Response[v1.UpdateReorderPolicyResponse], error)
+	// Sets whether a product can be backordered and when it is expected back in stock.
+	UpdateBackorderPolicy(context.Context, *connect.Request[v1.UpdateBackorderPolicyRequest]) (*connect.Response[v1.UpdateBackorderPolicyResponse], error)
+	// Sets the tax category that picks the tax rate of a product.
+	UpdateTaxCategory(context.Context, *connect.Request[v1.UpdateTaxCategoryRequest]) (*connect.Response[v1.UpdateTaxCategoryResponse], error)
+	// Sets the prices of a product in currencies other than its own.
+	SetPriceList(context.Context, *connect.Request[v1.SetPriceListRequest]) (*connect.Response[v1.SetPriceListResponse], error)
+	// Sets the category, tags and attributes of a product.
+	UpdateProductCatalog(context.Context, *connect.Request[v1.UpdateProductCatalogRequest]) (*connect.Response[v1.UpdateProductCatalogResponse], error)
+	// Lists the products of a category, by id.
+	ListProductsByCategory(context.Context, *connect.Request[v1.ListProductsByCategoryRequest]) (*connect.Response[v1.ListProductsByCategoryResponse], error)
+	// Lists the products with a tag, by id.
+	ListProductsByTag(context.Context, *connect.Request[v1.ListProductsByTagRequest]) (*connect.Response[v1.ListProductsByTagResponse], error)
+	// Adds a variant with its own SKU to a product.
+	CreateVariant(context.Context, *connect.Request[v1.CreateVariantRequest]) (*connect.Response[v1.CreateVariantResponse], error)
+	UpdateVariant(context.Context, *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error)
+	// Looks up a variant and its product by SKU.
+	GetProductBySku(context.Context, *connect.Request[v1.GetProductBySkuRequest]) (*connect.Response[v1.GetProductBySkuResponse], error)
+	// Searches the products by text, with facets to narrow the search down.
+	SearchProducts(context.Context, *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error)
 }
 
 // NewProductServiceClient constructs a client for the products.v1.ProductService service. By
@@ -81,14 +173,119 @@ func NewProductServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(productServiceMethods.ByName("DeleteProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		restoreProduct: connect.NewClient[v1.RestoreProductRequest, v1.RestoreProductResponse](
+			httpClient,
+			baseURL+ProductServiceRestoreProductProcedure,
+			connect.WithSchema(productServiceMethods.ByName("RestoreProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		adjustInventory: connect.NewClient[v1.AdjustInventoryRequest, v1.AdjustInventoryResponse](
+			httpClient,
+			baseURL+ProductServiceAdjustInventoryProcedure,
+			connect.WithSchema(productServiceMethods.ByName("AdjustInventory")),
+			connect.WithClientOptions(opts...),
+		),
+		getInventory: connect.NewClient[v1.GetInventoryRequest, v1.GetInventoryResponse](
+			httpClient,
+			baseURL+ProductServiceGetInventoryProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetInventory")),
+			connect.WithClientOptions(opts...),
+		),
+		listStockMovements: connect.NewClient[v1.ListStockMovementsRequest, v1.ListStockMovementsResponse](
+			httpClient,
+			baseURL+ProductServiceListStockMovementsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListStockMovements")),
+			connect.WithClientOptions(opts...),
+		),
+		updateReorderPolicy: connect.NewClient[v1.UpdateReorderPolicyRequest, v1.UpdateReorderPolicyResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateReorderPolicyProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateReorderPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updateBackorderPolicy: connect.NewClient[v1.UpdateBackorderPolicyRequest, v1.UpdateBackorderPolicyResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateBackorderPolicyProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateBackorderPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTaxCategory: connect.NewClient[v1.UpdateTaxCategoryRequest, v1.UpdateTaxCategoryResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateTaxCategoryProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateTaxCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		setPriceList: connect.NewClient[v1.SetPriceListRequest, v1.SetPriceListResponse](
+			httpClient,
+			baseURL+ProductServiceSetPriceListProcedure,
+			connect.WithSchema(productServiceMethods.ByName("SetPriceList")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProductCatalog: connect.NewClient[v1.UpdateProductCatalogRequest, v1.UpdateProductCatalogResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateProductCatalogProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateProductCatalog")),
+			connect.WithClientOptions(opts...),
+		),
+		listProductsByCategory: connect.NewClient[v1.ListProductsByCategoryRequest, v1.ListProductsByCategoryResponse](
+			httpClient,
+			baseURL+ProductServiceListProductsByCategoryProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListProductsByCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		listProductsByTag: connect.NewClient[v1.ListProductsByTagRequest, v1.ListProductsByTagResponse](
+			httpClient,
+			baseURL+ProductServiceListProductsByTagProcedure,
+			connect.WithSchema(productServiceMethods.ByName("ListProductsByTag")),
+			connect.WithClientOptions(opts...),
+		),
+		createVariant: connect.NewClient[v1.CreateVariantRequest, v1.CreateVariantResponse](
+			httpClient,
+			baseURL+ProductServiceCreateVariantProcedure,
+			connect.WithSchema(productServiceMethods.ByName("CreateVariant")),
+			connect.WithClientOptions(opts...),
+		),
+		updateVariant: connect.NewClient[v1.UpdateVariantRequest, v1.UpdateVariantResponse](
+			httpClient,
+			baseURL+ProductServiceUpdateVariantProcedure,
+			connect.WithSchema(productServiceMethods.ByName("UpdateVariant")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductBySku: connect.NewClient[v1.GetProductBySkuRequest, v1.GetProductBySkuResponse](
+			httpClient,
+			baseURL+ProductServiceGetProductBySkuProcedure,
+			connect.WithSchema(productServiceMethods.ByName("GetProductBySku")),
+			connect.WithClientOptions(opts...),
+		),
+		searchProducts: connect.NewClient[v1.SearchProductsRequest, v1.SearchProductsResponse](
+			httpClient,
+			baseURL+ProductServiceSearchProductsProcedure,
+			connect.WithSchema(productServiceMethods.ByName("SearchProducts")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // productServiceClient implements ProductServiceClient.
 type productServiceClient struct {
-	createProduct *connect.Client[v1.CreateProductRequest, v1.CreateProductResponse]
-	getProduct    *connect.Client[v1.GetProductRequest, v1.GetProductResponse]
-	deleteProduct *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	createProduct          *connect.Client[v1.CreateProductRequest, v1.CreateProductResponse]
+	getProduct             *connect.Client[v1.GetProductRequest, v1.GetProductResponse]
+	deleteProduct          *connect.Client[v1.DeleteProductRequest, v1.DeleteProductResponse]
+	restoreProduct         *connect.Client[v1.RestoreProductRequest, v1.RestoreProductResponse]
+	adjustInventory        *connect.Client[v1.AdjustInventoryRequest, v1.AdjustInventoryResponse]
+	getInventory           *connect.Client[v1.GetInventoryRequest, v1.GetInventoryResponse]
+	listStockMovements     *connect.Client[v1.ListStockMovementsRequest, v1.ListStockMovementsResponse]
+	updateReorderPolicy    *connect.Client[v1.UpdateReorderPolicyRequest, v1.UpdateReorderPolicyResponse]
+	updateBackorderPolicy  *connect.Client[v1.UpdateBackorderPolicyRequest, v1.UpdateBackorderPolicyResponse]
+	updateTaxCategory      *connect.Client[v1.UpdateTaxCategoryRequest, v1.UpdateTaxCategoryResponse]
+	setPriceList           *connect.Client[v1.SetPriceListRequest, v1.SetPriceListResponse]
+	updateProductCatalog   *connect.Client[v1.UpdateProductCatalogRequest, v1.UpdateProductCatalogResponse]
+	listProductsByCategory *connect.Client[v1.ListProductsByCategoryRequest, v1.ListProductsByCategoryResponse]
+	listProductsByTag      *connect.Client[v1.ListProductsByTagRequest, v1.ListProductsByTagResponse]
+	createVariant          *connect.Client[v1.CreateVariantRequest, v1.CreateVariantResponse]
+	updateVariant          *connect.Client[v1.UpdateVariantRequest, v1.UpdateVariantResponse]
+	getProductBySku        *connect.Client[v1.GetProductBySkuRequest, v1.GetProductBySkuResponse]
+	searchProducts         *connect.Client[v1.SearchProductsRequest, v1.SearchProductsResponse]
 }
 
 // CreateProduct calls products.v1.ProductService.CreateProduct.
@@ -106,11 +303,116 @@ func (c *productServiceClient) DeleteProduct(ctx context.Context, req *connect.R
 	return c.deleteProduct.CallUnary(ctx, req)
 }
 
+// RestoreProduct calls products.v1.ProductService.RestoreProduct.
+func (c *productServiceClient) RestoreProduct(ctx context.Context, req *connect.Request[v1.RestoreProductRequest]) (*connect.Response[v1.RestoreProductResponse], error) {
+	return c.restoreProduct.CallUnary(ctx, req)
+}
+
+// AdjustInventory calls products.v1.ProductService.AdjustInventory.
+func (c *productServiceClient) AdjustInventory(ctx context.Context, req *connect.Request[v1.AdjustInventoryRequest]) (*connect.Response[v1.AdjustInventoryResponse], error) {
+	return c.adjustInventory.CallUnary(ctx, req)
+}
+
+// GetInventory calls products.v1.ProductService.GetInventory.
+func (c *productServiceClient) GetInventory(ctx context.Context, req *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error) {
+	return c.getInventory.CallUnary(ctx, req)
+}
+
+// ListStockMovements calls products.v1.ProductService.ListStockMovements.
+func (c *productServiceClient) ListStockMovements(ctx context.Context, req *connect.Request[v1.ListStockMovementsRequest]) (*connect.Response[v1.ListStockMovementsResponse], error) {
+	return c.listStockMovements.CallUnary(ctx, req)
+}
+
+// UpdateReorderPolicy calls products.v1.ProductService.UpdateReorderPolicy.
+func (c *productServiceClient) UpdateReorderPolicy(ctx context.Context, req *connect.Request[v1.UpdateReorderPolicyRequest]) (*connect.Response[v1.UpdateReorderPolicyResponse], error) {
+	return c.updateReorderPolicy.CallUnary(ctx, req)
+}
+
+// UpdateBackorderPolicy calls products.v1.ProductService.UpdateBackorderPolicy.
+func (c *productServiceClient) UpdateBackorderPolicy(ctx context.Context, req *connect.Request[v1.UpdateBackorderPolicyRequest]) (*connect.Response[v1.UpdateBackorderPolicyResponse], error) {
+	return c.updateBackorderPolicy.CallUnary(ctx, req)
+}
+
+// UpdateTaxCategory calls products.v1.ProductService.UpdateTaxCategory.
+func (c *productServiceClient) UpdateTaxCategory(ctx context.Context, req *connect.Request[v1.UpdateTaxCategoryRequest]) (*connect.Response[v1.UpdateTaxCategoryResponse], error) {
+	return c.updateTaxCategory.CallUnary(ctx, req)
+}
+
+// SetPriceList calls products.v1.ProductService.SetPriceList.
+func (c *productServiceClient) SetPriceList(ctx context.Context, req *connect.Request[v1.SetPriceListRequest]) (*connect.Response[v1.SetPriceListResponse], error) {
+	return c.setPriceList.CallUnary(ctx, req)
+}
+
+// UpdateProductCatalog calls products.v1.ProductService.UpdateProductCatalog.
+func (c *productServiceClient) UpdateProductCatalog(ctx context.Context, req *connect.Request[v1.UpdateProductCatalogRequest]) (*connect.Response[v1.UpdateProductCatalogResponse], error) {
+	return c.updateProductCatalog.CallUnary(ctx, req)
+}
+
+// ListProductsByCategory calls products.v1.ProductService.ListProductsByCategory.
+func (c *productServiceClient) ListProductsByCategory(ctx context.Context, req *connect.Request[v1.ListProductsByCategoryRequest]) (*connect.Response[v1.ListProductsByCategoryResponse], error) {
+	return c.listProductsByCategory.CallUnary(ctx, req)
+}
+
+// ListProductsByTag calls products.v1.ProductService.ListProductsByTag.
+func (c *productServiceClient) ListProductsByTag(ctx context.Context, req *connect.Request[v1.ListProductsByTagRequest]) (*connect.Response[v1.ListProductsByTagResponse], error) {
+	return c.listProductsByTag.CallUnary(ctx, req)
+}
+
+// CreateVariant calls products.v1.ProductService.CreateVariant.
+func (c *productServiceClient) CreateVariant(ctx context.Context, req *connect.Request[v1.CreateVariantRequest]) (*connect.Response[v1.CreateVariantResponse], error) {
+	return c.createVariant.CallUnary(ctx, req)
+}
+
+// UpdateVariant calls products.v1.ProductService.UpdateVariant.
+func (c *productServiceClient) UpdateVariant(ctx context.Context, req *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error) {
+	return c.updateVariant.CallUnary(ctx, req)
+}
+
+// GetProductBySku calls products.v1.ProductService.GetProductBySku.
+func (c *productServiceClient) GetProductBySku(ctx context.Context, req *connect.Request[v1.GetProductBySkuRequest]) (*connect.Response[v1.GetProductBySkuResponse], error) {
+	return c.getProductBySku.CallUnary(ctx, req)
+}
+
+// SearchProducts calls products.v1.ProductService.SearchProducts.
+func (c *productServiceClient) SearchProducts(ctx context.Context, req *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error) {
+	return c.searchProducts.CallUnary(ctx, req)
+}
+
 // ProductServiceHandler is an implementation of the products.v1.ProductService service.
 type ProductServiceHandler interface {
 	CreateProduct(context.Context, *connect.Request[v1.CreateProductRequest]) (*connect.Response[v1.CreateProductResponse], error)
 	GetProduct(context.Context, *connect.Request[v1.GetProductRequest]) (*connect.Response[v1.GetProductResponse], error)
+	// Hides a product until it is restored or purged; products in orders are never purged.
 	DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error)
+	// Undoes the deletion of a product that has not been purged yet.
+	RestoreProduct(context.Context, *connect.Request[v1.RestoreProductRequest]) (*connect.Response[v1.RestoreProductResponse], error)
+	// Adds to or removes from the stock of a product at one warehouse.
+	AdjustInventory(context.Context, *connect.Request[v1.AdjustInventoryRequest]) (*connect.Response[v1.AdjustInventoryResponse], error)
+	// Lists the stock of a product per warehouse.
+	GetInventory(context.Context, *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error)
+	// Lists the stock movements of a product in a time range, newest first.
+	ListStockMovements(context.Context, *connect.Request[v1.ListStockMovementsRequest]) (*connect.Response[v1.ListStockMovementsResponse], error)
+	// Sets when and how much of a product is reordered.
+	UpdateReorderPolicy(context.Context, *connect.Request[v1.UpdateReorderPolicyRequest]) (*connect.Response[v1.UpdateReorderPolicyResponse], error)
+	// Sets whether a product can be backordered and when it is expected back in stock.
+	UpdateBackorderPolicy(context.Context, *connect.Request[v1.UpdateBackorderPolicyRequest]) (*connect.Response[v1.UpdateBackorderPolicyResponse], error)
+	// Sets the tax category that picks the tax rate of a product.
+	UpdateTaxCategory(context.Context, *connect.Request[v1.UpdateTaxCategoryRequest]) (*connect.Response[v1.UpdateTaxCategoryResponse], error)
+	// Sets the prices of a product in currencies other than its own.
+	SetPriceList(context.Context, *connect.Request[v1.SetPriceListRequest]) (*connect.Response[v1.SetPriceListResponse], error)
+	// Sets the category, tags and attributes of a product.
+	UpdateProductCatalog(context.Context, *connect.Request[v1.UpdateProductCatalogRequest]) (*connect.Response[v1.UpdateProductCatalogResponse], error)
+	// Lists the products of a category, by id.
+	ListProductsByCategory(context.Context, *connect.Request[v1.ListProductsByCategoryRequest]) (*connect.Response[v1.ListProductsByCategoryResponse], error)
+	// Lists the products with a tag, by id.
+	ListProductsByTag(context.Context, *connect.Request[v1.ListProductsByTagRequest]) (*connect.Response[v1.ListProductsByTagResponse], error)
+	// Adds a variant with its own SKU to a product.
+	CreateVariant(context.Context, *connect.Request[v1.CreateVariantRequest]) (*connect.Response[v1.CreateVariantResponse], error)
+	UpdateVariant(context.Context, *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error)
+	// Looks up a variant and its product by SKU.
+	GetProductBySku(context.Context, *connect.Request[v1.GetProductBySkuRequest]) (*connect.Response[v1.GetProductBySkuResponse], error)
+	// Searches the products by text, with facets to narrow the search down.
+	SearchProducts(context.Context, *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error)
 }
 
 // NewProductServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -138,6 +440,96 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 		connect.WithSchema(productServiceMethods.ByName("DeleteProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	productServiceRestoreProductHandler := connect.NewUnaryHandler(
+		ProductServiceRestoreProductProcedure,
+		svc.RestoreProduct,
+		connect.WithSchema(productServiceMethods.ByName("RestoreProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceAdjustInventoryHandler := connect.NewUnaryHandler(
+		ProductServiceAdjustInventoryProcedure,
+		svc.AdjustInventory,
+		connect.WithSchema(productServiceMethods.ByName("AdjustInventory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetInventoryHandler := connect.NewUnaryHandler(
+		ProductServiceGetInventoryProcedure,
+		svc.GetInventory,
+		connect.WithSchema(productServiceMethods.ByName("GetInventory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListStockMovementsHandler := connect.NewUnaryHandler(
+		ProductServiceListStockMovementsProcedure,
+		svc.ListStockMovements,
+		connect.WithSchema(productServiceMethods.ByName("ListStockMovements")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateReorderPolicyHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateReorderPolicyProcedure,
+		svc.UpdateReorderPolicy,
+		connect.WithSchema(productServiceMethods.ByName("UpdateReorderPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateBackorderPolicyHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateBackorderPolicyProcedure,
+		svc.UpdateBackorderPolicy,
+		connect.WithSchema(productServiceMethods.ByName("UpdateBackorderPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateTaxCategoryHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateTaxCategoryProcedure,
+		svc.UpdateTaxCategory,
+		connect.WithSchema(productServiceMethods.ByName("UpdateTaxCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceSetPriceListHandler := connect.NewUnaryHandler(
+		ProductServiceSetPriceListProcedure,
+		svc.SetPriceList,
+		connect.WithSchema(productServiceMethods.ByName("SetPriceList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateProductCatalogHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateProductCatalogProcedure,
+		svc.UpdateProductCatalog,
+		connect.WithSchema(productServiceMethods.ByName("UpdateProductCatalog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListProductsByCategoryHandler := connect.NewUnaryHandler(
+		ProductServiceListProductsByCategoryProcedure,
+		svc.ListProductsByCategory,
+		connect.WithSchema(productServiceMethods.ByName("ListProductsByCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceListProductsByTagHandler := connect.NewUnaryHandler(
+		ProductServiceListProductsByTagProcedure,
+		svc.ListProductsByTag,
+		connect.WithSchema(productServiceMethods.ByName("ListProductsByTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceCreateVariantHandler := connect.NewUnaryHandler(
+		ProductServiceCreateVariantProcedure,
+		svc.CreateVariant,
+		connect.WithSchema(productServiceMethods.ByName("CreateVariant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceUpdateVariantHandler := connect.NewUnaryHandler(
+		ProductServiceUpdateVariantProcedure,
+		svc.UpdateVariant,
+		connect.WithSchema(productServiceMethods.ByName("UpdateVariant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceGetProductBySkuHandler := connect.NewUnaryHandler(
+		ProductServiceGetProductBySkuProcedure,
+		svc.GetProductBySku,
+		connect.WithSchema(productServiceMethods.ByName("GetProductBySku")),
+		connect.WithHandlerOptions(opts...),
+	)
+	productServiceSearchProductsHandler := connect.NewUnaryHandler(
+		ProductServiceSearchProductsProcedure,
+		svc.SearchProducts,
+		connect.WithSchema(productServiceMethods.ByName("SearchProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/products.v1.ProductService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProductServiceCreateProductProcedure:
@@ -146,6 +538,36 @@ func NewProductServiceHandler(svc ProductServiceHandler, opts ...connect.Handler
 			productServiceGetProductHandler.ServeHTTP(w, r)
 		case ProductServiceDeleteProductProcedure:
 			productServiceDeleteProductHandler.ServeHTTP(w, r)
+		case ProductServiceRestoreProductProcedure:
+			productServiceRestoreProductHandler.ServeHTTP(w, r)
+		case ProductServiceAdjustInventoryProcedure:
+			productServiceAdjustInventoryHandler.ServeHTTP(w, r)
+		case ProductServiceGetInventoryProcedure:
+			productServiceGetInventoryHandler.ServeHTTP(w, r)
+		case ProductServiceListStockMovementsProcedure:
+			productServiceListStockMovementsHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateReorderPolicyProcedure:
+			productServiceUpdateReorderPolicyHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateBackorderPolicyProcedure:
+			productServiceUpdateBackorderPolicyHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateTaxCategoryProcedure:
+			productServiceUpdateTaxCategoryHandler.ServeHTTP(w, r)
+		case ProductServiceSetPriceListProcedure:
+			productServiceSetPriceListHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateProductCatalogProcedure:
+			productServiceUpdateProductCatalogHandler.ServeHTTP(w, r)
+		case ProductServiceListProductsByCategoryProcedure:
+			productServiceListProductsByCategoryHandler.ServeHTTP(w, r)
+		case ProductServiceListProductsByTagProcedure:
+			productServiceListProductsByTagHandler.ServeHTTP(w, r)
+		case ProductServiceCreateVariantProcedure:
+			productServiceCreateVariantHandler.ServeHTTP(w, r)
+		case ProductServiceUpdateVariantProcedure:
+			productServiceUpdateVariantHandler.ServeHTTP(w, r)
+		case ProductServiceGetProductBySkuProcedure:
+			productServiceGetProductBySkuHandler.ServeHTTP(w, r)
+		case ProductServiceSearchProductsProcedure:
+			productServiceSearchProductsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -166,3 +588,241 @@ func (UnimplementedProductServiceHandler) GetProduct(context.Context, *connect.R
 func (UnimplementedProductServiceHandler) DeleteProduct(context.Context, *connect.Request[v1.DeleteProductRequest]) (*connect.Response[v1.DeleteProductResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.DeleteProduct is not implemented"))
 }
+
+func (UnimplementedProductServiceHandler) RestoreProduct(context.Context, *connect.Request[v1.RestoreProductRequest]) (*connect.Response[v1.RestoreProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.RestoreProduct is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) AdjustInventory(context.Context, *connect.Request[v1.AdjustInventoryRequest]) (*connect.Response[v1.AdjustInventoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.AdjustInventory is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetInventory(context.Context, *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.GetInventory is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListStockMovements(context.Context, *connect.Request[v1.ListStockMovementsRequest]) (*connect.Response[v1.ListStockMovementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.ListStockMovements is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateReorderPolicy(context.Context, *connect.Request[v1.UpdateReorderPolicyRequest]) (*connect.Response[v1.UpdateReorderPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.UpdateReorderPolicy is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateBackorderPolicy(context.Context, *connect.Request[v1.UpdateBackorderPolicyRequest]) (*connect.Response[v1.UpdateBackorderPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.UpdateBackorderPolicy is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateTaxCategory(context.Context, *connect.Request[v1.UpdateTaxCategoryRequest]) (*connect.Response[v1.UpdateTaxCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.UpdateTaxCategory is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) SetPriceList(context.Context, *connect.Request[v1.SetPriceListRequest]) (*connect.Response[v1.SetPriceListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.SetPriceList is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateProductCatalog(context.Context, *connect.Request[v1.UpdateProductCatalogRequest]) (*connect.Response[v1.UpdateProductCatalogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.UpdateProductCatalog is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListProductsByCategory(context.Context, *connect.Request[v1.ListProductsByCategoryRequest]) (*connect.Response[v1.ListProductsByCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.ListProductsByCategory is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) ListProductsByTag(context.Context, *connect.Request[v1.ListProductsByTagRequest]) (*connect.Response[v1.ListProductsByTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.ListProductsByTag is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) CreateVariant(context.Context, *connect.Request[v1.CreateVariantRequest]) (*connect.Response[v1.CreateVariantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.CreateVariant is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) UpdateVariant(context.Context, *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.UpdateVariant is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) GetProductBySku(context.Context, *connect.Request[v1.GetProductBySkuRequest]) (*connect.Response[v1.GetProductBySkuResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.GetProductBySku is not implemented"))
+}
+
+func (UnimplementedProductServiceHandler) SearchProducts(context.Context, *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.ProductService.SearchProducts is not implemented"))
+}
+
+// CategoryServiceClient is a client for the products.v1.CategoryService service.
+type CategoryServiceClient interface {
+	CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error)
+	GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error)
+	UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error)
+	// Only empty categories, without subcategories or products, can be deleted.
+	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	// Lists the subcategories of a category, or the root categories.
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+}
+
+// NewCategoryServiceClient constructs a client for the products.v1.CategoryService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCategoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CategoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	categoryServiceMethods := v1.File_products_v1_products_proto.Services().ByName("CategoryService").Methods()
+	return &categoryServiceClient{
+		createCategory: connect.NewClient[v1.CreateCategoryRequest, v1.CreateCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceCreateCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("CreateCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		getCategory: connect.NewClient[v1.GetCategoryRequest, v1.GetCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceGetCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("GetCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCategory: connect.NewClient[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceUpdateCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("UpdateCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteCategory: connect.NewClient[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse](
+			httpClient,
+			baseURL+CategoryServiceDeleteCategoryProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("DeleteCategory")),
+			connect.WithClientOptions(opts...),
+		),
+		listCategories: connect.NewClient[v1.ListCategoriesRequest, v1.ListCategoriesResponse](
+			httpClient,
+			baseURL+CategoryServiceListCategoriesProcedure,
+			connect.WithSchema(categoryServiceMethods.ByName("ListCategories")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// categoryServiceClient implements CategoryServiceClient.
+type categoryServiceClient struct {
+	createCategory *connect.Client[v1.CreateCategoryRequest, v1.CreateCategoryResponse]
+	getCategory    *connect.Client[v1.GetCategoryRequest, v1.GetCategoryResponse]
+	updateCategory *connect.Client[v1.UpdateCategoryRequest, v1.UpdateCategoryResponse]
+	deleteCategory *connect.Client[v1.DeleteCategoryRequest, v1.DeleteCategoryResponse]
+	listCategories *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+}
+
+// CreateCategory calls products.v1.CategoryService.CreateCategory.
+func (c *categoryServiceClient) CreateCategory(ctx context.Context, req *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
+	return c.createCategory.CallUnary(ctx, req)
+}
+
+// GetCategory calls products.v1.CategoryService.GetCategory.
+func (c *categoryServiceClient) GetCategory(ctx context.Context, req *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error) {
+	return c.getCategory.CallUnary(ctx, req)
+}
+
+// UpdateCategory calls products.v1.CategoryService.UpdateCategory.
+func (c *categoryServiceClient) UpdateCategory(ctx context.Context, req *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
+	return c.updateCategory.CallUnary(ctx, req)
+}
+
+// DeleteCategory calls products.v1.CategoryService.DeleteCategory.
+func (c *categoryServiceClient) DeleteCategory(ctx context.Context, req *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error) {
+	return c.deleteCategory.CallUnary(ctx, req)
+}
+
+// ListCategories calls products.v1.CategoryService.ListCategories.
+func (c *categoryServiceClient) ListCategories(ctx context.Context, req *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return c.listCategories.CallUnary(ctx, req)
+}
+
+// CategoryServiceHandler is an implementation of the products.v1.CategoryService service.
+type CategoryServiceHandler interface {
+	CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error)
+	GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error)
+	UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error)
+	// Only empty categories, without subcategories or products, can be deleted.
+	DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error)
+	// Lists the subcategories of a category, or the root categories.
+	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
+}
+
+// NewCategoryServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCategoryServiceHandler(svc CategoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	categoryServiceMethods := v1.File_products_v1_products_proto.Services().ByName("CategoryService").Methods()
+	categoryServiceCreateCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceCreateCategoryProcedure,
+		svc.CreateCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("CreateCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceGetCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceGetCategoryProcedure,
+		svc.GetCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("GetCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceUpdateCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceUpdateCategoryProcedure,
+		svc.UpdateCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("UpdateCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceDeleteCategoryHandler := connect.NewUnaryHandler(
+		CategoryServiceDeleteCategoryProcedure,
+		svc.DeleteCategory,
+		connect.WithSchema(categoryServiceMethods.ByName("DeleteCategory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	categoryServiceListCategoriesHandler := connect.NewUnaryHandler(
+		CategoryServiceListCategoriesProcedure,
+		svc.ListCategories,
+		connect.WithSchema(categoryServiceMethods.ByName("ListCategories")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/products.v1.CategoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CategoryServiceCreateCategoryProcedure:
+			categoryServiceCreateCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceGetCategoryProcedure:
+			categoryServiceGetCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceUpdateCategoryProcedure:
+			categoryServiceUpdateCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceDeleteCategoryProcedure:
+			categoryServiceDeleteCategoryHandler.ServeHTTP(w, r)
+		case CategoryServiceListCategoriesProcedure:
+			categoryServiceListCategoriesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCategoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCategoryServiceHandler struct{}
+
+func (UnimplementedCategoryServiceHandler) CreateCategory(context.Context, *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.CategoryService.CreateCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) GetCategory(context.Context, *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.CategoryService.GetCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) UpdateCategory(context.Context, *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.CategoryService.UpdateCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) DeleteCategory(context.Context, *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.CategoryService.DeleteCategory is not implemented"))
+}
+
+func (UnimplementedCategoryServiceHandler) ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("products.v1.CategoryService.ListCategories is not implemented"))
+}
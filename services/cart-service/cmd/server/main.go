@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/joho/godotenv"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/carts/v1/cartsv1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/cart-service/controller"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/cart-service/repository"
+	customers "github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/repository"
+	orders "github.com/yaninyzwitty/temporal-microservice-go/services/order-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
+	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ratelimit"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/tracing"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/workflow"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func main() {
+	cfg := pkg.Config{}
+
+	if err := cfg.LoadConfig("config.yaml"); err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger.New("cart-service", cfg.Logging))
+	if err := snowflake.InitSonyFlake(); err != nil {
+		slog.Error("failed to initialize snowflake", "error", err)
+		os.Exit(1)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		slog.Error("failed to load .env file", "error", err)
+		os.Exit(1)
+	}
+
+	shutdownTracer, err := tracing.InitTracer("cart-service", cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			slog.Error("failed to shutdown tracing", "error", err)
+		}
+	}()
+
+	astraCfg := &database.AstraConfig{
+		Username: cfg.Database.Username,
+		Path:     cfg.Database.Path,
+		Token:    helpers.GetEnvOrDefault("ASTRA_TOKEN", ""),
+	}
+
+	db := database.NewAstraDB()
+	session, err := db.Connect(context.Background(), astraCfg, 30*time.Second)
+	if err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer session.Close()
+
+	temporalInterceptor, err := tracing.TemporalInterceptor()
+	if err != nil {
+		slog.Error("failed to create temporal tracing interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	temporalClient, err := client.Dial(client.Options{
+		Logger:             logger.NewTemporalLogger(slog.Default()),
+		Interceptors:       []interceptor.ClientInterceptor{temporalInterceptor},
+		ContextPropagators: []workflow.ContextPropagator{logger.NewCorrelationPropagator()},
+	})
+	if err != nil {
+		slog.Error("Unable to create client", "error", err)
+		os.Exit(1)
+	}
+
+	defer temporalClient.Close()
+
+	cartServiceAddr := fmt.Sprintf("localhost:%d", cfg.CartServer.Port)
+	// checkout starts the order workflow the same way the order service does
+	converter := money.NewConverter(session, "products_keyspace")
+	cartRepository := repository.NewCartRepository(session, orders.NewOrderRepository(temporalClient), converter, cfg.Payments.Currency, cfg.CartServer.TTL)
+	cartController := controller.NewCartController(cartRepository, customers.NewAddressRepository(session))
+
+	mux := http.NewServeMux()
+
+	otelInterceptor, err := tracing.ConnectInterceptor()
+	if err != nil {
+		slog.Error("failed to create otel interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	authInterceptor, err := auth.NewInterceptor(cfg.Auth)
+	if err != nil {
+		slog.Error("failed to create auth interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// rate limits are hot-reloaded when config.yaml changes
+	rateLimiter := ratelimit.New("cart-service", cfg.RateLimit)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go pkg.WatchConfig(watchCtx, "config.yaml", 10*time.Second, func(c *pkg.Config) {
+		rateLimiter.Update(c.RateLimit)
+	})
+
+	cartPath, cartHandler := cartsv1connect.NewCartServiceHandler(cartController, connect.WithInterceptors(otelInterceptor, logger.NewInterceptor(), authInterceptor, rateLimiter))
+	mux.Handle(cartPath, cartHandler)
+
+	server := &http.Server{
+		Addr:    cartServiceAddr,
+		Handler: h2c.NewHandler(mux, &http2.Server{}),
+	}
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sig := <-quit
+		slog.Info("received shutdown signal", "signal", sig)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(ctx); err != nil {
+			slog.Error("server forced to shutdown", "error", err)
+		} else {
+			slog.Info("server shutdown gracefully")
+		}
+	}()
+
+	// start http server
+	slog.Info("starting HTTP server", "address", cartServiceAddr, "pid", os.Getpid())
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		slog.Error("server failed", "error", err)
+		os.Exit(1)
+	}
+
+	wg.Wait()
+	slog.Info("service shutdown complete")
+}
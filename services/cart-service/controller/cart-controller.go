@@ -0,0 +1,160 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/carts/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/carts/v1/cartsv1connect"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/cart-service/repository"
+	customers "github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/repository"
+	orders "github.com/yaninyzwitty/temporal-microservice-go/services/order-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CartController struct {
+	cartsv1connect.UnimplementedCartServiceHandler
+	cartRepository *repository.CartRepository
+	addresses      *customers.AddressRepository
+}
+
+func NewCartController(cartRepository *repository.CartRepository, addresses *customers.AddressRepository) *CartController {
+	return &CartController{
+		cartRepository: cartRepository,
+		addresses:      addresses,
+	}
+}
+
+func (c *CartController) AddItem(ctx context.Context, req *connect.Request[v1.AddItemRequest]) (*connect.Response[v1.AddItemResponse], error) {
+	if req.Msg.CustomerId <= 0 || req.Msg.ProductId <= 0 || req.Msg.Quantity <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id, product_id and a positive quantity are required"))
+	}
+	if req.Msg.Currency != "" {
+		if err := money.Validate(req.Msg.Currency); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	cart, err := c.cartRepository.AddItem(ctx, req.Msg.CustomerId, req.Msg.ProductId, req.Msg.Quantity, req.Msg.Currency)
+	if err != nil {
+		return nil, cartError(err)
+	}
+
+	return connect.NewResponse(&v1.AddItemResponse{
+		Cart: cart,
+	}), nil
+}
+
+func (c *CartController) RemoveItem(ctx context.Context, req *connect.Request[v1.RemoveItemRequest]) (*connect.Response[v1.RemoveItemResponse], error) {
+	if req.Msg.CustomerId <= 0 || req.Msg.ProductId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id and product_id are required"))
+	}
+
+	cart, err := c.cartRepository.RemoveItem(ctx, req.Msg.CustomerId, req.Msg.ProductId)
+	if err != nil {
+		return nil, cartError(err)
+	}
+
+	return connect.NewResponse(&v1.RemoveItemResponse{
+		Cart: cart,
+	}), nil
+}
+
+func (c *CartController) UpdateQuantity(ctx context.Context, req *connect.Request[v1.UpdateQuantityRequest]) (*connect.Response[v1.UpdateQuantityResponse], error) {
+	if req.Msg.CustomerId <= 0 || req.Msg.ProductId <= 0 || req.Msg.Quantity < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id, product_id and a quantity of at least 0 are required"))
+	}
+
+	cart, err := c.cartRepository.UpdateQuantity(ctx, req.Msg.CustomerId, req.Msg.ProductId, req.Msg.Quantity)
+	if err != nil {
+		return nil, cartError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateQuantityResponse{
+		Cart: cart,
+	}), nil
+}
+
+func (c *CartController) GetCart(ctx context.Context, req *connect.Request[v1.GetCartRequest]) (*connect.Response[v1.GetCartResponse], error) {
+	if req.Msg.CustomerId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required"))
+	}
+
+	cart, err := c.cartRepository.GetCart(ctx, req.Msg.CustomerId)
+	if err != nil {
+		return nil, cartError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCartResponse{
+		Cart: cart,
+	}), nil
+}
+
+func (c *CartController) Checkout(ctx context.Context, req *connect.Request[v1.CheckoutRequest]) (*connect.Response[v1.CheckoutResponse], error) {
+	if req.Msg.CustomerId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required"))
+	}
+	if wait := req.Msg.MaxBackorderWait; wait != nil && (wait.AsDuration() < 0 || wait.AsDuration() > workflows.MaxBackorderWait) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_backorder_wait must be between 0 and %s", workflows.MaxBackorderWait))
+	}
+	if (req.Msg.ShippingAddressId != 0 && req.Msg.ShippingAddress != nil) || (req.Msg.BillingAddressId != 0 && req.Msg.BillingAddress != nil) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("give either an address or its address book id, not both"))
+	}
+	if err := tax.ValidateAddresses(req.Msg.ShippingAddress, req.Msg.BillingAddress); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	shipping, billing, err := c.addresses.OrderAddresses(ctx, req.Msg.CustomerId, req.Msg.ShippingAddressId, req.Msg.BillingAddressId, req.Msg.ShippingAddress, req.Msg.BillingAddress)
+	if err != nil {
+		return nil, cartError(err)
+	}
+
+	orderId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	order, err := c.cartRepository.Checkout(ctx, &ordersv1.Order{
+		OrderId:          int64(orderId),
+		CustomerId:       req.Msg.CustomerId,
+		ShipTo:           req.Msg.ShipTo,
+		MaxBackorderWait: req.Msg.MaxBackorderWait,
+		CouponCodes:      req.Msg.CouponCodes,
+		ShippingAddress:  shipping,
+		BillingAddress:   billing,
+		Status:           ordersv1.OrderStatus_ORDER_STATUS_CREATED,
+		CreatedAt:        timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		return nil, cartError(err)
+	}
+	logger.FromContext(ctx).Info("cart checked out", "customer_id", req.Msg.CustomerId, "order_id", order.OrderId)
+
+	return connect.NewResponse(&v1.CheckoutResponse{
+		Order: order,
+	}), nil
+}
+
+// cartError maps repository errors to Connect codes.
+func cartError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrItemNotFound), errors.Is(err, repository.ErrProductNotFound), errors.Is(err, customers.ErrAddressNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrCartEmpty), errors.Is(err, repository.ErrPricesChanged), errors.Is(err, repository.ErrInsufficientStock),
+		errors.Is(err, orders.ErrCouponRejected), errors.Is(err, orders.ErrPricingFailed), errors.Is(err, money.ErrRateNotFound):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, orders.ErrInvalidItems):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
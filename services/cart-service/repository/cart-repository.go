@@ -0,0 +1,380 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	cartsv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/carts/v1"
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	orders "github.com/yaninyzwitty/temporal-microservice-go/services/order-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/inf.v0"
+)
+
+var (
+	// ErrCartEmpty is returned when checking out a cart without items.
+	ErrCartEmpty = errors.New("cart is empty")
+	// ErrItemNotFound is returned when the product is not in the cart.
+	ErrItemNotFound = errors.New("item not in cart")
+	// ErrProductNotFound is returned when the product does not exist.
+	ErrProductNotFound = errors.New("product not found")
+	// ErrPricesChanged is returned by Checkout when items were repriced; the cart holds the new prices.
+	ErrPricesChanged = errors.New("prices changed since the items were added")
+	// ErrInsufficientStock is returned by Checkout when an item is out of stock and cannot be backordered.
+	ErrInsufficientStock = errors.New("insufficient stock")
+)
+
+type CartRepository struct {
+	session   *gocql.Session
+	orders    *orders.OrderRepository
+	converter *money.Converter
+	currency  string
+	ttl       time.Duration
+}
+
+// NewCartRepository returns a repository that drops carts ttl after their last
+// change and places checked out carts through orders. New carts are priced in
+// currency until the customer picks another one.
+func NewCartRepository(session *gocql.Session, orders *orders.OrderRepository, converter *money.Converter, currency string, ttl time.Duration) *CartRepository {
+	return &CartRepository{
+		session:   session,
+		orders:    orders,
+		converter: converter,
+		currency:  currency,
+		ttl:       ttl,
+	}
+}
+
+// product is what a cart needs to know about a product right now.
+type product struct {
+	priceMinor     int64
+	available      int32
+	allowBackorder bool
+}
+
+// product reads the product with its price in the currency of pricer.
+func (r *CartRepository) product(ctx context.Context, pricer *money.Pricer, id int64) (*product, error) {
+	var p product
+	var (
+		stock        int32
+		legacyPrice  float64
+		basePrice    *inf.Dec
+		baseCurrency string
+		priceList    map[string]*inf.Dec
+		deletedAt    time.Time
+	)
+	query := `
+		SELECT price, base_price, currency, price_list, stock, allow_backorder, deleted_at FROM products_keyspace.products WHERE id = ?
+	`
+	if err := r.session.Query(query, id).WithContext(ctx).Scan(&legacyPrice, &basePrice, &baseCurrency, &priceList, &stock, &p.allowBackorder, &deletedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrProductNotFound, id)
+		}
+		return nil, err
+	}
+	// deleted products can no longer be added or checked out
+	if !deletedAt.IsZero() {
+		return nil, fmt.Errorf("%w: %d", ErrProductNotFound, id)
+	}
+
+	if basePrice == nil {
+		// products stored before prices were decimals
+		legacyMinor, err := money.FromFloat(legacyPrice, baseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		if basePrice, err = money.ToDecimal(legacyMinor, baseCurrency); err != nil {
+			return nil, err
+		}
+	}
+	priceMinor, err := pricer.Price(ctx, basePrice, baseCurrency, priceList)
+	if err != nil {
+		return nil, err
+	}
+	p.priceMinor = priceMinor
+
+	var reserved int32
+	reservedQuery := `
+		SELECT SUM(quantity) FROM products_keyspace.stock_reservations WHERE product_id = ?
+	`
+	if err := r.session.Query(reservedQuery, id).WithContext(ctx).Scan(&reserved); err != nil {
+		return nil, err
+	}
+	p.available = stock - reserved
+
+	return &p, nil
+}
+
+func (r *CartRepository) GetCart(ctx context.Context, customerId int64) (*cartsv1.Cart, error) {
+	query := `
+		SELECT product_id, quantity, price, unit_price, currency, added_at, updated_at, TTL(quantity)
+		FROM products_keyspace.cart_items
+		WHERE customer_id = ?
+	`
+	scanner := r.session.Query(query, customerId).WithContext(ctx).Iter().Scanner()
+
+	cart := &cartsv1.Cart{CustomerId: customerId, Currency: r.currency}
+	var lastUpdate, expireAt time.Time
+	for scanner.Next() {
+		var (
+			productId          int64
+			quantity           int32
+			legacyPrice        float64
+			unitPrice          *inf.Dec
+			currency           string
+			addedAt, updatedAt time.Time
+			ttlSeconds         int
+		)
+		if err := scanner.Scan(&productId, &quantity, &legacyPrice, &unitPrice, &currency, &addedAt, &updatedAt, &ttlSeconds); err != nil {
+			return nil, err
+		}
+		// every change rewrites all items, so they share one currency, update time and TTL
+		if currency != "" {
+			cart.Currency = currency
+		}
+
+		var priceMinor int64
+		var err error
+		if unitPrice != nil {
+			priceMinor, err = money.FromDecimal(unitPrice, cart.Currency)
+		} else {
+			// items added before prices were decimals
+			priceMinor, err = money.FromFloat(legacyPrice, cart.Currency)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cart.Items = append(cart.Items, &cartsv1.CartItem{
+			ProductId: productId,
+			Quantity:  quantity,
+			UnitPrice: &moneyv1.Money{Currency: cart.Currency, AmountMinor: priceMinor},
+			AddedAt:   timestamppb.New(addedAt),
+		})
+		lastUpdate = updatedAt
+		expireAt = time.Now().Add(time.Duration(ttlSeconds) * time.Second)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(cart.Items) > 0 {
+		cart.UpdatedAt = timestamppb.New(lastUpdate)
+		cart.ExpiresAt = timestamppb.New(expireAt)
+	}
+	return withTotal(cart), nil
+}
+
+// save writes every item of the cart, which restarts the TTL of the whole cart.
+func (r *CartRepository) save(ctx context.Context, cart *cartsv1.Cart) error {
+	now := time.Now()
+	batch := r.session.NewBatch(gocql.UnloggedBatch).WithContext(ctx)
+	for _, item := range cart.Items {
+		unitPrice, err := money.ToDecimal(item.UnitPrice.AmountMinor, cart.Currency)
+		if err != nil {
+			return err
+		}
+		batch.Query(`
+			INSERT INTO products_keyspace.cart_items (customer_id, product_id, quantity, unit_price, currency, added_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?) USING TTL ?
+		`, cart.CustomerId, item.ProductId, item.Quantity, unitPrice, cart.Currency, item.AddedAt.AsTime(), now, int(r.ttl.Seconds()))
+	}
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return err
+	}
+
+	cart.UpdatedAt = timestamppb.New(now)
+	cart.ExpiresAt = timestamppb.New(now.Add(r.ttl))
+	return nil
+}
+
+func (r *CartRepository) removeItem(ctx context.Context, customerId, productId int64) error {
+	query := `
+		DELETE FROM products_keyspace.cart_items WHERE customer_id = ? AND product_id = ?
+	`
+	return r.session.Query(query, customerId, productId).WithContext(ctx).Exec()
+}
+
+// AddItem adds quantity of the product to the cart. A currency other than the
+// cart's reprices every item in it; an empty one keeps the cart's currency.
+func (r *CartRepository) AddItem(ctx context.Context, customerId, productId int64, quantity int32, currency string) (*cartsv1.Cart, error) {
+	cart, err := r.GetCart(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	pricer := r.converter.Pricer(cart.Currency)
+	if currency != "" && currency != cart.Currency {
+		cart.Currency = currency
+		pricer = r.converter.Pricer(currency)
+		for _, item := range cart.Items {
+			p, err := r.product(ctx, pricer, item.ProductId)
+			if err != nil {
+				return nil, err
+			}
+			item.UnitPrice = &moneyv1.Money{Currency: currency, AmountMinor: p.priceMinor}
+		}
+	}
+
+	p, err := r.product(ctx, pricer, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	if item := findItem(cart, productId); item != nil {
+		item.Quantity += quantity
+	} else {
+		cart.Items = append(cart.Items, &cartsv1.CartItem{
+			ProductId: productId,
+			Quantity:  quantity,
+			UnitPrice: &moneyv1.Money{Currency: cart.Currency, AmountMinor: p.priceMinor},
+			AddedAt:   timestamppb.New(time.Now()),
+		})
+	}
+
+	if err := r.save(ctx, cart); err != nil {
+		return nil, err
+	}
+	return withTotal(cart), nil
+}
+
+func (r *CartRepository) UpdateQuantity(ctx context.Context, customerId, productId int64, quantity int32) (*cartsv1.Cart, error) {
+	if quantity == 0 {
+		return r.RemoveItem(ctx, customerId, productId)
+	}
+
+	cart, err := r.GetCart(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	item := findItem(cart, productId)
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
+	item.Quantity = quantity
+
+	if err := r.save(ctx, cart); err != nil {
+		return nil, err
+	}
+	return withTotal(cart), nil
+}
+
+func (r *CartRepository) RemoveItem(ctx context.Context, customerId, productId int64) (*cartsv1.Cart, error) {
+	cart, err := r.GetCart(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if findItem(cart, productId) == nil {
+		return nil, ErrItemNotFound
+	}
+	if err := r.removeItem(ctx, customerId, productId); err != nil {
+		return nil, err
+	}
+
+	remaining := cart.Items[:0]
+	for _, item := range cart.Items {
+		if item.ProductId != productId {
+			remaining = append(remaining, item)
+		}
+	}
+	cart.Items = remaining
+
+	if len(cart.Items) == 0 {
+		cart.UpdatedAt, cart.ExpiresAt = nil, nil
+		return withTotal(cart), nil
+	}
+	if err := r.save(ctx, cart); err != nil {
+		return nil, err
+	}
+	return withTotal(cart), nil
+}
+
+// Checkout places the cart as an order once every item still exists, has the
+// price it was added at and is in stock or can be backordered. Items whose price
+// changed are repriced in the cart and ErrPricesChanged is returned, so the
+// customer checks out again at the new prices. The cart is emptied once the order is placed.
+func (r *CartRepository) Checkout(ctx context.Context, order *ordersv1.Order) (*ordersv1.Order, error) {
+	cart, err := r.GetCart(ctx, order.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, ErrCartEmpty
+	}
+
+	repriced := false
+	pricer := r.converter.Pricer(cart.Currency)
+	for _, item := range cart.Items {
+		p, err := r.product(ctx, pricer, item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		if p.available < item.Quantity && !p.allowBackorder {
+			return nil, fmt.Errorf("%w: product %d has %d available", ErrInsufficientStock, item.ProductId, max(p.available, 0))
+		}
+		if p.priceMinor != item.UnitPrice.AmountMinor {
+			item.UnitPrice.AmountMinor = p.priceMinor
+			repriced = true
+		}
+
+		order.Items = append(order.Items, &ordersv1.OrderItem{
+			ProductId:      item.ProductId,
+			Quantity:       item.Quantity,
+			UnitPriceMinor: item.UnitPrice.AmountMinor,
+			Price:          money.ToFloat(item.UnitPrice.AmountMinor, cart.Currency),
+		})
+	}
+	// the order workflow prices the items again in this currency
+	order.Currency = cart.Currency
+
+	if repriced {
+		if err := r.save(ctx, cart); err != nil {
+			return nil, err
+		}
+		return nil, ErrPricesChanged
+	}
+
+	placed, err := r.orders.CreateOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	// the order is placed either way; a cart left behind is only an annoyance
+	clearQuery := `
+		DELETE FROM products_keyspace.cart_items WHERE customer_id = ?
+	`
+	if err := r.session.Query(clearQuery, order.CustomerId).WithContext(ctx).Exec(); err != nil {
+		logger.FromContext(ctx).Error("failed to empty cart after checkout", "error", err, "customer_id", order.CustomerId)
+	}
+
+	return placed, nil
+}
+
+func findItem(cart *cartsv1.Cart, productId int64) *cartsv1.CartItem {
+	for _, item := range cart.Items {
+		if item.ProductId == productId {
+			return item
+		}
+	}
+	return nil
+}
+
+// withTotal sums the cart and fills the floating point prices kept for older clients.
+func withTotal(cart *cartsv1.Cart) *cartsv1.Cart {
+	var total int64
+	for _, item := range cart.Items {
+		item.Price = money.ToFloat(item.UnitPrice.AmountMinor, cart.Currency)
+		total += item.UnitPrice.AmountMinor * int64(item.Quantity)
+	}
+	cart.TotalAmount = &moneyv1.Money{Currency: cart.Currency, AmountMinor: total}
+	cart.Total = money.ToFloat(total, cart.Currency)
+	return cart
+}
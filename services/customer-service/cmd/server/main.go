@@ -11,14 +11,22 @@ import (
 	"syscall"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/joho/godotenv"
 	"github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1/customersv1connect"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/controller"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/repository"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
 	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ratelimit"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/tracing"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/workflow"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -29,6 +37,7 @@ func main() {
 		slog.Error("failed to load config", "error", err)
 		os.Exit(1)
 	}
+	slog.SetDefault(logger.New("customer-service", config.Logging))
 
 	if err := snowflake.InitSonyFlake(); err != nil {
 		slog.Error("failed to initialize snowflake", "error", err)
@@ -40,6 +49,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	shutdownTracer, err := tracing.InitTracer("customer-service", config.Tracing)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			slog.Error("failed to shutdown tracing", "error", err)
+		}
+	}()
+
 	astraCfg := &database.AstraConfig{
 		Username: config.Database.Username,
 		Path:     config.Database.Path,
@@ -54,13 +74,53 @@ func main() {
 	}
 	defer session.Close()
 
+	temporalInterceptor, err := tracing.TemporalInterceptor()
+	if err != nil {
+		slog.Error("failed to create temporal tracing interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	temporalClient, err := client.Dial(client.Options{
+		Logger:             logger.NewTemporalLogger(slog.Default()),
+		Interceptors:       []interceptor.ClientInterceptor{temporalInterceptor},
+		ContextPropagators: []workflow.ContextPropagator{logger.NewCorrelationPropagator()},
+	})
+	if err != nil {
+		slog.Error("Unable to create client", "error", err)
+		os.Exit(1)
+	}
+
+	defer temporalClient.Close()
+
 	customerRepository := repository.NewCustomerRepository(session)
+	// deleted customers are erased by a workflow on the order worker
+	erasureRepository := repository.NewErasureRepository(temporalClient, session, config.CustomerServer.ErasureGracePeriod)
 
 	customerServiceAddr := fmt.Sprintf("localhost:%d", config.CustomerServer.Port)
 
+	otelInterceptor, err := tracing.ConnectInterceptor()
+	if err != nil {
+		slog.Error("failed to create otel interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	authInterceptor, err := auth.NewInterceptor(config.Auth)
+	if err != nil {
+		slog.Error("failed to create auth interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// rate limits are hot-reloaded when config.yaml changes
+	rateLimiter := ratelimit.New("customer-service", config.RateLimit)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go pkg.WatchConfig(watchCtx, "config.yaml", 10*time.Second, func(c *pkg.Config) {
+		rateLimiter.Update(c.RateLimit)
+	})
+
 	// Initialize controller and mux
-	customerController := controller.NewCustomerController(customerRepository)
-	customersPath, customersHandler := customersv1connect.NewCustomersServiceHandler(customerController)
+	customerController := controller.NewCustomerController(customerRepository, repository.NewAddressRepository(session), erasureRepository, repository.NewExportRepository(temporalClient, session))
+	customersPath, customersHandler := customersv1connect.NewCustomersServiceHandler(customerController, connect.WithInterceptors(otelInterceptor, logger.NewInterceptor(), authInterceptor, rateLimiter))
 
 	mux := http.NewServeMux()
 	mux.Handle(customersPath, customersHandler)
@@ -9,6 +9,7 @@ import (
 	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
 	"github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1/customersv1connect"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -16,11 +17,17 @@ import (
 type CustomerController struct {
 	customersv1connect.UnimplementedCustomersServiceHandler
 	customerRepository *repository.CustomerRepository
+	addressRepository  *repository.AddressRepository
+	erasureRepository  *repository.ErasureRepository
+	exportRepository   *repository.ExportRepository
 }
 
-func NewCustomerController(customerRepository *repository.CustomerRepository) *CustomerController {
+func NewCustomerController(customerRepository *repository.CustomerRepository, addressRepository *repository.AddressRepository, erasureRepository *repository.ErasureRepository, exportRepository *repository.ExportRepository) *CustomerController {
 	return &CustomerController{
 		customerRepository: customerRepository,
+		addressRepository:  addressRepository,
+		erasureRepository:  erasureRepository,
+		exportRepository:   exportRepository,
 	}
 }
 
@@ -43,9 +50,10 @@ func (c *CustomerController) CreateCustomer(ctx context.Context, req *connect.Re
 		CreatedAt: timestamppb.New(time.Now()),
 	}
 
-	if err := c.customerRepository.CreateCustomer(customer); err != nil {
+	if err := c.customerRepository.CreateCustomer(ctx, customer); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	logger.FromContext(ctx).Info("customer created", "customer_id", customer.Id, "email", customer.Email)
 
 	return &connect.Response[v1.CreateCustomerResponse]{
 		Msg: &v1.CreateCustomerResponse{
@@ -59,8 +67,11 @@ func (c *CustomerController) GetCustomer(ctx context.Context, req *connect.Reque
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
 	}
 
-	customer, err := c.customerRepository.GetCustomer(req.Msg.Id)
+	customer, err := c.customerRepository.GetCustomer(ctx, req.Msg.Id, req.Msg.IncludeDeleted)
 	if err != nil {
+		if errors.Is(err, repository.ErrCustomerNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -76,12 +87,244 @@ func (c *CustomerController) DeleteCustomer(ctx context.Context, req *connect.Re
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
 	}
 
-	if err := c.customerRepository.DeleteCustomer(req.Msg.Id); err != nil {
+	if _, err := c.customerRepository.GetCustomer(ctx, req.Msg.Id, false); err != nil {
+		if errors.Is(err, repository.ErrCustomerNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+
+	// the customer is hidden straight away and their data erased once the grace
+	// period is over, unless they are restored first
+	erasure, err := c.erasureRepository.RequestErasure(ctx, req.Msg.Id, req.Msg.Reason)
+	if err != nil {
+		return nil, erasureError(err)
+	}
+	logger.FromContext(ctx).Info("customer deleted", "customer_id", req.Msg.Id, "erase_after", erasure.EraseAfter.AsTime())
 	return &connect.Response[v1.DeleteCustomerResponse]{
 		Msg: &v1.DeleteCustomerResponse{
 			Success: true,
+			Erasure: erasure,
 		},
 	}, nil
 }
+
+func (c *CustomerController) CancelCustomerDeletion(ctx context.Context, req *connect.Request[v1.CancelCustomerDeletionRequest]) (*connect.Response[v1.CancelCustomerDeletionResponse], error) {
+	if req.Msg.Id <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	erasure, err := c.erasureRepository.CancelErasure(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, erasureError(err)
+	}
+	logger.FromContext(ctx).Info("customer deletion cancelled", "customer_id", req.Msg.Id)
+
+	return connect.NewResponse(&v1.CancelCustomerDeletionResponse{
+		Erasure: erasure,
+	}), nil
+}
+
+func (c *CustomerController) RestoreCustomer(ctx context.Context, req *connect.Request[v1.RestoreCustomerRequest]) (*connect.Response[v1.RestoreCustomerResponse], error) {
+	// restoring is cancelling the deletion, answered with the customer
+	cancelled, err := c.CancelCustomerDeletion(ctx, connect.NewRequest(&v1.CancelCustomerDeletionRequest{Id: req.Msg.Id}))
+	if err != nil {
+		return nil, err
+	}
+
+	customer, err := c.customerRepository.GetCustomer(ctx, req.Msg.Id, true)
+	if err != nil {
+		if errors.Is(err, repository.ErrCustomerNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	// the workflow clears deleted_at asynchronously
+	customer.DeletedAt = nil
+	logger.FromContext(ctx).Info("customer restored", "customer_id", req.Msg.Id)
+
+	return connect.NewResponse(&v1.RestoreCustomerResponse{
+		Customer: customer,
+		Erasure:  cancelled.Msg.Erasure,
+	}), nil
+}
+
+func (c *CustomerController) GetCustomerErasure(ctx context.Context, req *connect.Request[v1.GetCustomerErasureRequest]) (*connect.Response[v1.GetCustomerErasureResponse], error) {
+	if req.Msg.Id <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	erasure, err := c.erasureRepository.GetErasure(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, erasureError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCustomerErasureResponse{
+		Erasure: erasure,
+	}), nil
+}
+
+// erasureError maps erasure errors to Connect codes.
+func erasureError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrErasureNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrErasureExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrErasureRejected), errors.Is(err, repository.ErrErasureNotPending):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (c *CustomerController) ExportCustomerData(ctx context.Context, req *connect.Request[v1.ExportCustomerDataRequest]) (*connect.Response[v1.ExportCustomerDataResponse], error) {
+	if req.Msg.CustomerId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required"))
+	}
+
+	// deleted customers may still export their data until it is erased
+	if _, err := c.customerRepository.GetCustomer(ctx, req.Msg.CustomerId, true); err != nil {
+		if errors.Is(err, repository.ErrCustomerNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	exportId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	export, err := c.exportRepository.RequestExport(ctx, req.Msg.CustomerId, int64(exportId), req.Msg.IncludeCsv)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	logger.FromContext(ctx).Info("customer data export requested", "customer_id", export.CustomerId, "export_id", export.ExportId, "requested_by", export.RequestedBy)
+
+	return connect.NewResponse(&v1.ExportCustomerDataResponse{
+		Export: export,
+	}), nil
+}
+
+func (c *CustomerController) GetCustomerDataExport(ctx context.Context, req *connect.Request[v1.GetCustomerDataExportRequest]) (*connect.Response[v1.GetCustomerDataExportResponse], error) {
+	if req.Msg.CustomerId <= 0 || req.Msg.ExportId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id and export_id are required"))
+	}
+
+	export, err := c.exportRepository.GetExport(ctx, req.Msg.CustomerId, req.Msg.ExportId)
+	if err != nil {
+		if errors.Is(err, repository.ErrExportNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.GetCustomerDataExportResponse{
+		Export: export,
+	}), nil
+}
+
+func (c *CustomerController) AddAddress(ctx context.Context, req *connect.Request[v1.AddAddressRequest]) (*connect.Response[v1.AddAddressResponse], error) {
+	address := req.Msg.Address
+	if address == nil || address.CustomerId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address with a customer_id is required"))
+	}
+	if err := repository.ValidateAddress(address); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	addressId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	address.Id = int64(addressId)
+
+	address, err = c.addressRepository.AddAddress(ctx, address, req.Msg.MakeDefault)
+	if err != nil {
+		return nil, addressError(err)
+	}
+	logger.FromContext(ctx).Info("address added", "customer_id", address.CustomerId, "address_id", address.Id, "default", address.IsDefault)
+
+	return connect.NewResponse(&v1.AddAddressResponse{
+		Address: address,
+	}), nil
+}
+
+func (c *CustomerController) UpdateAddress(ctx context.Context, req *connect.Request[v1.UpdateAddressRequest]) (*connect.Response[v1.UpdateAddressResponse], error) {
+	address := req.Msg.Address
+	if address == nil || address.CustomerId <= 0 || address.Id <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address with a customer_id and id is required"))
+	}
+	if err := repository.ValidateAddress(address); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	address, err := c.addressRepository.UpdateAddress(ctx, address)
+	if err != nil {
+		return nil, addressError(err)
+	}
+	logger.FromContext(ctx).Info("address updated", "customer_id", address.CustomerId, "address_id", address.Id)
+
+	return connect.NewResponse(&v1.UpdateAddressResponse{
+		Address: address,
+	}), nil
+}
+
+func (c *CustomerController) DeleteAddress(ctx context.Context, req *connect.Request[v1.DeleteAddressRequest]) (*connect.Response[v1.DeleteAddressResponse], error) {
+	if req.Msg.CustomerId <= 0 || req.Msg.AddressId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id and address_id are required"))
+	}
+
+	if err := c.addressRepository.DeleteAddress(ctx, req.Msg.CustomerId, req.Msg.AddressId); err != nil {
+		return nil, addressError(err)
+	}
+	logger.FromContext(ctx).Info("address deleted", "customer_id", req.Msg.CustomerId, "address_id", req.Msg.AddressId)
+
+	return connect.NewResponse(&v1.DeleteAddressResponse{
+		Success: true,
+	}), nil
+}
+
+func (c *CustomerController) ListAddresses(ctx context.Context, req *connect.Request[v1.ListAddressesRequest]) (*connect.Response[v1.ListAddressesResponse], error) {
+	if req.Msg.CustomerId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required"))
+	}
+
+	addresses, err := c.addressRepository.ListAddresses(ctx, req.Msg.CustomerId)
+	if err != nil {
+		return nil, addressError(err)
+	}
+
+	return connect.NewResponse(&v1.ListAddressesResponse{
+		Addresses: addresses,
+	}), nil
+}
+
+func (c *CustomerController) SetDefaultAddress(ctx context.Context, req *connect.Request[v1.SetDefaultAddressRequest]) (*connect.Response[v1.SetDefaultAddressResponse], error) {
+	if req.Msg.CustomerId <= 0 || req.Msg.AddressId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id and address_id are required"))
+	}
+
+	address, err := c.addressRepository.SetDefaultAddress(ctx, req.Msg.CustomerId, req.Msg.AddressId)
+	if err != nil {
+		return nil, addressError(err)
+	}
+	logger.FromContext(ctx).Info("default address set", "customer_id", address.CustomerId, "address_id", address.Id)
+
+	return connect.NewResponse(&v1.SetDefaultAddressResponse{
+		Address: address,
+	}), nil
+}
+
+// addressError maps address book errors to Connect codes.
+func addressError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAddressNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrAddressBookFull):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
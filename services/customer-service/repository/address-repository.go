@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/address"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxAddresses is how many addresses a customer can keep.
+const MaxAddresses = 20
+
+var (
+	// ErrAddressNotFound is returned when the customer has no address with the id.
+	ErrAddressNotFound = errors.New("address not found")
+	// ErrAddressBookFull is returned when adding an address to a customer who has MaxAddresses.
+	ErrAddressBookFull = fmt.Errorf("a customer can keep at most %d addresses", MaxAddresses)
+)
+
+type AddressRepository struct {
+	session *gocql.Session
+}
+
+func NewAddressRepository(session *gocql.Session) *AddressRepository {
+	return &AddressRepository{
+		session: session,
+	}
+}
+
+// ValidateAddress checks the address against the rules of its country.
+func ValidateAddress(a *customersv1.Address) error {
+	return address.Validate(postalAddress(a))
+}
+
+// normalize trims the address and upper-cases its codes before it is stored.
+func normalize(a *customersv1.Address) {
+	n := address.Normalize(postalAddress(a))
+	a.Line1, a.City, a.Region, a.PostalCode, a.Country = n.Line1, n.City, n.Region, n.PostalCode, n.Country
+}
+
+func postalAddress(a *customersv1.Address) address.Address {
+	return address.Address{
+		Line1:      a.Line1,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+const addressColumns = `default_address_id, address_id, label, recipient, line1, line2, city, region, postal_code, country, phone, created_at, updated_at`
+
+func scanAddress(scan func(...interface{}) error, customerId int64) (*customersv1.Address, error) {
+	a := &customersv1.Address{CustomerId: customerId}
+	var defaultId int64
+	var createdAt, updatedAt time.Time
+	if err := scan(&defaultId, &a.Id, &a.Label, &a.Recipient, &a.Line1, &a.Line2, &a.City, &a.Region, &a.PostalCode, &a.Country, &a.Phone, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	a.IsDefault = a.Id != 0 && a.Id == defaultId
+	a.CreatedAt = timestamppb.New(createdAt)
+	a.UpdatedAt = timestamppb.New(updatedAt)
+	return a, nil
+}
+
+// AddAddress stores a new address. The first address of a customer becomes
+// their default, as does any address added with makeDefault.
+func (r *AddressRepository) AddAddress(ctx context.Context, a *customersv1.Address, makeDefault bool) (*customersv1.Address, error) {
+	existing, err := r.ListAddresses(ctx, a.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= MaxAddresses {
+		return nil, ErrAddressBookFull
+	}
+
+	normalize(a)
+	now := time.Now()
+	a.CreatedAt, a.UpdatedAt = timestamppb.New(now), timestamppb.New(now)
+	a.IsDefault = makeDefault || len(existing) == 0
+
+	query := `
+		INSERT INTO products_keyspace.customer_addresses (customer_id, address_id, label, recipient, line1, line2, city, region, postal_code, country, phone, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	if err := r.session.Query(query, a.CustomerId, a.Id, a.Label, a.Recipient, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.Country, a.Phone, now, now).WithContext(ctx).Exec(); err != nil {
+		return nil, err
+	}
+
+	if a.IsDefault {
+		if err := r.setDefault(ctx, a.CustomerId, a.Id); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// UpdateAddress replaces an existing address of the customer. Orders keep the
+// copy they were placed with.
+func (r *AddressRepository) UpdateAddress(ctx context.Context, a *customersv1.Address) (*customersv1.Address, error) {
+	normalize(a)
+	query := `
+		UPDATE products_keyspace.customer_addresses
+		SET label = ?, recipient = ?, line1 = ?, line2 = ?, city = ?, region = ?, postal_code = ?, country = ?, phone = ?, updated_at = ?
+		WHERE customer_id = ? AND address_id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, a.Label, a.Recipient, a.Line1, a.Line2, a.City, a.Region, a.PostalCode, a.Country, a.Phone, time.Now(), a.CustomerId, a.Id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, ErrAddressNotFound
+	}
+	return r.GetAddress(ctx, a.CustomerId, a.Id)
+}
+
+// DeleteAddress removes an address of the customer. Deleting the default
+// address leaves the customer without one until another is set.
+func (r *AddressRepository) DeleteAddress(ctx context.Context, customerId, addressId int64) error {
+	a, err := r.GetAddress(ctx, customerId, addressId)
+	if err != nil {
+		return err
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`DELETE FROM products_keyspace.customer_addresses WHERE customer_id = ? AND address_id = ?`, customerId, addressId)
+	if a.IsDefault {
+		batch.Query(`DELETE default_address_id FROM products_keyspace.customer_addresses WHERE customer_id = ?`, customerId)
+	}
+	return r.session.ExecuteBatch(batch)
+}
+
+// ListAddresses returns the addresses of the customer, oldest first.
+func (r *AddressRepository) ListAddresses(ctx context.Context, customerId int64) ([]*customersv1.Address, error) {
+	query := `SELECT ` + addressColumns + ` FROM products_keyspace.customer_addresses WHERE customer_id = ?`
+	scanner := r.session.Query(query, customerId).WithContext(ctx).Iter().Scanner()
+
+	var addresses []*customersv1.Address
+	for scanner.Next() {
+		a, err := scanAddress(scanner.Scan, customerId)
+		if err != nil {
+			return nil, err
+		}
+		// a partition holding only the default has no address rows
+		if a.Id == 0 {
+			continue
+		}
+		addresses = append(addresses, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return addresses, nil
+}
+
+// GetAddress returns one address of the customer.
+func (r *AddressRepository) GetAddress(ctx context.Context, customerId, addressId int64) (*customersv1.Address, error) {
+	query := `SELECT ` + addressColumns + ` FROM products_keyspace.customer_addresses WHERE customer_id = ? AND address_id = ?`
+	a, err := scanAddress(r.session.Query(query, customerId, addressId).WithContext(ctx).Scan, customerId)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %d", ErrAddressNotFound, addressId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// SetDefaultAddress makes the address the one orders ship to when they name none.
+func (r *AddressRepository) SetDefaultAddress(ctx context.Context, customerId, addressId int64) (*customersv1.Address, error) {
+	a, err := r.GetAddress(ctx, customerId, addressId)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.setDefault(ctx, customerId, addressId); err != nil {
+		return nil, err
+	}
+	a.IsDefault = true
+	return a, nil
+}
+
+func (r *AddressRepository) setDefault(ctx context.Context, customerId, addressId int64) error {
+	query := `UPDATE products_keyspace.customer_addresses SET default_address_id = ? WHERE customer_id = ?`
+	return r.session.Query(query, addressId, customerId).WithContext(ctx).Exec()
+}
+
+// defaultAddress returns the default address of the customer, or nil when they have none.
+func (r *AddressRepository) defaultAddress(ctx context.Context, customerId int64) (*customersv1.Address, error) {
+	var defaultId int64
+	query := `SELECT default_address_id FROM products_keyspace.customer_addresses WHERE customer_id = ? LIMIT 1`
+	if err := r.session.Query(query, customerId).WithContext(ctx).Scan(&defaultId); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if defaultId == 0 {
+		return nil, nil
+	}
+	return r.GetAddress(ctx, customerId, defaultId)
+}
+
+// OrderAddresses returns the addresses an order ships to and is billed to, as
+// copies that later changes to the address book do not affect. An address id
+// takes the entry from the customer's address book, otherwise the address given
+// with the order is used. Without either, the order ships to the default
+// address and is billed where it ships.
+func (r *AddressRepository) OrderAddresses(ctx context.Context, customerId, shippingId, billingId int64, shipping, billing *ordersv1.Address) (*ordersv1.Address, *ordersv1.Address, error) {
+	var err error
+	switch {
+	case shippingId != 0:
+		if shipping, err = r.bookAddress(ctx, customerId, shippingId); err != nil {
+			return nil, nil, err
+		}
+	case shipping == nil:
+		a, err := r.defaultAddress(ctx, customerId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if a != nil {
+			shipping = snapshot(a)
+		}
+	}
+
+	switch {
+	case billingId != 0:
+		if billing, err = r.bookAddress(ctx, customerId, billingId); err != nil {
+			return nil, nil, err
+		}
+	case billing == nil:
+		billing = shipping
+	}
+	return shipping, billing, nil
+}
+
+func (r *AddressRepository) bookAddress(ctx context.Context, customerId, addressId int64) (*ordersv1.Address, error) {
+	a, err := r.GetAddress(ctx, customerId, addressId)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot(a), nil
+}
+
+// snapshot copies an address book entry into the address of an order.
+func snapshot(a *customersv1.Address) *ordersv1.Address {
+	return &ordersv1.Address{
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+		Recipient:  a.Recipient,
+		Phone:      a.Phone,
+		AddressId:  a.Id,
+	}
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -8,6 +10,10 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ErrCustomerNotFound is returned when no customer has the id, or the customer
+// is deleted unless asked for.
+var ErrCustomerNotFound = errors.New("customer not found")
+
 type CustomerRepository struct {
 	session *gocql.Session
 }
@@ -18,41 +24,42 @@ func NewCustomerRepository(session *gocql.Session) *CustomerRepository {
 	}
 }
 
-func (r *CustomerRepository) CreateCustomer(customer *customersv1.Customer) error {
+func (r *CustomerRepository) CreateCustomer(ctx context.Context, customer *customersv1.Customer) error {
 	query := `
 		INSERT INTO products_keyspace.customers (id, username, alias_name, email, created_at)
 		VALUES (?, ?, ?, ?, ?)
 	`
 
-	return r.session.Query(query, customer.Id, customer.Username, customer.AliasName, customer.Email, customer.CreatedAt.AsTime()).Exec()
+	return r.session.Query(query, customer.Id, customer.Username, customer.AliasName, customer.Email, customer.CreatedAt.AsTime()).WithContext(ctx).Exec()
 
 }
 
-func (r *CustomerRepository) GetCustomer(id int64) (*customersv1.Customer, error) {
-	var createdAt time.Time
+// GetCustomer returns a customer. Customers waiting out the grace period of
+// their erasure are only returned with includeDeleted.
+func (r *CustomerRepository) GetCustomer(ctx context.Context, id int64, includeDeleted bool) (*customersv1.Customer, error) {
+	var createdAt, deletedAt time.Time
 	query := `
-		SELECT id, username, alias_name, email, created_at
+		SELECT id, username, alias_name, email, created_at, deleted_at
 		FROM products_keyspace.customers
 		WHERE id = ?
 	`
 
 	var customer customersv1.Customer
-	if err := r.session.Query(query, id).Scan(&customer.Id, &customer.Username, &customer.AliasName, &customer.Email, &createdAt); err != nil {
+	if err := r.session.Query(query, id).WithContext(ctx).Scan(&customer.Id, &customer.Username, &customer.AliasName, &customer.Email, &createdAt, &deletedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, ErrCustomerNotFound
+		}
 		return nil, err
 	}
 
 	customer.CreatedAt = timestamppb.New(createdAt)
+	if !deletedAt.IsZero() {
+		if !includeDeleted {
+			return nil, ErrCustomerNotFound
+		}
+		customer.DeletedAt = timestamppb.New(deletedAt)
+	}
 
 	return &customer, nil
 
 }
-
-func (r *CustomerRepository) DeleteCustomer(id int64) error {
-	query := `
-		DELETE FROM products_keyspace.customers
-		WHERE id = ?
-	`
-
-	return r.session.Query(query, id).Exec()
-
-}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gocql/gocql"
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrErasureExists is returned when the erasure of the customer is already in progress.
+	ErrErasureExists = errors.New("erasure already in progress")
+	// ErrErasureRejected is returned when the customer cannot be erased.
+	ErrErasureRejected = errors.New("erasure rejected")
+	// ErrErasureNotFound is returned when the customer was never deleted.
+	ErrErasureNotFound = errors.New("erasure not found")
+	// ErrErasureNotPending is returned when cancelling an erasure after its grace period.
+	ErrErasureNotPending = errors.New("erasure is not pending")
+)
+
+type ErasureRepository struct {
+	client      client.Client
+	session     *gocql.Session
+	gracePeriod time.Duration
+}
+
+// NewErasureRepository returns a repository that erases deleted customers
+// gracePeriod after they were deleted.
+func NewErasureRepository(client client.Client, session *gocql.Session, gracePeriod time.Duration) *ErasureRepository {
+	return &ErasureRepository{
+		client:      client,
+		session:     session,
+		gracePeriod: gracePeriod,
+	}
+}
+
+func erasureWorkflowID(customerId int64) string {
+	return fmt.Sprintf("customer-erasure-%d", customerId)
+}
+
+// RequestErasure starts the erasure workflow of a customer and waits until the
+// erasure is scheduled or rejected.
+func (r *ErasureRepository) RequestErasure(ctx context.Context, customerId int64, reason string) (*customersv1.CustomerErasure, error) {
+	ctx = logger.WithCorrelationID(ctx, logger.CustomerIDKey, strconv.FormatInt(customerId, 10))
+
+	now := time.Now()
+	erasure := &customersv1.CustomerErasure{
+		CustomerId:  customerId,
+		Reason:      reason,
+		RequestedAt: timestamppb.New(now),
+		EraseAfter:  timestamppb.New(now.Add(r.gracePeriod)),
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        erasureWorkflowID(customerId),
+		TaskQueue: workflows.TaskQueue,
+		// a cancelled erasure may be requested again, one at a time
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}
+
+	we, err := r.client.ExecuteWorkflow(ctx, workflowOptions, workflows.CustomerErasureWorkflow, erasure)
+	if err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil, ErrErasureExists
+		}
+		return nil, fmt.Errorf("failed to execute workflow: %w", err)
+	}
+	logger.FromContext(ctx).Info("customer erasure workflow started", "workflow_id", we.GetID(), "run_id", we.GetRunID())
+
+	handle, err := r.client.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+		WorkflowID:   we.GetID(),
+		RunID:        we.GetRunID(),
+		UpdateName:   workflows.UpdateAwaitErasureAccepted,
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	})
+	if err == nil {
+		err = handle.Get(ctx, nil)
+	}
+	if err != nil {
+		// the workflow may already have failed and closed before accepting the update
+		if desc, descErr := r.client.DescribeWorkflowExecution(ctx, we.GetID(), we.GetRunID()); descErr == nil && desc.WorkflowExecutionInfo.GetCloseTime() != nil {
+			err = we.Get(ctx, nil)
+		}
+		return nil, erasureFailure(err)
+	}
+
+	return r.queryErasure(ctx, customerId)
+}
+
+// erasureFailure maps the error an erasure workflow was rejected with to a repository error.
+func erasureFailure(err error) error {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() == workflows.ErrTypeErasureRejected {
+		return fmt.Errorf("%w: %s", ErrErasureRejected, appErr.Message())
+	}
+	return fmt.Errorf("erasure workflow failed: %w", err)
+}
+
+// CancelErasure signals the erasure workflow of a customer to stop during its
+// grace period and returns the erasure as cancelled.
+func (r *ErasureRepository) CancelErasure(ctx context.Context, customerId int64) (*customersv1.CustomerErasure, error) {
+	erasure, err := r.queryErasure(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+	if erasure.Status != customersv1.ErasureStatus_ERASURE_STATUS_PENDING {
+		return nil, fmt.Errorf("%w: erasure is %s", ErrErasureNotPending, erasure.Status)
+	}
+
+	if err := r.client.SignalWorkflow(ctx, erasureWorkflowID(customerId), "", workflows.SignalCancelErasure, nil); err != nil {
+		return nil, fmt.Errorf("failed to signal erasure workflow: %w", err)
+	}
+
+	// the workflow records the cancellation asynchronously
+	erasure.Status = customersv1.ErasureStatus_ERASURE_STATUS_CANCELLED
+	return erasure, nil
+}
+
+func (r *ErasureRepository) queryErasure(ctx context.Context, customerId int64) (*customersv1.CustomerErasure, error) {
+	value, err := r.client.QueryWorkflow(ctx, erasureWorkflowID(customerId), "", workflows.QueryErasure)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil, ErrErasureNotFound
+		}
+		return nil, fmt.Errorf("failed to query erasure workflow: %w", err)
+	}
+
+	var erasure customersv1.CustomerErasure
+	if err := value.Get(&erasure); err != nil {
+		return nil, fmt.Errorf("failed to decode erasure: %w", err)
+	}
+	return &erasure, nil
+}
+
+// GetErasure returns the latest erasure of a customer from its tombstone, which
+// outlives the workflow and the customer.
+func (r *ErasureRepository) GetErasure(ctx context.Context, customerId int64) (*customersv1.CustomerErasure, error) {
+	var (
+		status, reason, detail               string
+		requestedAt, eraseAfter, completedAt time.Time
+		ordersAnonymized                     int32
+	)
+	query := `
+		SELECT status, reason, requested_at, erase_after, completed_at, orders_anonymized, detail
+		FROM products_keyspace.customer_erasures
+		WHERE customer_id = ?
+	`
+	if err := r.session.Query(query, customerId).WithContext(ctx).Scan(&status, &reason, &requestedAt, &eraseAfter, &completedAt, &ordersAnonymized, &detail); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, ErrErasureNotFound
+		}
+		return nil, err
+	}
+
+	erasure := &customersv1.CustomerErasure{
+		CustomerId:       customerId,
+		Status:           customersv1.ErasureStatus(customersv1.ErasureStatus_value[status]),
+		Reason:           reason,
+		RequestedAt:      timestamppb.New(requestedAt),
+		EraseAfter:       timestamppb.New(eraseAfter),
+		OrdersAnonymized: ordersAnonymized,
+		Detail:           detail,
+	}
+	if !completedAt.IsZero() {
+		erasure.CompletedAt = timestamppb.New(completedAt)
+	}
+	return erasure, nil
+}
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrExportNotFound is returned for an export the customer never requested.
+var ErrExportNotFound = errors.New("export not found")
+
+type ExportRepository struct {
+	client  client.Client
+	session *gocql.Session
+}
+
+// NewExportRepository returns a repository that exports customer data with a
+// workflow and keeps an audit record of every export.
+func NewExportRepository(client client.Client, session *gocql.Session) *ExportRepository {
+	return &ExportRepository{
+		client:  client,
+		session: session,
+	}
+}
+
+func exportWorkflowID(exportId int64) string {
+	return fmt.Sprintf("customer-export-%d", exportId)
+}
+
+// RequestExport records the export in the audit table and starts the workflow
+// writing it. The export is returned running; the workflow records its outcome.
+func (r *ExportRepository) RequestExport(ctx context.Context, customerId, exportId int64, includeCsv bool) (*customersv1.CustomerDataExport, error) {
+	ctx = logger.WithCorrelationID(ctx, logger.CustomerIDKey, strconv.FormatInt(customerId, 10))
+
+	export := &customersv1.CustomerDataExport{
+		ExportId:    exportId,
+		CustomerId:  customerId,
+		Status:      customersv1.ExportStatus_EXPORT_STATUS_RUNNING,
+		IncludeCsv:  includeCsv,
+		RequestedBy: requestedBy(ctx),
+		RequestedAt: timestamppb.New(time.Now()),
+	}
+
+	// the request is recorded first so that every export started is audited
+	query := `
+		INSERT INTO products_keyspace.customer_exports (customer_id, export_id, status, include_csv, requested_by, requested_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	if err := r.session.Query(query,
+		export.CustomerId,
+		export.ExportId,
+		export.Status.String(),
+		export.IncludeCsv,
+		export.RequestedBy,
+		export.RequestedAt.AsTime(),
+	).WithContext(ctx).Exec(); err != nil {
+		return nil, fmt.Errorf("failed to record export: %w", err)
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        exportWorkflowID(exportId),
+		TaskQueue: workflows.TaskQueue,
+	}
+	we, err := r.client.ExecuteWorkflow(ctx, workflowOptions, workflows.CustomerExportWorkflow, export)
+	if err != nil {
+		update := `UPDATE products_keyspace.customer_exports SET status = ?, error = ? WHERE customer_id = ? AND export_id = ?`
+		if recordErr := r.session.Query(update, customersv1.ExportStatus_EXPORT_STATUS_FAILED.String(), err.Error(), customerId, exportId).WithContext(ctx).Exec(); recordErr != nil {
+			logger.FromContext(ctx).Error("failed to record export failure", "export_id", exportId, "error", recordErr)
+		}
+		return nil, fmt.Errorf("failed to execute workflow: %w", err)
+	}
+	logger.FromContext(ctx).Info("customer export workflow started", "workflow_id", we.GetID(), "run_id", we.GetRunID())
+
+	return export, nil
+}
+
+// requestedBy is the subject of the caller, recorded as who asked for an export.
+func requestedBy(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+	return "anonymous"
+}
+
+// GetExport returns an export of the customer from the audit table.
+func (r *ExportRepository) GetExport(ctx context.Context, customerId, exportId int64) (*customersv1.CustomerDataExport, error) {
+	var (
+		status, requester, exportErr string
+		includeCsv                   bool
+		requestedAt, completedAt     time.Time
+		handles, checksums           map[string]string
+		sizes                        map[string]int64
+	)
+	query := `
+		SELECT status, include_csv, requested_by, requested_at, completed_at, files, file_sizes, file_checksums, error
+		FROM products_keyspace.customer_exports
+		WHERE customer_id = ? AND export_id = ?
+	`
+	if err := r.session.Query(query, customerId, exportId).WithContext(ctx).Scan(
+		&status, &includeCsv, &requester, &requestedAt, &completedAt, &handles, &sizes, &checksums, &exportErr,
+	); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, ErrExportNotFound
+		}
+		return nil, err
+	}
+
+	export := &customersv1.CustomerDataExport{
+		ExportId:    exportId,
+		CustomerId:  customerId,
+		Status:      customersv1.ExportStatus(customersv1.ExportStatus_value[status]),
+		IncludeCsv:  includeCsv,
+		RequestedBy: requester,
+		RequestedAt: timestamppb.New(requestedAt),
+		Error:       exportErr,
+	}
+	if !completedAt.IsZero() {
+		export.CompletedAt = timestamppb.New(completedAt)
+	}
+	names := make([]string, 0, len(handles))
+	for name := range handles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		export.Files = append(export.Files, &customersv1.ExportFile{
+			Name:      name,
+			Format:    strings.TrimPrefix(path.Ext(name), "."),
+			Handle:    handles[name],
+			SizeBytes: sizes[name],
+			Sha256:    checksums[name],
+		})
+	}
+	return export, nil
+}
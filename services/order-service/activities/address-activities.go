@@ -0,0 +1,58 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+)
+
+// ✅ Record the addresses of a persisted order as they were when it was placed
+func (o *OrderActivity) RecordAddresses(ctx context.Context, orderId int64, shipping, billing *ordersv1.Address) error {
+	query := `UPDATE orders SET shipping_address = ?, billing_address = ? WHERE id = ?`
+	if err := o.Cassandra.Query(query, addressColumn(shipping), addressColumn(billing), orderId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record order addresses: %w", err)
+	}
+	return nil
+}
+
+// addressColumn turns an address into the map it is stored as; nil stays null.
+func addressColumn(a *ordersv1.Address) map[string]string {
+	if a == nil {
+		return nil
+	}
+	column := map[string]string{
+		"line1":       a.Line1,
+		"line2":       a.Line2,
+		"city":        a.City,
+		"region":      a.Region,
+		"postal_code": a.PostalCode,
+		"country":     a.Country,
+		"recipient":   a.Recipient,
+		"phone":       a.Phone,
+	}
+	if a.AddressId != 0 {
+		column["address_id"] = strconv.FormatInt(a.AddressId, 10)
+	}
+	return column
+}
+
+// columnAddress turns a stored address map back into an address; null stays nil.
+func columnAddress(column map[string]string) *ordersv1.Address {
+	if len(column) == 0 {
+		return nil
+	}
+	addressId, _ := strconv.ParseInt(column["address_id"], 10, 64)
+	return &ordersv1.Address{
+		Line1:      column["line1"],
+		Line2:      column["line2"],
+		City:       column["city"],
+		Region:     column["region"],
+		PostalCode: column["postal_code"],
+		Country:    column["country"],
+		Recipient:  column["recipient"],
+		Phone:      column["phone"],
+		AddressId:  addressId,
+	}
+}
@@ -0,0 +1,109 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/backorders"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+)
+
+// ErrTypeInsufficientStock is the error type of CheckBackorders when an item is
+// out of stock and its product cannot be backordered, and of ReserveStock when
+// other orders took the stock first.
+const ErrTypeInsufficientStock = "InsufficientStock"
+
+// SignalStockReplenished tells a backordered order that one of its products, sent as the payload, is back in stock.
+const SignalStockReplenished = backorders.SignalStockReplenished
+
+// OrderWorkflowID is the workflow ID of the order workflow of an order.
+func OrderWorkflowID(orderId int64) string {
+	return backorders.OrderWorkflowID(orderId)
+}
+
+// Backorder is an item of an order that is short on stock but may wait for it.
+type Backorder struct {
+	ProductID int64
+	Shortfall int
+	// ExpectedRestockAt is when the product is expected back; zero when unknown.
+	ExpectedRestockAt time.Time
+}
+
+// ✅ Find the items that are short on stock, failing when any of them cannot be backordered
+func (o *OrderActivity) CheckBackorders(ctx context.Context, items []*ordersv1.OrderItem) ([]Backorder, error) {
+	var backorders []Backorder
+	for _, item := range items {
+		level, err := o.stockLevel(ctx, item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		if level.Available >= int(item.Quantity) {
+			continue
+		}
+
+		var allowBackorder bool
+		var expectedRestockAt time.Time
+		query := `SELECT allow_backorder, expected_restock_at FROM products WHERE id = ? LIMIT 1`
+		if err := o.Cassandra.Query(query, item.ProductId).WithContext(ctx).Scan(&allowBackorder, &expectedRestockAt); err != nil {
+			return nil, fmt.Errorf("failed to read backorder policy of product %d: %w", item.ProductId, err)
+		}
+		if !allowBackorder {
+			msg := fmt.Sprintf("product %d has insufficient stock: %d available of %d on hand", item.ProductId, level.Available, level.OnHand)
+			return nil, temporal.NewNonRetryableApplicationError(msg, ErrTypeInsufficientStock, nil)
+		}
+
+		backorders = append(backorders, Backorder{
+			ProductID:         item.ProductId,
+			Shortfall:         int(item.Quantity) - max(level.Available, 0),
+			ExpectedRestockAt: expectedRestockAt,
+		})
+	}
+	return backorders, nil
+}
+
+// ✅ Register the order as waiting for the backordered products
+func (o *OrderActivity) RegisterBackorders(ctx context.Context, orderId int64, backorders []Backorder) error {
+	query := `INSERT INTO backorders (product_id, order_id, shortfall, created_at) VALUES (?, ?, ?, ?)`
+	for _, b := range backorders {
+		if err := o.Cassandra.Query(query, b.ProductID, orderId, b.Shortfall, time.Now()).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to register backorder of product %d: %w", b.ProductID, err)
+		}
+	}
+	return nil
+}
+
+// ✅ Remove the order from the waiting list of its products
+func (o *OrderActivity) ClearBackorders(ctx context.Context, orderId int64, items []*ordersv1.OrderItem) error {
+	query := `DELETE FROM backorders WHERE product_id = ? AND order_id = ?`
+	for _, item := range items {
+		if err := o.Cassandra.Query(query, item.ProductId, orderId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to clear backorder of product %d: %w", item.ProductId, err)
+		}
+	}
+	return nil
+}
+
+// ✅ Signal every order waiting for a product that it is back in stock
+func (o *OrderActivity) NotifyBackorders(ctx context.Context, productId int64) (int, error) {
+	notified, err := backorders.NewNotifier(o.Cassandra, o.Temporal, "").Notify(ctx, productId)
+	if err != nil {
+		return notified, err
+	}
+
+	logger.Activity(ctx).Info("backordered orders notified", "product_id", productId, "orders", notified)
+	return notified, nil
+}
+
+// wakeBackorders tells the orders waiting for products that their stock grew.
+// A missed signal only delays them until their next recheck, so it is logged
+// instead of failing the stock change.
+func (o *OrderActivity) wakeBackorders(ctx context.Context, productIds ...int64) {
+	for _, productId := range productIds {
+		if _, err := o.NotifyBackorders(ctx, productId); err != nil {
+			logger.Activity(ctx).Warn("failed to notify backordered orders", "product_id", productId, "error", err)
+		}
+	}
+}
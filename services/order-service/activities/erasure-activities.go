@@ -0,0 +1,159 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+)
+
+// CustomerOrder is an order of a customer and its status.
+type CustomerOrder struct {
+	OrderId int64
+	Status  string
+}
+
+// anonymizedAddressKeys are the parts of an address kept on anonymized orders,
+// which tax records need and which do not identify anyone.
+var anonymizedAddressKeys = []string{"country", "region"}
+
+// ✅ List the orders of a customer
+func (o *OrderActivity) ListOrdersOfCustomer(ctx context.Context, customerId int64) ([]CustomerOrder, error) {
+	// orders placed before orders_by_customer existed are added by the backfill
+	query := `SELECT order_id, status FROM orders_by_customer WHERE customer_id = ?`
+	scanner := o.Cassandra.Query(query, customerId).WithContext(ctx).Iter().Scanner()
+
+	var orders []CustomerOrder
+	for scanner.Next() {
+		var order CustomerOrder
+		if err := scanner.Scan(&order.OrderId, &order.Status); err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list orders of customer %d: %w", customerId, err)
+	}
+	return orders, nil
+}
+
+// ✅ Hide a customer during the grace period of their erasure
+func (o *OrderActivity) MarkCustomerDeleted(ctx context.Context, customerId int64, deletedAt time.Time) error {
+	query := `UPDATE customers SET deleted_at = ? WHERE id = ? IF EXISTS`
+	if _, err := o.Cassandra.Query(query, deletedAt, customerId).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+		return fmt.Errorf("failed to mark customer %d deleted: %w", customerId, err)
+	}
+	return nil
+}
+
+// ✅ Show a customer again once their erasure is cancelled or rejected
+func (o *OrderActivity) RestoreCustomer(ctx context.Context, customerId int64) error {
+	query := `UPDATE customers SET deleted_at = null WHERE id = ? IF EXISTS`
+	if _, err := o.Cassandra.Query(query, customerId).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+		return fmt.Errorf("failed to restore customer %d: %w", customerId, err)
+	}
+	logger.Activity(ctx).Info("customer restored", "customer_id", customerId)
+	return nil
+}
+
+// ✅ Remove the personal data from orders, keeping what tax records need
+func (o *OrderActivity) AnonymizeOrders(ctx context.Context, orderIds []int64) error {
+	now := time.Now()
+	for _, orderId := range orderIds {
+		var shipping, billing map[string]string
+		query := `SELECT shipping_address, billing_address FROM orders WHERE id = ?`
+		if err := o.Cassandra.Query(query, orderId).WithContext(ctx).Scan(&shipping, &billing); err != nil {
+			if errors.Is(err, gocql.ErrNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to read addresses of order %d: %w", orderId, err)
+		}
+
+		update := `UPDATE orders SET shipping_address = ?, billing_address = ?, anonymized_at = ? WHERE id = ?`
+		if err := o.Cassandra.Query(update, anonymizeAddress(shipping), anonymizeAddress(billing), now, orderId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to anonymize order %d: %w", orderId, err)
+		}
+	}
+
+	logger.Activity(ctx).Info("orders anonymized", "orders", len(orderIds))
+	return nil
+}
+
+func anonymizeAddress(address map[string]string) map[string]string {
+	if address == nil {
+		return nil
+	}
+	kept := make(map[string]string, len(anonymizedAddressKeys))
+	for _, key := range anonymizedAddressKeys {
+		if value, ok := address[key]; ok {
+			kept[key] = value
+		}
+	}
+	return kept
+}
+
+// ✅ Delete the customer and every row holding their personal data
+func (o *OrderActivity) EraseCustomer(ctx context.Context, customerId int64) error {
+	var username, aliasName, email string
+	var createdAt time.Time
+	query := `SELECT username, alias_name, email, created_at FROM customers WHERE id = ?`
+	err := o.Cassandra.Query(query, customerId).WithContext(ctx).Scan(&username, &aliasName, &email, &createdAt)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return fmt.Errorf("failed to read customer %d: %w", customerId, err)
+	}
+
+	// the customer row goes last; the lookup rows can only be found through it
+	// when the activity is retried
+	batch := o.Cassandra.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	if username != "" {
+		batch.Query(`DELETE FROM customers_by_username WHERE username = ?`, username)
+	}
+	if email != "" {
+		batch.Query(`DELETE FROM customers_by_email WHERE email = ?`, email)
+	}
+	if aliasName != "" {
+		batch.Query(`DELETE FROM customers_by_alias WHERE alias_name = ? AND customer_id = ?`, aliasName, customerId)
+	}
+	if !createdAt.IsZero() {
+		batch.Query(`DELETE FROM customers_by_created_at WHERE created_at = ? AND customer_id = ?`, createdAt, customerId)
+	}
+	batch.Query(`DELETE FROM customer_addresses WHERE customer_id = ?`, customerId)
+	batch.Query(`DELETE FROM cart_items WHERE customer_id = ?`, customerId)
+	if err := o.Cassandra.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to erase personal data of customer %d: %w", customerId, err)
+	}
+
+	if err := o.Cassandra.Query(`DELETE FROM customers WHERE id = ?`, customerId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to delete customer %d: %w", customerId, err)
+	}
+
+	logger.Activity(ctx).Info("customer erased", "customer_id", customerId)
+	return nil
+}
+
+// ✅ Record the erasure of a customer; the tombstone holds no personal data
+func (o *OrderActivity) RecordErasure(ctx context.Context, erasure *customersv1.CustomerErasure) error {
+	var completedAt interface{}
+	if erasure.CompletedAt != nil {
+		completedAt = erasure.CompletedAt.AsTime()
+	}
+
+	query := `INSERT INTO customer_erasures (customer_id, status, reason, requested_at, erase_after, completed_at, orders_anonymized, detail) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	if err := o.Cassandra.Query(query,
+		erasure.CustomerId,
+		erasure.Status.String(),
+		erasure.Reason,
+		erasure.RequestedAt.AsTime(),
+		erasure.EraseAfter.AsTime(),
+		completedAt,
+		erasure.OrdersAnonymized,
+		erasure.Detail,
+	).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record erasure of customer %d: %w", erasure.CustomerId, err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package activities
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gocql/gocql"
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/exports"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrTypeCustomerNotFound is the error type of an export of a customer that does not exist.
+const ErrTypeCustomerNotFound = "CustomerNotFound"
+
+// ✅ Gather the data held about a customer and write it to export storage
+func (o *OrderActivity) WriteCustomerExport(ctx context.Context, export *customersv1.CustomerDataExport) ([]*customersv1.ExportFile, error) {
+	data := &customersv1.CustomerData{ExportedAt: timestamppb.Now()}
+
+	customer := &customersv1.Customer{Id: export.CustomerId}
+	var createdAt time.Time
+	query := `SELECT username, alias_name, email, created_at FROM customers WHERE id = ?`
+	err := o.Cassandra.Query(query, export.CustomerId).WithContext(ctx).Scan(&customer.Username, &customer.AliasName, &customer.Email, &createdAt)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("customer %d not found", export.CustomerId), ErrTypeCustomerNotFound, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read customer %d: %w", export.CustomerId, err)
+	}
+	customer.CreatedAt = timestamppb.New(createdAt)
+	data.Customer = customer
+
+	if data.Addresses, err = o.customerAddresses(ctx, export.CustomerId); err != nil {
+		return nil, err
+	}
+
+	orders, err := o.ListOrdersOfCustomer(ctx, export.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	for i, customerOrder := range orders {
+		order, err := o.GetOrder(ctx, customerOrder.OrderId)
+		if err != nil {
+			var appErr *temporal.ApplicationError
+			// the order may be gone since it was listed
+			if errors.As(err, &appErr) && appErr.Type() == ErrTypeOrderNotFound {
+				continue
+			}
+			return nil, err
+		}
+		data.Orders = append(data.Orders, order)
+		activity.RecordHeartbeat(ctx, i)
+	}
+	sort.Slice(data.Orders, func(i, j int) bool {
+		return data.Orders[i].CreatedAt.AsTime().Before(data.Orders[j].CreatedAt.AsTime())
+	})
+
+	// the same export always lands under the same keys, so retries overwrite their own files
+	prefix := fmt.Sprintf("%d/%d/", export.CustomerId, export.ExportId)
+
+	document, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode customer data: %w", err)
+	}
+	contents := []exportContent{{name: "customer-data.json", format: "json", data: document}}
+
+	if export.IncludeCsv {
+		addresses, err := exports.AddressesCSV(data.Addresses)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode addresses: %w", err)
+		}
+		orderRows, err := exports.OrdersCSV(data.Orders)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode orders: %w", err)
+		}
+		contents = append(contents,
+			exportContent{name: "addresses.csv", format: "csv", data: addresses},
+			exportContent{name: "orders.csv", format: "csv", data: orderRows},
+		)
+	}
+
+	files := make([]*customersv1.ExportFile, 0, len(contents))
+	for _, content := range contents {
+		handle, err := o.Exports.Put(ctx, prefix+content.name, content.data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store %s: %w", content.name, err)
+		}
+		sum := sha256.Sum256(content.data)
+		files = append(files, &customersv1.ExportFile{
+			Name:      content.name,
+			Format:    content.format,
+			Handle:    handle,
+			SizeBytes: int64(len(content.data)),
+			Sha256:    hex.EncodeToString(sum[:]),
+		})
+	}
+
+	logger.Activity(ctx).Info("customer data exported", "customer_id", export.CustomerId, "export_id", export.ExportId, "orders", len(data.Orders), "files", len(files))
+	return files, nil
+}
+
+// exportContent is a file of an export before it is stored.
+type exportContent struct {
+	name, format string
+	data         []byte
+}
+
+func (o *OrderActivity) customerAddresses(ctx context.Context, customerId int64) ([]*customersv1.Address, error) {
+	query := `SELECT default_address_id, address_id, label, recipient, line1, line2, city, region, postal_code, country, phone, created_at, updated_at FROM customer_addresses WHERE customer_id = ?`
+	scanner := o.Cassandra.Query(query, customerId).WithContext(ctx).Iter().Scanner()
+
+	var addresses []*customersv1.Address
+	for scanner.Next() {
+		a := &customersv1.Address{CustomerId: customerId}
+		var defaultId int64
+		var createdAt, updatedAt time.Time
+		if err := scanner.Scan(&defaultId, &a.Id, &a.Label, &a.Recipient, &a.Line1, &a.Line2, &a.City, &a.Region, &a.PostalCode, &a.Country, &a.Phone, &createdAt, &updatedAt); err != nil {
+			return nil, err
+		}
+		// a partition holding only the default has no address rows
+		if a.Id == 0 {
+			continue
+		}
+		a.IsDefault = a.Id == defaultId
+		a.CreatedAt = timestamppb.New(createdAt)
+		a.UpdatedAt = timestamppb.New(updatedAt)
+		addresses = append(addresses, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read addresses of customer %d: %w", customerId, err)
+	}
+	return addresses, nil
+}
+
+// ✅ Record the outcome of a customer data export in the audit table
+func (o *OrderActivity) RecordExport(ctx context.Context, export *customersv1.CustomerDataExport) error {
+	var completedAt interface{}
+	if export.CompletedAt != nil {
+		completedAt = export.CompletedAt.AsTime()
+	}
+	// files are keyed by name in each map
+	handles := make(map[string]string, len(export.Files))
+	sizes := make(map[string]int64, len(export.Files))
+	checksums := make(map[string]string, len(export.Files))
+	for _, file := range export.Files {
+		handles[file.Name] = file.Handle
+		sizes[file.Name] = file.SizeBytes
+		checksums[file.Name] = file.Sha256
+	}
+
+	// only the outcome is written; who asked and when was recorded with the request
+	query := `UPDATE customer_exports SET status = ?, completed_at = ?, files = ?, file_sizes = ?, file_checksums = ?, error = ? WHERE customer_id = ? AND export_id = ?`
+	if err := o.Cassandra.Query(query,
+		export.Status.String(),
+		completedAt,
+		handles,
+		sizes,
+		checksums,
+		export.Error,
+		export.CustomerId,
+		export.ExportId,
+	).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record export %d of customer %d: %w", export.ExportId, export.CustomerId, err)
+	}
+	return nil
+}
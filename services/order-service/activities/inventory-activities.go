@@ -0,0 +1,149 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/inventory"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+)
+
+// Error types of allocation failures that retrying the same activity cannot fix.
+const (
+	ErrTypeAllocationFailed   = "AllocationFailed"
+	ErrTypeAllocationConflict = "AllocationConflict"
+)
+
+var errInsufficientLocationStock = errors.New("insufficient stock at location")
+
+// defaultLocation is the warehouse that holds the stock of products without
+// per-warehouse inventory and receives returns.
+func (o *OrderActivity) defaultLocation() string {
+	if len(o.Inventory.Warehouses) == 0 {
+		return ""
+	}
+	return o.Inventory.Warehouses[0].ID
+}
+
+// locationStock reads the stock of a product per warehouse. It returns nil
+// when the product has no per-warehouse inventory yet.
+func (o *OrderActivity) locationStock(ctx context.Context, productId int64) (map[string]int32, error) {
+	levels, _, err := o.locationStockChange(ctx, productId)
+	return levels, err
+}
+
+// locationStockChange reads the stock of a product per warehouse together
+// with the key of the last stock change applied to it.
+func (o *OrderActivity) locationStockChange(ctx context.Context, productId int64) (map[string]int32, string, error) {
+	query := `SELECT location_id, quantity, stock_change FROM inventory WHERE product_id = ?`
+	iter := o.Cassandra.Query(query, productId).WithContext(ctx).Iter()
+
+	var levels map[string]int32
+	var last string
+	var location string
+	var quantity int32
+	for iter.Scan(&location, &quantity, &last) {
+		if levels == nil {
+			levels = make(map[string]int32)
+		}
+		levels[location] = quantity
+	}
+	if err := iter.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to read inventory of product %d: %w", productId, err)
+	}
+	return levels, last, nil
+}
+
+// ✅ Split the order into shipments across warehouses
+func (o *OrderActivity) AllocateShipments(ctx context.Context, items []*ordersv1.OrderItem, shipTo *ordersv1.GeoPoint) ([]*ordersv1.Shipment, error) {
+	stock := make(inventory.Stock, len(items))
+	for _, item := range items {
+		levels, err := o.locationStock(ctx, item.ProductId)
+		if err != nil {
+			return nil, err
+		}
+
+		if levels == nil {
+			level, err := o.stockLevel(ctx, item.ProductId)
+			if err != nil {
+				return nil, err
+			}
+			levels = map[string]int32{o.defaultLocation(): int32(level.OnHand)}
+		}
+		stock[item.ProductId] = levels
+	}
+
+	shipments, err := inventory.Allocate(o.Inventory.AllocationStrategy, o.Inventory.Warehouses, stock, items, shipTo)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeAllocationFailed, err)
+	}
+
+	logger.Activity(ctx).Info("order allocated", "strategy", o.Inventory.AllocationStrategy, "shipments", len(shipments))
+	return shipments, nil
+}
+
+// adjustLocationStock applies the deltas to the stock of a product at each
+// warehouse in one conditional batch, so either all of them apply or none.
+// Products without per-warehouse inventory are left alone. A non-empty key is
+// kept like adjustStock keeps it, with the last one on the product's partition.
+func (o *OrderActivity) adjustLocationStock(ctx context.Context, productId int64, deltas map[string]int32, key string) error {
+	if key != "" {
+		applied, err := o.stockChangeApplied(ctx, productId, stockChangeInventory, key)
+		if err != nil || applied {
+			return err
+		}
+	}
+
+	for {
+		levels, last, err := o.locationStockChange(ctx, productId)
+		if err != nil || levels == nil {
+			return err
+		}
+		if key != "" && last == key {
+			// an earlier attempt applied it and failed before recording it
+			return o.recordStockChange(ctx, productId, stockChangeInventory, key)
+		}
+
+		// all rows of a product share a partition, which conditional batches require
+		batch := o.Cassandra.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+		for location, delta := range deltas {
+			current, ok := levels[location]
+			if !ok {
+				batch.Query(`INSERT INTO inventory (product_id, location_id, quantity) VALUES (?, ?, ?) IF NOT EXISTS`, productId, location, delta)
+				current = 0
+			} else {
+				batch.Query(`UPDATE inventory SET quantity = ? WHERE product_id = ? AND location_id = ? IF quantity = ?`, current+delta, productId, location, current)
+			}
+			if current+delta < 0 {
+				return fmt.Errorf("%w: product %d at %s", errInsufficientLocationStock, productId, location)
+			}
+		}
+		if key != "" {
+			if last != "" {
+				if err := o.recordStockChange(ctx, productId, stockChangeInventory, last); err != nil {
+					return err
+				}
+			}
+			batch.Query(`UPDATE inventory SET stock_change = ? WHERE product_id = ? IF stock_change = ?`, key, productId, optionalKey(last))
+		}
+
+		applied, iter, err := o.Cassandra.ExecuteBatchCAS(batch)
+		if iter != nil {
+			_ = iter.Close()
+		}
+		if err != nil {
+			return err
+		}
+		if applied {
+			if key != "" {
+				return o.recordStockChange(ctx, productId, stockChangeInventory, key)
+			}
+			return nil
+		}
+		// another order or adjustment changed the stock in between; retry with the new values
+	}
+}
@@ -2,92 +2,259 @@ package activities
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/gocql/gocql"
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
 	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/exports"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/payments"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/promotions"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"gopkg.in/inf.v0"
 )
 
 type OrderActivity struct {
 	Cassandra *gocql.Session
+	Payments  payments.PaymentGateway
+	// Currency is the ISO 4217 code of orders that do not choose one
+	Currency  string
+	Inventory pkg.Inventory
+	Ledger    *ledger.Ledger
+	// Temporal starts and signals replenishment workflows
+	Temporal      client.Client
+	Replenishment pkg.Replenishment
+	Promotions    *promotions.Store
+	Tax           tax.TaxCalculator
+	Converter     *money.Converter
+	Exports       exports.Storage
 }
 
 // ✅ Check if customer exists
 func (o *OrderActivity) CheckCustomerExists(ctx context.Context, customerID int64) (bool, error) {
-	var name string
-	query := `SELECT name FROM customers WHERE id = ?`
+	var deletedAt time.Time
+	query := `SELECT deleted_at FROM customers WHERE id = ?`
 
-	err := o.Cassandra.Query(query, customerID).WithContext(ctx).Scan(&name)
+	err := o.Cassandra.Query(query, customerID).WithContext(ctx).Scan(&deletedAt)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return false, nil
+	}
 	if err != nil {
 		return false, err
 	}
 
-	return name != "", nil
+	// deleted customers cannot order during the grace period of their erasure
+	return deletedAt.IsZero(), nil
 }
 
 // ✅ Check products availability
-func (o *OrderActivity) CheckProductsAvailability(ctx context.Context, items []*ordersv1.OrderItem) error {
+func (o *OrderActivity) CheckProductsAvailability(ctx context.Context, items []*ordersv1.OrderItem) ([]StockLevel, error) {
+	levels := make([]StockLevel, 0, len(items))
 	for _, item := range items {
-		var stock int
-		query := `SELECT stock FROM products WHERE id = ? LIMIT 1`
-
-		err := o.Cassandra.Query(query, item.ProductId).WithContext(ctx).Scan(&stock)
+		level, err := o.stockLevel(ctx, item.ProductId)
 		if err != nil {
-			return fmt.Errorf("product %d not found", item.ProductId)
+			return nil, err
 		}
 
-		if stock < int(item.Quantity) {
-			return fmt.Errorf("product %d has insufficient stock", item.ProductId)
+		if level.Available < int(item.Quantity) {
+			// retrying cannot help, and orders started before it was dropped from the workflow still call it
+			msg := fmt.Sprintf("product %d has insufficient stock: %d available of %d on hand", item.ProductId, level.Available, level.OnHand)
+			return nil, temporal.NewNonRetryableApplicationError(msg, ErrTypeInsufficientStock, nil)
 		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+// stockChangeTTL is how long the key of an idempotent stock change is kept,
+// which bounds how late a retried activity is still recognised.
+const stockChangeTTL = 30 * 24 * time.Hour
+
+// The targets of idempotent stock changes in stock_changes.
+const (
+	stockChangeProducts  = "products"
+	stockChangeInventory = "inventory"
+)
+
+// stockChangeApplied reports whether the change with key was applied to target.
+func (o *OrderActivity) stockChangeApplied(ctx context.Context, productId int64, target, key string) (bool, error) {
+	var appliedAt time.Time
+	query := `SELECT applied_at FROM stock_changes WHERE product_id = ? AND key = ? AND target = ?`
+	err := o.Cassandra.Query(query, productId, key, target).WithContext(ctx).Scan(&appliedAt)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read stock change %s of product %d: %w", key, productId, err)
+	}
+	return true, nil
+}
+
+// recordStockChange remembers that the change with key was applied to target.
+// The first record wins, so it keeps the time the change was first seen applied.
+func (o *OrderActivity) recordStockChange(ctx context.Context, productId int64, target, key string) error {
+	query := `INSERT INTO stock_changes (product_id, key, target, applied_at) VALUES (?, ?, ?, ?) IF NOT EXISTS USING TTL ?`
+	_, err := o.Cassandra.Query(query, productId, key, target, time.Now(), int(stockChangeTTL.Seconds())).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to record stock change %s of product %d: %w", key, productId, err)
 	}
 	return nil
 }
 
-// ✅ Reserve stock atomically
-func (o *OrderActivity) ReserveStock(ctx context.Context, items []*ordersv1.OrderItem) error {
-	for _, item := range items {
-		query := `UPDATE products SET stock = stock - ? WHERE id = ? IF stock >= ?`
-		applied, err := o.Cassandra.Query(query, item.Quantity, item.ProductId, item.Quantity).WithContext(ctx).ScanCAS()
+// optionalKey binds an empty stock change key as null, which is what a row
+// that never had a keyed change holds.
+func optionalKey(key string) interface{} {
+	if key == "" {
+		return nil
+	}
+	return key
+}
 
-		if err != nil {
-			return fmt.Errorf("failed reserving stock for product %d: %w", item.ProductId, err)
+// adjustStock adds delta to the stock of a product with a compare-and-set, so
+// concurrent orders never oversell. It fails when stock would go negative.
+// Applying a non-empty key again is a no-op, so retried activities do not
+// repeat a change: the product row keeps the key of the last change it took,
+// and every earlier key is in stock_changes. A key is recorded there before
+// the row moves on to the next one, so a change that is in neither place was
+// never applied.
+func (o *OrderActivity) adjustStock(ctx context.Context, productId int64, delta int, key string) error {
+	if key != "" {
+		applied, err := o.stockChangeApplied(ctx, productId, stockChangeProducts, key)
+		if err != nil || applied {
+			return err
+		}
+	}
+
+	for {
+		var stock int
+		var last string
+		query := `SELECT stock, stock_change FROM products WHERE id = ? LIMIT 1`
+		if err := o.Cassandra.Query(query, productId).WithContext(ctx).Scan(&stock, &last); err != nil {
+			return fmt.Errorf("product %d not found: %w", productId, err)
+		}
+		if key != "" && last == key {
+			// an earlier attempt applied it and failed before recording it
+			return o.recordStockChange(ctx, productId, stockChangeProducts, key)
+		}
+
+		if stock+delta < 0 {
+			return fmt.Errorf("insufficient stock for product %d", productId)
 		}
 
-		if !applied {
-			return fmt.Errorf("insufficient stock for product %d", item.ProductId)
+		var applied bool
+		var err error
+		if key == "" {
+			var current int
+			update := `UPDATE products SET stock = ? WHERE id = ? IF stock = ?`
+			applied, err = o.Cassandra.Query(update, stock+delta, productId, stock).WithContext(ctx).ScanCAS(&current)
+		} else {
+			if last != "" {
+				if err := o.recordStockChange(ctx, productId, stockChangeProducts, last); err != nil {
+					return err
+				}
+			}
+			update := `UPDATE products SET stock = ?, stock_change = ? WHERE id = ? IF stock = ? AND stock_change = ?`
+			applied, err = o.Cassandra.Query(update, stock+delta, key, productId, stock, optionalKey(last)).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+			if err == nil && applied {
+				return o.recordStockChange(ctx, productId, stockChangeProducts, key)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if applied {
+			return nil
 		}
+		// another order changed the stock in between, or a concurrent attempt
+		// applied the same change; retry with the new value
 	}
-	return nil
 }
 
-func (o *OrderActivity) CreateOrder(ctx context.Context, items []*ordersv1.OrderItem, orderId, customerId int64, status string) error {
+func (o *OrderActivity) CreateOrder(ctx context.Context, items []*ordersv1.OrderItem, orderId, customerId int64, status, currency string, rates []*moneyv1.ExchangeRate) error {
 	createdAt := time.Now()
-	updatedAt := createdAt
+	// a retry keeps the time of the first attempt, which keys the order in orders_by_customer
+	var existing time.Time
+	if err := o.Cassandra.Query(`SELECT created_at FROM orders WHERE id = ?`, orderId).WithContext(ctx).Scan(&existing); err == nil && !existing.IsZero() {
+		createdAt = existing
+	} else if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return fmt.Errorf("failed to read order: %w", err)
+	}
+	updatedAt := time.Now()
 
-	// ✅ Insert into orders table
+	// rates are kept by currency pair, e.g. EUR/USD
+	exchangeRates := make(map[string]*inf.Dec, len(rates))
+	for _, rate := range rates {
+		value, err := money.ParseRate(rate.Rate)
+		if err != nil {
+			return err
+		}
+		exchangeRates[rate.BaseCurrency+"/"+rate.QuoteCurrency] = value
+	}
 
-	orderQuery := `INSERT INTO orders (id, customer_id, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+	// ✅ Insert into orders table and the order history of the customer
 
-	err := o.Cassandra.Query(orderQuery, orderId, customerId, status, createdAt, updatedAt).WithContext(ctx).Exec()
-	if err != nil {
+	batch := o.Cassandra.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`INSERT INTO orders (id, customer_id, status, currency, exchange_rates, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		orderId, customerId, status, currency, exchangeRates, createdAt, updatedAt)
+	batch.Query(`INSERT INTO orders_by_customer (customer_id, created_at, order_id, status, currency, updated_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		customerId, createdAt, orderId, status, currency, updatedAt)
+	if err := o.Cassandra.ExecuteBatch(batch); err != nil {
 		return fmt.Errorf("failed to create order: %w", err)
 	}
 
 	// ✅ Insert into order_items table
 
 	for _, item := range items {
-		itemQuery := `INSERT INTO order_items (order_id, product_id, quantity, price) VALUES (?, ?, ?, ?)`
+		unitPrice, err := money.ToDecimal(item.UnitPriceMinor, currency)
+		if err != nil {
+			return err
+		}
+
+		itemQuery := `INSERT INTO order_items (order_id, product_id, quantity, unit_price, sku) VALUES (?, ?, ?, ?, ?)`
 
 		if err := o.Cassandra.Query(itemQuery,
 			orderId,
 			item.ProductId,
 			item.Quantity,
-			item.Price,
+			unitPrice,
+			item.Sku,
 		).WithContext(ctx).Exec(); err != nil {
 			return fmt.Errorf("failed to insert order item (product_id=%d): %w", item.ProductId, err)
 		}
 	}
 
+	logger.Activity(ctx).Info("order persisted", "customer_id", customerId, "items", len(items))
+	return nil
+}
+
+// ✅ Update the status of an existing order
+func (o *OrderActivity) UpdateOrderStatus(ctx context.Context, orderId int64, status string) error {
+	var customerId int64
+	var createdAt time.Time
+	if err := o.Cassandra.Query(`SELECT customer_id, created_at FROM orders WHERE id = ?`, orderId).WithContext(ctx).Scan(&customerId, &createdAt); err != nil {
+		return fmt.Errorf("failed to read order: %w", err)
+	}
+
+	updatedAt := time.Now()
+	batch := o.Cassandra.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`UPDATE orders SET status = ?, updated_at = ? WHERE id = ?`, status, updatedAt, orderId)
+	// an order without created_at has no key in orders_by_customer and is only found by scanning orders
+	if !createdAt.IsZero() {
+		batch.Query(`UPDATE orders_by_customer SET status = ?, updated_at = ? WHERE customer_id = ? AND created_at = ? AND order_id = ?`,
+			status, updatedAt, customerId, createdAt, orderId)
+	}
+	if err := o.Cassandra.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+
+	logger.Activity(ctx).Info("order status updated", "status", status)
 	return nil
 }
@@ -0,0 +1,129 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/payments"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+)
+
+// Error types of payment failures that retrying cannot fix.
+const (
+	ErrTypePaymentDeclined             = "PaymentDeclined"
+	ErrTypePaymentAuthenticationFailed = "PaymentAuthenticationFailed"
+)
+
+// ✅ Authorize payment for the order amount
+func (o *OrderActivity) AuthorizePayment(ctx context.Context, orderId, customerId, amountMinor int64, currency string) (*ordersv1.Payment, error) {
+	auth, err := o.Payments.Authorize(ctx, payments.AuthorizeRequest{
+		OrderID:     orderId,
+		CustomerID:  customerId,
+		AmountMinor: amountMinor,
+		Currency:    currency,
+	})
+	if err != nil {
+		return nil, paymentError(err)
+	}
+
+	payment := &ordersv1.Payment{
+		AuthorizationId: auth.ID,
+		Status:          paymentStatus(auth.Status),
+		AmountMinor:     amountMinor,
+		Currency:        currency,
+		ActionUrl:       auth.ActionURL,
+	}
+
+	logger.Activity(ctx).Info("payment authorization attempted", "authorization_id", auth.ID, "status", payment.Status.String())
+	return payment, nil
+}
+
+// ✅ Finish an authorization once the customer passed 3-D Secure
+func (o *OrderActivity) CompletePaymentAuthentication(ctx context.Context, payment *ordersv1.Payment) (*ordersv1.Payment, error) {
+	auth, err := o.Payments.CompleteAuthentication(ctx, payment.AuthorizationId)
+	if err != nil {
+		return nil, paymentError(err)
+	}
+
+	payment.Status = paymentStatus(auth.Status)
+	payment.ActionUrl = ""
+	return payment, nil
+}
+
+// ✅ Capture the authorized amount
+func (o *OrderActivity) CapturePayment(ctx context.Context, payment *ordersv1.Payment) error {
+	if err := o.Payments.Capture(ctx, payment.AuthorizationId, payment.AmountMinor); err != nil {
+		return fmt.Errorf("failed to capture payment %s: %w", payment.AuthorizationId, err)
+	}
+
+	logger.Activity(ctx).Info("payment captured", "authorization_id", payment.AuthorizationId)
+	return nil
+}
+
+// ✅ Release the hold on the customer's funds
+func (o *OrderActivity) VoidPayment(ctx context.Context, payment *ordersv1.Payment) error {
+	if err := o.Payments.Void(ctx, payment.AuthorizationId); err != nil {
+		return fmt.Errorf("failed to void payment %s: %w", payment.AuthorizationId, err)
+	}
+
+	logger.Activity(ctx).Info("payment voided", "authorization_id", payment.AuthorizationId)
+	return nil
+}
+
+// ✅ Refund the whole captured amount of a cancelled order
+func (o *OrderActivity) RefundCancelledOrder(ctx context.Context, orderId int64, payment *ordersv1.Payment) error {
+	// the order is the idempotency key, so a retry does not pay the refund out twice
+	refundID := fmt.Sprintf("cancel-%d", orderId)
+	if err := o.Payments.Refund(ctx, payment.AuthorizationId, refundID, payment.AmountMinor); err != nil {
+		return fmt.Errorf("failed to refund payment %s: %w", payment.AuthorizationId, err)
+	}
+
+	logger.Activity(ctx).Info("payment refunded", "authorization_id", payment.AuthorizationId, "amount_minor", payment.AmountMinor)
+	return nil
+}
+
+// ✅ Record the payment state on the order
+func (o *OrderActivity) RecordPayment(ctx context.Context, orderId int64, payment *ordersv1.Payment) error {
+	query := `UPDATE orders SET payment_authorization_id = ?, payment_status = ?, payment_amount_minor = ?, payment_currency = ?, updated_at = ? WHERE id = ?`
+
+	err := o.Cassandra.Query(query,
+		payment.AuthorizationId,
+		payment.Status.String(),
+		payment.AmountMinor,
+		payment.Currency,
+		time.Now(),
+		orderId,
+	).WithContext(ctx).Exec()
+	if err != nil {
+		return fmt.Errorf("failed to record payment: %w", err)
+	}
+
+	return nil
+}
+
+func paymentStatus(status payments.AuthorizationStatus) ordersv1.PaymentStatus {
+	switch status {
+	case payments.StatusAuthorized:
+		return ordersv1.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+	case payments.StatusRequiresAction:
+		return ordersv1.PaymentStatus_PAYMENT_STATUS_REQUIRES_ACTION
+	default:
+		return ordersv1.PaymentStatus_PAYMENT_STATUS_PENDING
+	}
+}
+
+// paymentError marks gateway refusals as non-retryable so the workflow fails fast.
+func paymentError(err error) error {
+	switch {
+	case errors.Is(err, payments.ErrDeclined):
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypePaymentDeclined, err)
+	case errors.Is(err, payments.ErrAuthenticationFailed):
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypePaymentAuthenticationFailed, err)
+	default:
+		return fmt.Errorf("payment gateway error: %w", err)
+	}
+}
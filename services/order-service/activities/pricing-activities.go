@@ -0,0 +1,105 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/inf.v0"
+)
+
+// ErrTypePricingFailed is the error type of PriceItems when the order cannot be
+// priced: a product is unknown, the currency is not supported or there is no
+// exchange rate into it.
+const ErrTypePricingFailed = "PricingFailed"
+
+// PricedItems are the items of an order priced in its currency.
+type PricedItems struct {
+	Currency string
+	Items    []*ordersv1.OrderItem
+	// Rates are the exchange rates the prices were converted at, one per currency converted from
+	Rates []*moneyv1.ExchangeRate
+}
+
+// ✅ Price the items in the currency of the order, or the default currency when it has none
+func (o *OrderActivity) PriceItems(ctx context.Context, currency string, items []*ordersv1.OrderItem) (*PricedItems, error) {
+	if currency == "" {
+		currency = o.Currency
+	}
+	if err := money.Validate(currency); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), ErrTypePricingFailed, err)
+	}
+
+	priced := &PricedItems{Currency: currency, Items: make([]*ordersv1.OrderItem, 0, len(items))}
+	pricer := o.Converter.Pricer(currency)
+	for _, item := range items {
+		var legacyPrice float64
+		var basePrice *inf.Dec
+		var baseCurrency string
+		var priceList map[string]*inf.Dec
+		var deletedAt time.Time
+		query := `SELECT price, base_price, currency, price_list, deleted_at FROM products WHERE id = ? LIMIT 1`
+		err := o.Cassandra.Query(query, item.ProductId).WithContext(ctx).Scan(&legacyPrice, &basePrice, &baseCurrency, &priceList, &deletedAt)
+		// deleted products can no longer be ordered
+		if errors.Is(err, gocql.ErrNotFound) || (err == nil && !deletedAt.IsZero()) {
+			msg := fmt.Sprintf("product %d not found", item.ProductId)
+			return nil, temporal.NewNonRetryableApplicationError(msg, ErrTypePricingFailed, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price of product %d: %w", item.ProductId, err)
+		}
+
+		if basePrice == nil {
+			// products stored before prices were decimals
+			if basePrice, err = legacyDecimal(legacyPrice, baseCurrency); err != nil {
+				return nil, temporal.NewNonRetryableApplicationError(err.Error(), ErrTypePricingFailed, err)
+			}
+		}
+
+		amount, err := pricer.Price(ctx, basePrice, baseCurrency, priceList)
+		if errors.Is(err, money.ErrRateNotFound) || errors.Is(err, money.ErrUnknownCurrency) {
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), ErrTypePricingFailed, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to price product %d: %w", item.ProductId, err)
+		}
+
+		priced.Items = append(priced.Items, &ordersv1.OrderItem{
+			ProductId:      item.ProductId,
+			Quantity:       item.Quantity,
+			UnitPriceMinor: amount,
+			Price:          money.ToFloat(amount, currency),
+			Backordered:    item.Backordered,
+			Sku:            item.Sku,
+		})
+	}
+
+	for _, rate := range pricer.Rates() {
+		priced.Rates = append(priced.Rates, &moneyv1.ExchangeRate{
+			BaseCurrency:  rate.Base,
+			QuoteCurrency: rate.Quote,
+			Rate:          rate.Rate.String(),
+			UpdatedAt:     timestamppb.New(rate.UpdatedAt),
+		})
+	}
+
+	logger.Activity(ctx).Info("items priced", "currency", currency, "items", len(items), "rates", len(priced.Rates))
+	return priced, nil
+}
+
+// legacyDecimal turns a floating point price into the decimal it would be stored as now.
+func legacyDecimal(price float64, currency string) (*inf.Dec, error) {
+	amount, err := money.FromFloat(price, currency)
+	if err != nil {
+		return nil, err
+	}
+	return money.ToDecimal(amount, currency)
+}
@@ -0,0 +1,78 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/promotions"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+)
+
+// ErrTypeCouponRejected is the error type of ApplyCoupons when a coupon is
+// unknown, does not apply to the order or was used up by the customer.
+const ErrTypeCouponRejected = "CouponRejected"
+
+// ✅ Evaluate the coupons of the order and redeem them for the customer
+func (o *OrderActivity) ApplyCoupons(ctx context.Context, orderId, customerId int64, codes []string, items []*ordersv1.OrderItem, currency string) ([]*ordersv1.Discount, error) {
+	coupons, err := o.Promotions.GetCoupons(ctx, codes)
+	if err != nil {
+		return nil, couponError(err)
+	}
+	for _, c := range coupons {
+		// amounts of coupons created before coupons had a currency are in the default one
+		if c.Currency == "" {
+			c.Currency = o.Currency
+		}
+	}
+
+	discounts, err := promotions.Evaluate(coupons, items, currency, time.Now())
+	if err != nil {
+		return nil, couponError(err)
+	}
+
+	for i, c := range coupons {
+		if err := o.Promotions.Redeem(ctx, c.Code, customerId, orderId, c.PerCustomerLimit); err != nil {
+			// give back what this order already took, so a retry or another order can use it
+			if releaseErr := o.ReleaseCoupons(ctx, orderId, customerId, codes[:i]); releaseErr != nil {
+				logger.Activity(ctx).Error("failed to release coupons", "error", releaseErr)
+			}
+			return nil, couponError(err)
+		}
+	}
+
+	logger.Activity(ctx).Info("coupons applied", "coupons", len(coupons), "discounts", len(discounts))
+	return discounts, nil
+}
+
+// ✅ Give back the coupon uses of an order that did not go through
+func (o *OrderActivity) ReleaseCoupons(ctx context.Context, orderId, customerId int64, codes []string) error {
+	for _, code := range codes {
+		if err := o.Promotions.Release(ctx, code, customerId, orderId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ✅ Record the discount lines of a persisted order
+func (o *OrderActivity) RecordDiscounts(ctx context.Context, orderId int64, discounts []*ordersv1.Discount) error {
+	query := `INSERT INTO order_discounts (order_id, coupon_code, description, product_id, amount_minor) VALUES (?, ?, ?, ?, ?)`
+	for _, d := range discounts {
+		if err := o.Cassandra.Query(query, orderId, d.CouponCode, d.Description, d.ProductId, d.AmountMinor).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to record discount of coupon %s: %w", d.CouponCode, err)
+		}
+	}
+	return nil
+}
+
+// couponError makes the errors retrying cannot fix non-retryable.
+func couponError(err error) error {
+	if errors.Is(err, promotions.ErrCouponNotFound) || errors.Is(err, promotions.ErrCouponRejected) || errors.Is(err, promotions.ErrUsageLimitReached) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeCouponRejected, err)
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/activity"
+)
+
+// PurgeActivity hard-deletes products once they have been deleted for longer
+// than the retention period.
+type PurgeActivity struct {
+	Cassandra *gocql.Session
+	Ledger    *ledger.Ledger
+}
+
+// PurgeCandidate is a product deleted before the retention period began.
+type PurgeCandidate struct {
+	ProductID int64
+	// DeletedAt guards the purge against the product being restored meanwhile
+	DeletedAt time.Time
+	// ParentID and Sku are set for a variant, whose SKU is freed when it is purged
+	ParentID int64
+	Sku      string
+}
+
+// ✅ List the products deleted before a point in time
+func (p *PurgeActivity) ListPurgeableProducts(ctx context.Context, deletedBefore time.Time) ([]PurgeCandidate, error) {
+	iter := p.Cassandra.Query(`SELECT id, deleted_at, parent_id, sku FROM products`).WithContext(ctx).Iter()
+
+	var candidates []PurgeCandidate
+	var productId, parentId int64
+	var deletedAt time.Time
+	var sku string
+	for iter.Scan(&productId, &deletedAt, &parentId, &sku) {
+		if !deletedAt.IsZero() && deletedAt.Before(deletedBefore) {
+			candidates = append(candidates, PurgeCandidate{ProductID: productId, DeletedAt: deletedAt, ParentID: parentId, Sku: sku})
+		}
+		activity.RecordHeartbeat(ctx, productId)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+	return candidates, nil
+}
+
+// ✅ Find which of the products any order refers to
+func (p *PurgeActivity) ProductsInOrders(ctx context.Context, productIds []int64) ([]int64, error) {
+	wanted := make(map[int64]bool, len(productIds))
+	for _, id := range productIds {
+		wanted[id] = false
+	}
+
+	// order items are keyed by order, so every item is read
+	iter := p.Cassandra.Query(`SELECT order_id, product_id FROM order_items`).WithContext(ctx).Iter()
+	var orderId, productId int64
+	for iter.Scan(&orderId, &productId) {
+		if _, ok := wanted[productId]; ok {
+			wanted[productId] = true
+		}
+		activity.RecordHeartbeat(ctx, orderId)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read order items: %w", err)
+	}
+
+	var referenced []int64
+	for _, id := range productIds {
+		if wanted[id] {
+			referenced = append(referenced, id)
+		}
+	}
+	return referenced, nil
+}
+
+// ✅ Hard-delete a product, its stock and its stock ledger, and free the SKU of a variant, unless it was restored
+func (p *PurgeActivity) PurgeProduct(ctx context.Context, candidate PurgeCandidate) (bool, error) {
+	query := `DELETE FROM products WHERE id = ? IF deleted_at = ?`
+	applied, err := p.Cassandra.Query(query, candidate.ProductID, candidate.DeletedAt).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to purge product %d: %w", candidate.ProductID, err)
+	}
+	if !applied {
+		// a product that is still there was restored, or deleted again since it was listed;
+		// one that is gone was purged by an earlier attempt that did not finish
+		var id int64
+		err := p.Cassandra.Query(`SELECT id FROM products WHERE id = ?`, candidate.ProductID).WithContext(ctx).Scan(&id)
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, gocql.ErrNotFound) {
+			return false, fmt.Errorf("failed to read product %d: %w", candidate.ProductID, err)
+		}
+	}
+
+	if err := p.Cassandra.Query(`DELETE FROM inventory WHERE product_id = ?`, candidate.ProductID).WithContext(ctx).Exec(); err != nil {
+		return false, fmt.Errorf("failed to purge inventory of product %d: %w", candidate.ProductID, err)
+	}
+	if err := p.Ledger.Purge(ctx, candidate.ProductID); err != nil {
+		return false, err
+	}
+	if candidate.ParentID != 0 {
+		if err := p.Cassandra.Query(`DELETE FROM variants_by_product WHERE product_id = ? AND variant_id = ?`, candidate.ParentID, candidate.ProductID).WithContext(ctx).Exec(); err != nil {
+			return false, fmt.Errorf("failed to remove variant %d: %w", candidate.ProductID, err)
+		}
+		// conditional, in case a retry runs after another variant claimed the SKU
+		if _, err := p.Cassandra.Query(`DELETE FROM skus WHERE sku = ? IF variant_id = ?`, candidate.Sku, candidate.ProductID).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+			return false, fmt.Errorf("failed to free sku %q: %w", candidate.Sku, err)
+		}
+	}
+
+	logger.Activity(ctx).Info("product purged", "product_id", candidate.ProductID, "deleted_at", candidate.DeletedAt)
+	return true, nil
+}
@@ -0,0 +1,194 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/activity"
+)
+
+// ReconcileActivity checks products.stock against what orders and the stock ledger imply.
+type ReconcileActivity struct {
+	Cassandra *gocql.Session
+	Ledger    *ledger.Ledger
+}
+
+// StockDrift compares the stock of a product with the stock it should have.
+type StockDrift struct {
+	ProductID int64
+	Stock     int32
+	// Expected is the initial stock plus manual adjustments, returns and
+	// corrections, minus the items of confirmed orders.
+	Expected int32
+	// Confirmed is the items of confirmed orders, as summed from the orders.
+	Confirmed int32
+	Corrected bool
+}
+
+// Drift is how far the stock is off; positive when there is more than expected.
+func (d StockDrift) Drift() int32 {
+	return d.Stock - d.Expected
+}
+
+// ✅ Sum the items of every confirmed order per product
+func (r *ReconcileActivity) ConfirmedOrderQuantities(ctx context.Context) (map[int64]int32, error) {
+	// stock is confirmed just before the payment is captured, and given back when the capture fails
+	// or the order is cancelled, whose payment is then refunded
+	captured := ordersv1.PaymentStatus_PAYMENT_STATUS_CAPTURED.String()
+
+	iter := r.Cassandra.Query(`SELECT id, payment_status FROM orders`).WithContext(ctx).Iter()
+
+	var orderIds []int64
+	var orderId int64
+	var paymentStatus string
+	for iter.Scan(&orderId, &paymentStatus) {
+		if paymentStatus == captured {
+			orderIds = append(orderIds, orderId)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read orders: %w", err)
+	}
+
+	quantities := make(map[int64]int32)
+	for i, orderId := range orderIds {
+		itemIter := r.Cassandra.Query(`SELECT product_id, quantity FROM order_items WHERE order_id = ?`, orderId).WithContext(ctx).Iter()
+
+		var productId int64
+		var quantity int32
+		for itemIter.Scan(&productId, &quantity) {
+			quantities[productId] += quantity
+		}
+		if err := itemIter.Close(); err != nil {
+			return nil, fmt.Errorf("failed to read items of order %d: %w", orderId, err)
+		}
+		activity.RecordHeartbeat(ctx, i)
+	}
+
+	logger.Activity(ctx).Info("confirmed orders summed", "orders", len(orderIds), "products", len(quantities))
+	return quantities, nil
+}
+
+// ✅ Compare the stock of every product with the stock it should have
+func (r *ReconcileActivity) ComputeStockDrift(ctx context.Context, confirmed map[int64]int32) ([]StockDrift, error) {
+	iter := r.Cassandra.Query(`SELECT id, stock FROM products`).WithContext(ctx).Iter()
+
+	var drifts []StockDrift
+	var productId int64
+	var stock int32
+	for iter.Scan(&productId, &stock) {
+		balances, err := r.Ledger.BalanceByReason(ctx, productId)
+		if err != nil {
+			_ = iter.Close()
+			return nil, err
+		}
+
+		// order movements are what is being checked, so they come from the orders instead
+		expected := -confirmed[productId]
+		for reason, balance := range balances {
+			if !isOrderMovement(reason) {
+				expected += balance
+			}
+		}
+
+		if stock != expected {
+			drifts = append(drifts, StockDrift{ProductID: productId, Stock: stock, Expected: expected, Confirmed: confirmed[productId]})
+		}
+		activity.RecordHeartbeat(ctx, productId)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+
+	return drifts, nil
+}
+
+func isOrderMovement(reason string) bool {
+	return reason == ledger.ReasonOrderConfirmed || reason == ledger.ReasonOrderReverted
+}
+
+// ✅ Set the stock of a product to what it should be, unless it changed since it was checked
+func (r *ReconcileActivity) CorrectStock(ctx context.Context, drift StockDrift) (bool, error) {
+	log := logger.Activity(ctx)
+
+	balances, err := r.Ledger.BalanceByReason(ctx, drift.ProductID)
+	if err != nil {
+		return false, err
+	}
+	// products stocked before the ledger existed have no initial stock in it,
+	// so what they are expected to hold means nothing
+	if _, ok := balances[ledger.ReasonInitialStock]; !ok {
+		log.Info("stock not corrected, the product has no initial stock in the ledger", "product_id", drift.ProductID)
+		return false, nil
+	}
+
+	// orders were summed before the stock was read, so an order confirmed or
+	// given back in between shows up as drift; it is in the ledger by now
+	var ordered int32
+	for reason, balance := range balances {
+		if isOrderMovement(reason) {
+			ordered -= balance
+		}
+	}
+	if ordered != drift.Confirmed {
+		log.Info("stock not corrected, orders changed since the check", "product_id", drift.ProductID, "confirmed", drift.Confirmed, "ledger", ordered)
+		return false, nil
+	}
+
+	// an order that is being confirmed has taken the stock but not yet written the ledger
+	iter := r.Cassandra.Query(`SELECT confirmed_at FROM stock_reservations WHERE product_id = ?`, drift.ProductID).WithContext(ctx).Iter()
+	confirming := false
+	var confirmedAt time.Time
+	for iter.Scan(&confirmedAt) {
+		confirming = confirming || !confirmedAt.IsZero()
+	}
+	if err := iter.Close(); err != nil {
+		return false, fmt.Errorf("failed to read reservations of product %d: %w", drift.ProductID, err)
+	}
+	if confirming {
+		log.Info("stock not corrected, an order is being confirmed", "product_id", drift.ProductID)
+		return false, nil
+	}
+
+	query := `UPDATE products SET stock = ? WHERE id = ? IF stock = ?`
+	applied, err := r.Cassandra.Query(query, drift.Expected, drift.ProductID, drift.Stock).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return false, fmt.Errorf("failed to correct stock of product %d: %w", drift.ProductID, err)
+	}
+	if !applied {
+		return false, nil
+	}
+
+	err = r.Ledger.Record(ctx, ledger.Movement{
+		ProductID: drift.ProductID,
+		Delta:     -drift.Drift(),
+		Reason:    ledger.ReasonReconciliation,
+		Actor:     "reconcile-workflow",
+	})
+	if err != nil {
+		return false, err
+	}
+
+	log.Info("stock corrected", "product_id", drift.ProductID, "from", drift.Stock, "to", drift.Expected)
+	return true, nil
+}
+
+// ✅ Write the drift found by a reconciliation run
+func (r *ReconcileActivity) WriteDriftReport(ctx context.Context, runId string, drifts []StockDrift) error {
+	checkedAt := time.Now()
+	query := `INSERT INTO stock_drift_reports (run_id, product_id, stock, expected, drift, corrected, checked_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	for _, d := range drifts {
+		if err := r.Cassandra.Query(query, runId, d.ProductID, d.Stock, d.Expected, d.Drift(), d.Corrected, checkedAt).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to write drift of product %d: %w", d.ProductID, err)
+		}
+	}
+
+	logger.Activity(ctx).Info("drift report written", "run_id", runId, "products", len(drifts))
+	return nil
+}
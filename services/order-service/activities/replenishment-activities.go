@@ -0,0 +1,142 @@
+package activities
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+)
+
+// ReplenishmentWorkflowName is the registered name of workflows.ReplenishmentWorkflow;
+// activities cannot import the workflows package, so it is started by name.
+const ReplenishmentWorkflowName = "ReplenishmentWorkflow"
+
+// SignalLowStock carries a LowStockAlert to the replenishment workflow of a product.
+const SignalLowStock = "low-stock"
+
+// ReplenishmentWorkflowID is the workflow ID of the replenishment of a product.
+// There is at most one running per product, so repeated alerts land in the same run.
+func ReplenishmentWorkflowID(productId int64) string {
+	return fmt.Sprintf("replenish-%d", productId)
+}
+
+// LowStockAlert reports that the available stock of a product fell below its reorder threshold.
+type LowStockAlert struct {
+	ProductID       int64
+	Available       int
+	Threshold       int32
+	ReorderQuantity int32
+}
+
+// PurchaseOrder asks for more stock of a product.
+type PurchaseOrder struct {
+	ID        string    `json:"id"`
+	ProductID int64     `json:"product_id"`
+	Quantity  int32     `json:"quantity"`
+	Available int       `json:"available"`
+	Threshold int32     `json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ✅ Alert the replenishment workflow of every ordered product that fell below its reorder threshold
+func (o *OrderActivity) CheckReorderPoints(ctx context.Context, items []*ordersv1.OrderItem) error {
+	for _, item := range items {
+		var threshold, quantity int32
+		query := `SELECT reorder_threshold, reorder_quantity FROM products WHERE id = ? LIMIT 1`
+		if err := o.Cassandra.Query(query, item.ProductId).WithContext(ctx).Scan(&threshold, &quantity); err != nil {
+			return fmt.Errorf("failed to read reorder policy of product %d: %w", item.ProductId, err)
+		}
+		if threshold <= 0 {
+			continue
+		}
+
+		level, err := o.stockLevel(ctx, item.ProductId)
+		if err != nil {
+			return err
+		}
+		if level.Available >= int(threshold) {
+			continue
+		}
+
+		alert := LowStockAlert{
+			ProductID:       item.ProductId,
+			Available:       level.Available,
+			Threshold:       threshold,
+			ReorderQuantity: quantity,
+		}
+		// signal-with-start joins a running replenishment instead of starting a second one
+		_, err = o.Temporal.SignalWithStartWorkflow(ctx, ReplenishmentWorkflowID(item.ProductId), SignalLowStock, alert, client.StartWorkflowOptions{
+			TaskQueue:             activity.GetInfo(ctx).TaskQueue,
+			WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		}, ReplenishmentWorkflowName, item.ProductId, nil)
+		if err != nil {
+			return fmt.Errorf("failed to alert replenishment of product %d: %w", item.ProductId, err)
+		}
+
+		logger.Activity(ctx).Warn("stock below reorder threshold", "product_id", item.ProductId, "available", level.Available, "threshold", threshold)
+	}
+	return nil
+}
+
+// ✅ Get the current stock level of a product
+func (o *OrderActivity) GetStockLevel(ctx context.Context, productId int64) (StockLevel, error) {
+	return o.stockLevel(ctx, productId)
+}
+
+// ✅ Record a purchase order and send it to the replenishment webhook, or log it when none is configured
+func (o *OrderActivity) CreatePurchaseOrder(ctx context.Context, po PurchaseOrder) error {
+	// the ID is the replenishment run, so a retried activity overwrites the same row
+	query := `INSERT INTO purchase_orders (id, product_id, quantity, available, threshold, status, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	if err := o.Cassandra.Query(query, po.ID, po.ProductID, po.Quantity, po.Available, po.Threshold, "open", po.CreatedAt).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record purchase order: %w", err)
+	}
+
+	log := logger.Activity(ctx)
+	if o.Replenishment.WebhookURL == "" {
+		log.Warn("purchase order created", "purchase_order_id", po.ID, "product_id", po.ProductID, "quantity", po.Quantity)
+		return nil
+	}
+
+	body, err := json.Marshal(po)
+	if err != nil {
+		return fmt.Errorf("failed to encode purchase order: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.Replenishment.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// lets the receiver drop deliveries repeated by activity retries
+	req.Header.Set("Idempotency-Key", po.ID)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send purchase order: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("replenishment webhook returned %s", resp.Status)
+	}
+
+	log.Info("purchase order sent", "purchase_order_id", po.ID, "product_id", po.ProductID, "quantity", po.Quantity)
+	return nil
+}
+
+// ✅ Close a purchase order once stock is back above the threshold, or it expired
+func (o *OrderActivity) ClosePurchaseOrder(ctx context.Context, id string, status string) error {
+	query := `UPDATE purchase_orders SET status = ?, closed_at = ? WHERE id = ?`
+	if err := o.Cassandra.Query(query, status, time.Now(), id).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to close purchase order %s: %w", id, err)
+	}
+	return nil
+}
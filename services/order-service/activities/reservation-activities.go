@@ -0,0 +1,298 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+)
+
+// StockLevel is the stock of a product. OnHand is what the warehouse holds;
+// Available is what is left for new orders once active reservations are held back.
+type StockLevel struct {
+	ProductID int64
+	OnHand    int
+	Available int
+}
+
+func (o *OrderActivity) stockLevel(ctx context.Context, productId int64) (StockLevel, error) {
+	level := StockLevel{ProductID: productId}
+
+	query := `SELECT stock FROM products WHERE id = ? LIMIT 1`
+	if err := o.Cassandra.Query(query, productId).WithContext(ctx).Scan(&level.OnHand); err != nil {
+		return level, fmt.Errorf("product %d not found: %w", productId, err)
+	}
+
+	// expired reservations drop out of the table with their TTL
+	var held int
+	reservedQuery := `SELECT SUM(quantity) FROM stock_reservations WHERE product_id = ?`
+	if err := o.Cassandra.Query(reservedQuery, productId).WithContext(ctx).Scan(&held); err != nil {
+		return level, fmt.Errorf("failed to sum reservations of product %d: %w", productId, err)
+	}
+
+	level.Available = level.OnHand - held
+	return level, nil
+}
+
+// ✅ Hold stock for the order until it is confirmed, released or the hold expires
+func (o *OrderActivity) ReserveStock(ctx context.Context, orderId int64, items []*ordersv1.OrderItem, ttl time.Duration) error {
+	expiresAt := time.Now().Add(ttl)
+
+	for i, item := range items {
+		query := `INSERT INTO stock_reservations (product_id, order_id, quantity, expires_at) VALUES (?, ?, ?, ?) USING TTL ?`
+		if err := o.Cassandra.Query(query, item.ProductId, orderId, item.Quantity, expiresAt, int(ttl.Seconds())).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed reserving stock for product %d: %w", item.ProductId, err)
+		}
+
+		// the hold is written before it is checked, so concurrent orders see
+		// each other and at worst both back off instead of overselling
+		level, err := o.stockLevel(ctx, item.ProductId)
+		if err == nil && level.Available < 0 {
+			// retrying cannot help; the workflow decides whether to wait for stock
+			msg := fmt.Sprintf("insufficient stock for product %d", item.ProductId)
+			err = temporal.NewNonRetryableApplicationError(msg, ErrTypeInsufficientStock, nil)
+		}
+		if err != nil {
+			if releaseErr := o.ReleaseReservation(ctx, orderId, items[:i+1]); releaseErr != nil {
+				logger.Activity(ctx).Error("failed to release partial reservation", "error", releaseErr)
+			}
+			return err
+		}
+
+		logger.Activity(ctx).Info("stock reserved", "product_id", item.ProductId, "quantity", item.Quantity, "available", level.Available)
+	}
+	return nil
+}
+
+// ✅ Turn the order's holds into a permanent stock decrement at the allocated warehouses
+func (o *OrderActivity) ConfirmReservation(ctx context.Context, orderId int64, items []*ordersv1.OrderItem, shipments []*ordersv1.Shipment) error {
+	for _, item := range items {
+		var c stockConfirmation
+		quantity, confirmedAt, err := o.claimReservation(ctx, orderId, item.ProductId)
+		switch {
+		case errors.Is(err, gocql.ErrNotFound):
+			// without its hold, the stock counts as confirmed only when it was taken
+			c, err = o.stockConfirmation(ctx, orderId, item.ProductId)
+			if errors.Is(err, gocql.ErrNotFound) {
+				msg := fmt.Sprintf("hold of product %d expired before it was confirmed", item.ProductId)
+				return temporal.NewNonRetryableApplicationError(msg, ErrTypeInsufficientStock, nil)
+			}
+			if err != nil {
+				return err
+			}
+			if c.applied {
+				continue
+			}
+			// the hold expired while an earlier attempt was taking the stock; finish it
+		case err != nil:
+			return err
+		default:
+			c = stockConfirmation{productId: item.ProductId, quantity: quantity, deltas: make(map[string]int32), confirmedAt: confirmedAt}
+			for _, shipment := range shipments {
+				for _, line := range shipment.Items {
+					if line.ProductId == item.ProductId {
+						c.deltas[shipment.WarehouseId] -= line.Quantity
+					}
+				}
+			}
+			// recorded before the stock is taken, so a retry can finish it after the hold is gone
+			confirmQuery := `INSERT INTO stock_confirmations (order_id, product_id, quantity, deltas, confirmed_at) VALUES (?, ?, ?, ?, ?)`
+			if err := o.Cassandra.Query(confirmQuery, orderId, c.productId, c.quantity, c.deltas, c.confirmedAt).WithContext(ctx).Exec(); err != nil {
+				return fmt.Errorf("failed to record confirmation of product %d: %w", item.ProductId, err)
+			}
+		}
+
+		// every write is keyed by the order, so an attempt that failed halfway
+		// is completed by the retry instead of being applied twice
+		key := fmt.Sprintf("order-%d", orderId)
+
+		// decrement before dropping the hold so the stock is never counted as free twice
+		if err := o.adjustLocationStock(ctx, item.ProductId, c.deltas, key); err != nil {
+			if errors.Is(err, errInsufficientLocationStock) {
+				// the allocation went stale; the workflow allocates again
+				return temporal.NewNonRetryableApplicationError(err.Error(), ErrTypeAllocationConflict, err)
+			}
+			return fmt.Errorf("failed confirming stock for product %d: %w", item.ProductId, err)
+		}
+		if err := o.adjustStock(ctx, item.ProductId, -c.quantity, key); err != nil {
+			return fmt.Errorf("failed confirming stock for product %d: %w", item.ProductId, err)
+		}
+		for location, delta := range c.deltas {
+			err := o.Ledger.Record(ctx, ledger.Movement{
+				ProductID:  item.ProductId,
+				LocationID: location,
+				Delta:      delta,
+				Reason:     ledger.ReasonOrderConfirmed,
+				OrderID:    orderId,
+				Actor:      "order-workflow",
+				OccurredAt: c.confirmedAt,
+				Key:        key,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		appliedQuery := `UPDATE stock_confirmations SET applied = true WHERE order_id = ? AND product_id = ?`
+		if err := o.Cassandra.Query(appliedQuery, orderId, item.ProductId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to record confirmation of product %d: %w", item.ProductId, err)
+		}
+
+		deleteQuery := `DELETE FROM stock_reservations WHERE product_id = ? AND order_id = ?`
+		if err := o.Cassandra.Query(deleteQuery, item.ProductId, orderId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to delete reservation of product %d: %w", item.ProductId, err)
+		}
+	}
+
+	logger.Activity(ctx).Info("stock reservation confirmed", "items", len(items), "shipments", len(shipments))
+	return nil
+}
+
+// stockConfirmation is what ConfirmReservation takes from stock for a product of an order.
+type stockConfirmation struct {
+	productId   int64
+	quantity    int
+	deltas      map[string]int32
+	confirmedAt time.Time
+	applied     bool
+}
+
+func (o *OrderActivity) stockConfirmation(ctx context.Context, orderId, productId int64) (stockConfirmation, error) {
+	c := stockConfirmation{productId: productId}
+	query := `SELECT quantity, deltas, confirmed_at, applied FROM stock_confirmations WHERE order_id = ? AND product_id = ?`
+	err := o.Cassandra.Query(query, orderId, productId).WithContext(ctx).Scan(&c.quantity, &c.deltas, &c.confirmedAt, &c.applied)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return c, fmt.Errorf("failed to read confirmation of product %d: %w", productId, err)
+	}
+	return c, err
+}
+
+// ✅ Give back the stock confirmed for an order that failed before it was paid, or was cancelled after
+func (o *OrderActivity) RevertConfirmation(ctx context.Context, orderId int64) error {
+	query := `SELECT product_id, quantity, deltas, applied FROM stock_confirmations WHERE order_id = ?`
+	iter := o.Cassandra.Query(query, orderId).WithContext(ctx).Iter()
+
+	var confirmations []stockConfirmation
+	var c stockConfirmation
+	for iter.Scan(&c.productId, &c.quantity, &c.deltas, &c.applied) {
+		// a confirmation that never took the stock has nothing to give back
+		if c.applied {
+			confirmations = append(confirmations, c)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return fmt.Errorf("failed to read stock confirmations of order %d: %w", orderId, err)
+	}
+
+	// keyed like the confirmation, so a retry does not give the stock back twice
+	key := fmt.Sprintf("order-%d-reverted", orderId)
+	for _, c := range confirmations {
+		revertedAt, err := o.claimRevert(ctx, orderId, c.productId)
+		if err != nil {
+			return err
+		}
+
+		deltas := make(map[string]int32, len(c.deltas))
+		for location, delta := range c.deltas {
+			deltas[location] = -delta
+		}
+		if err := o.adjustLocationStock(ctx, c.productId, deltas, key); err != nil {
+			return fmt.Errorf("failed reverting stock of product %d: %w", c.productId, err)
+		}
+		if err := o.adjustStock(ctx, c.productId, c.quantity, key); err != nil {
+			return fmt.Errorf("failed reverting stock of product %d: %w", c.productId, err)
+		}
+		for location, delta := range deltas {
+			err := o.Ledger.Record(ctx, ledger.Movement{
+				ProductID:  c.productId,
+				LocationID: location,
+				Delta:      delta,
+				Reason:     ledger.ReasonOrderReverted,
+				OrderID:    orderId,
+				Actor:      "order-workflow",
+				OccurredAt: revertedAt,
+				Key:        key,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, c := range confirmations {
+		o.wakeBackorders(ctx, c.productId)
+	}
+	logger.Activity(ctx).Info("stock confirmation reverted", "items", len(confirmations))
+	return nil
+}
+
+// claimRevert records when the confirmation of a product was first reverted
+// and returns that time on every later call.
+func (o *OrderActivity) claimRevert(ctx context.Context, orderId, productId int64) (time.Time, error) {
+	// timestamps are stored in milliseconds, so the time is truncated to match what is read back
+	now := time.Now().Truncate(time.Millisecond)
+	claim := `UPDATE stock_confirmations SET reverted_at = ? WHERE order_id = ? AND product_id = ? IF reverted_at = null`
+	existing := map[string]interface{}{}
+	applied, err := o.Cassandra.Query(claim, now, orderId, productId).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to revert confirmation of product %d: %w", productId, err)
+	}
+	if applied {
+		return now, nil
+	}
+	revertedAt, _ := existing["reverted_at"].(time.Time)
+	return revertedAt, nil
+}
+
+// claimReservation marks a hold as being confirmed and returns its quantity and
+// when it was first claimed, which every attempt to confirm it records in the ledger.
+func (o *OrderActivity) claimReservation(ctx context.Context, orderId, productId int64) (int, time.Time, error) {
+	var quantity, ttl int
+	var confirmedAt time.Time
+	query := `SELECT quantity, confirmed_at, TTL(quantity) FROM stock_reservations WHERE product_id = ? AND order_id = ?`
+	err := o.Cassandra.Query(query, productId, orderId).WithContext(ctx).Scan(&quantity, &confirmedAt, &ttl)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return 0, time.Time{}, err
+	}
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to read reservation of product %d: %w", productId, err)
+	}
+	if !confirmedAt.IsZero() {
+		return quantity, confirmedAt, nil
+	}
+
+	// the claim expires along with the hold; a TTL of 0 is none. Timestamps are
+	// stored in milliseconds, so the time is truncated to read back the same.
+	confirmedAt = time.Now().Truncate(time.Millisecond)
+	claim := `UPDATE stock_reservations USING TTL ? SET confirmed_at = ? WHERE product_id = ? AND order_id = ? IF quantity = ? AND confirmed_at = null`
+	applied, err := o.Cassandra.Query(claim, ttl, confirmedAt, productId, orderId, quantity).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("failed to claim reservation of product %d: %w", productId, err)
+	}
+	if !applied {
+		// a concurrent attempt claimed or confirmed it first
+		return o.claimReservation(ctx, orderId, productId)
+	}
+	return quantity, confirmedAt, nil
+}
+
+// ✅ Drop the order's holds so the stock is available again
+func (o *OrderActivity) ReleaseReservation(ctx context.Context, orderId int64, items []*ordersv1.OrderItem) error {
+	for _, item := range items {
+		query := `DELETE FROM stock_reservations WHERE product_id = ? AND order_id = ?`
+		if err := o.Cassandra.Query(query, item.ProductId, orderId).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to release reservation of product %d: %w", item.ProductId, err)
+		}
+		// the released stock may be what a backordered order waits for
+		o.wakeBackorders(ctx, item.ProductId)
+	}
+
+	logger.Activity(ctx).Info("stock reservation released", "items", len(items))
+	return nil
+}
@@ -0,0 +1,253 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/inf.v0"
+)
+
+// ErrTypeOrderNotFound is the error type of GetOrder when no order has the ID.
+const ErrTypeOrderNotFound = "OrderNotFound"
+
+// ✅ Load a persisted order with its items and payment
+func (o *OrderActivity) GetOrder(ctx context.Context, orderId int64) (*ordersv1.Order, error) {
+	var (
+		customerId                               int64
+		status, returnStatus                     string
+		authorizationId, paymentStatus, currency string
+		orderCurrency                            string
+		amountMinor, taxMinor                    int64
+		shipping, billing                        map[string]string
+		createdAt, updatedAt                     time.Time
+	)
+
+	query := `SELECT customer_id, status, currency, return_status, payment_authorization_id, payment_status, payment_amount_minor, payment_currency, tax_minor, shipping_address, billing_address, created_at, updated_at FROM orders WHERE id = ?`
+
+	err := o.Cassandra.Query(query, orderId).WithContext(ctx).Scan(
+		&customerId, &status, &orderCurrency, &returnStatus, &authorizationId, &paymentStatus, &amountMinor, &currency, &taxMinor, &shipping, &billing, &createdAt, &updatedAt,
+	)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("order %d not found", orderId), ErrTypeOrderNotFound, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+
+	// orders placed before they had a currency were paid in the default one
+	if orderCurrency == "" {
+		orderCurrency = o.Currency
+	}
+
+	order := &ordersv1.Order{
+		OrderId:         orderId,
+		CustomerId:      customerId,
+		Currency:        orderCurrency,
+		Status:          ordersv1.OrderStatus(ordersv1.OrderStatus_value[status]),
+		ReturnStatus:    ordersv1.ReturnStatus(ordersv1.ReturnStatus_value[returnStatus]),
+		TaxMinor:        taxMinor,
+		ShippingAddress: columnAddress(shipping),
+		BillingAddress:  columnAddress(billing),
+		CreatedAt:       timestamppb.New(createdAt),
+		UpdatedAt:       timestamppb.New(updatedAt),
+	}
+	if authorizationId != "" {
+		order.Payment = &ordersv1.Payment{
+			AuthorizationId: authorizationId,
+			Status:          ordersv1.PaymentStatus(ordersv1.PaymentStatus_value[paymentStatus]),
+			AmountMinor:     amountMinor,
+			Currency:        currency,
+		}
+	}
+
+	// ✅ Load the order items
+
+	itemQuery := `SELECT product_id, quantity, price, unit_price, sku FROM order_items WHERE order_id = ?`
+	scanner := o.Cassandra.Query(itemQuery, orderId).WithContext(ctx).Iter().Scanner()
+
+	for scanner.Next() {
+		var item ordersv1.OrderItem
+		var legacyPrice float64
+		var unitPrice *inf.Dec
+		if err := scanner.Scan(&item.ProductId, &item.Quantity, &legacyPrice, &unitPrice, &item.Sku); err != nil {
+			return nil, fmt.Errorf("failed to get order items: %w", err)
+		}
+
+		// items stored before prices were decimals only have the floating point price
+		if unitPrice != nil {
+			item.UnitPriceMinor, err = money.FromDecimal(unitPrice, orderCurrency)
+		} else {
+			item.UnitPriceMinor, err = money.FromFloat(legacyPrice, orderCurrency)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get price of product %d: %w", item.ProductId, err)
+		}
+		item.Price = money.ToFloat(item.UnitPriceMinor, orderCurrency)
+		order.Items = append(order.Items, &item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get order items: %w", err)
+	}
+
+	return order, nil
+}
+
+// ReturnedItems is what earlier returns of an order took back.
+type ReturnedItems struct {
+	// Quantities is the quantity returned per product.
+	Quantities map[int64]int32
+	// RefundAmountMinor is the total refunded, or about to be, for them.
+	RefundAmountMinor int64
+}
+
+// ✅ Sum up the items of the returns of an order that did not expire
+func (o *OrderActivity) GetReturnedItems(ctx context.Context, orderId int64) (*ReturnedItems, error) {
+	query := `SELECT items, refund_amount_minor, expired_at FROM order_returns WHERE order_id = ?`
+	iter := o.Cassandra.Query(query, orderId).WithContext(ctx).Iter()
+
+	returned := &ReturnedItems{Quantities: make(map[int64]int32)}
+	var items map[int64]int32
+	var refundAmountMinor int64
+	var expiredAt time.Time
+	for iter.Scan(&items, &refundAmountMinor, &expiredAt) {
+		if !expiredAt.IsZero() {
+			continue
+		}
+		for productId, quantity := range items {
+			returned.Quantities[productId] += quantity
+		}
+		returned.RefundAmountMinor += refundAmountMinor
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read returns of order %d: %w", orderId, err)
+	}
+	return returned, nil
+}
+
+// ✅ Record the items and refund of an accepted return
+func (o *OrderActivity) RecordReturn(ctx context.Context, orderId int64, returnId string, items []*ordersv1.OrderItem, refundAmountMinor int64) error {
+	quantities := make(map[int64]int32, len(items))
+	for _, item := range items {
+		quantities[item.ProductId] += item.Quantity
+	}
+
+	query := `UPDATE order_returns SET items = ?, refund_amount_minor = ? WHERE order_id = ? AND return_id = ?`
+	if err := o.Cassandra.Query(query, quantities, refundAmountMinor, orderId, returnId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record return %s: %w", returnId, err)
+	}
+	return nil
+}
+
+// ✅ Mark a return whose items never arrived, which gives them back to later returns
+func (o *OrderActivity) ExpireReturn(ctx context.Context, orderId int64, returnId string) error {
+	_, err := o.claimReturnStep(ctx, orderId, returnId, "expired_at")
+	return err
+}
+
+// ✅ Put returned items back into stock at the default warehouse, which receives returns
+func (o *OrderActivity) RestockItems(ctx context.Context, orderId int64, returnId string, items []*ordersv1.OrderItem) error {
+	restockedAt, err := o.claimReturnStep(ctx, orderId, returnId, "restocked_at")
+	if err != nil {
+		return err
+	}
+
+	// the stock changes are keyed by the return, so a retry completes an attempt
+	// that failed halfway and replaying a finished restock changes nothing
+	key := "return-" + returnId
+	location := o.defaultLocation()
+	for _, item := range items {
+		if location != "" {
+			if err := o.adjustLocationStock(ctx, item.ProductId, map[string]int32{location: item.Quantity}, key); err != nil {
+				return fmt.Errorf("failed restocking product %d at %s: %w", item.ProductId, location, err)
+			}
+		}
+		if err := o.adjustStock(ctx, item.ProductId, int(item.Quantity), key); err != nil {
+			return fmt.Errorf("failed restocking product %d: %w", item.ProductId, err)
+		}
+		err := o.Ledger.Record(ctx, ledger.Movement{
+			ProductID:  item.ProductId,
+			LocationID: location,
+			Delta:      item.Quantity,
+			Reason:     ledger.ReasonReturnRestock,
+			OrderID:    orderId,
+			Actor:      "return-workflow",
+			OccurredAt: restockedAt,
+			Key:        key,
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.Activity(ctx).Info("stock returned", "product_id", item.ProductId, "quantity", item.Quantity)
+		o.wakeBackorders(ctx, item.ProductId)
+	}
+	return nil
+}
+
+// ✅ Refund part or all of the captured payment
+func (o *OrderActivity) RefundPayment(ctx context.Context, orderId int64, returnId string, payment *ordersv1.Payment, amountMinor int64) error {
+	var refundedAt time.Time
+	query := `SELECT refunded_at FROM order_returns WHERE order_id = ? AND return_id = ?`
+	err := o.Cassandra.Query(query, orderId, returnId).WithContext(ctx).Scan(&refundedAt)
+	if err != nil && !errors.Is(err, gocql.ErrNotFound) {
+		return fmt.Errorf("failed to read return of order %d: %w", orderId, err)
+	}
+	if !refundedAt.IsZero() {
+		logger.Activity(ctx).Info("payment already refunded", "authorization_id", payment.AuthorizationId)
+		return nil
+	}
+
+	// the return is the idempotency key, so a refund that reached the gateway
+	// before the attempt failed is not paid out again
+	if err := o.Payments.Refund(ctx, payment.AuthorizationId, "return-"+returnId, amountMinor); err != nil {
+		return fmt.Errorf("failed to refund payment %s: %w", payment.AuthorizationId, err)
+	}
+	if _, err := o.claimReturnStep(ctx, orderId, returnId, "refunded_at"); err != nil {
+		return err
+	}
+
+	logger.Activity(ctx).Info("payment refunded", "authorization_id", payment.AuthorizationId, "amount_minor", amountMinor)
+	return nil
+}
+
+// claimReturnStep records when a step of a return, restocked_at, refunded_at or
+// expired_at, first happened, and returns that time on every later call.
+func (o *OrderActivity) claimReturnStep(ctx context.Context, orderId int64, returnId, column string) (time.Time, error) {
+	// timestamps are stored in milliseconds, so the time is truncated to match what is read back
+	now := time.Now().Truncate(time.Millisecond)
+	claim := `UPDATE order_returns SET ` + column + ` = ? WHERE order_id = ? AND return_id = ? IF ` + column + ` = null`
+	existing := map[string]interface{}{}
+	applied, err := o.Cassandra.Query(claim, now, orderId, returnId).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to record %s of return %s: %w", column, returnId, err)
+	}
+	if applied {
+		return now, nil
+	}
+	claimed, ok := existing[column].(time.Time)
+	if !ok {
+		return time.Time{}, fmt.Errorf("return %s has no %s", returnId, column)
+	}
+	return claimed, nil
+}
+
+// ✅ Record the return status on the order
+func (o *OrderActivity) UpdateReturnStatus(ctx context.Context, orderId int64, status string, refundAmountMinor int64) error {
+	query := `UPDATE orders SET return_status = ?, refund_amount_minor = ?, updated_at = ? WHERE id = ?`
+
+	if err := o.Cassandra.Query(query, status, refundAmountMinor, time.Now(), orderId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to update return status: %w", err)
+	}
+
+	logger.Activity(ctx).Info("return status updated", "status", status)
+	return nil
+}
@@ -0,0 +1,50 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+)
+
+// ✅ Work out the tax on the items of an order after its discounts
+func (o *OrderActivity) CalculateTax(ctx context.Context, orderId int64, address *ordersv1.Address, items []*ordersv1.OrderItem, discounts []*ordersv1.Discount) ([]*ordersv1.TaxLine, error) {
+	categories := make(map[int64]string, len(items))
+	for _, item := range items {
+		var category string
+		query := `SELECT tax_category FROM products WHERE id = ?`
+		if err := o.Cassandra.Query(query, item.ProductId).WithContext(ctx).Scan(&category); err != nil {
+			return nil, fmt.Errorf("failed to read tax category of product %d: %w", item.ProductId, err)
+		}
+		categories[item.ProductId] = category
+	}
+
+	lines, err := o.Tax.Calculate(ctx, tax.Request{
+		OrderID: orderId,
+		Address: address,
+		Lines:   tax.Lines(items, discounts, categories),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate tax: %w", err)
+	}
+
+	logger.Activity(ctx).Info("tax calculated", "lines", len(lines), "country", address.GetCountry())
+	return lines, nil
+}
+
+// ✅ Record the tax lines and total tax of a persisted order
+func (o *OrderActivity) RecordTax(ctx context.Context, orderId int64, lines []*ordersv1.TaxLine, taxMinor int64) error {
+	query := `INSERT INTO order_tax_lines (order_id, product_id, category, jurisdiction, rate_bps, inclusive, taxable_minor, tax_minor) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	for _, line := range lines {
+		if err := o.Cassandra.Query(query, orderId, line.ProductId, line.Category, line.Jurisdiction, line.RateBps, line.Inclusive, line.TaxableMinor, line.TaxMinor).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to record tax of product %d: %w", line.ProductId, err)
+		}
+	}
+
+	if err := o.Cassandra.Query(`UPDATE orders SET tax_minor = ? WHERE id = ?`, taxMinor, orderId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to record order tax: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Package backorders wakes the orders that wait for a product once its stock
+// grows. It is shared by the order activities and the product service, which
+// both change stock.
+package backorders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+)
+
+// SignalStockReplenished tells a backordered order that one of its products, sent as the payload, is back in stock.
+const SignalStockReplenished = "stock-replenished"
+
+// OrderWorkflowID is the workflow ID of the order workflow of an order.
+func OrderWorkflowID(orderId int64) string {
+	return fmt.Sprintf("order-%d", orderId)
+}
+
+// Notifier signals the orders registered in the backorders table.
+type Notifier struct {
+	session  *gocql.Session
+	temporal client.Client
+	table    string
+}
+
+// NewNotifier returns a notifier that reads the backorders table in keyspace,
+// or in the session's keyspace when keyspace is empty.
+func NewNotifier(session *gocql.Session, temporal client.Client, keyspace string) *Notifier {
+	table := "backorders"
+	if keyspace != "" {
+		table = keyspace + "." + table
+	}
+	return &Notifier{session: session, temporal: temporal, table: table}
+}
+
+// Notify signals every order waiting for a product and returns how many it reached.
+func (n *Notifier) Notify(ctx context.Context, productId int64) (int, error) {
+	iter := n.session.Query(`SELECT order_id FROM `+n.table+` WHERE product_id = ?`, productId).WithContext(ctx).Iter()
+
+	var orderIds []int64
+	var orderId int64
+	for iter.Scan(&orderId) {
+		orderIds = append(orderIds, orderId)
+	}
+	if err := iter.Close(); err != nil {
+		return 0, fmt.Errorf("failed to read backorders of product %d: %w", productId, err)
+	}
+
+	notified := 0
+	for _, orderId := range orderIds {
+		err := n.temporal.SignalWorkflow(ctx, OrderWorkflowID(orderId), "", SignalStockReplenished, productId)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			// the order closed without clearing its backorder, e.g. it was terminated
+			continue
+		}
+		if err != nil {
+			return notified, fmt.Errorf("failed to signal order %d: %w", orderId, err)
+		}
+		notified++
+	}
+	return notified, nil
+}
@@ -0,0 +1,80 @@
+// Command backfill writes the orders_by_customer row of every order placed
+// before that table existed. Rows that already exist are left alone, so it is
+// safe to run while the order service is up, and to run more than once.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+)
+
+func main() {
+	cfg := pkg.Config{}
+
+	if err := cfg.LoadConfig("config.yaml"); err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger.New("backfill", cfg.Logging))
+
+	if err := godotenv.Load(); err != nil {
+		slog.Error("failed to load .env file", "error", err)
+		os.Exit(1)
+	}
+
+	astraCfg := &database.AstraConfig{
+		Username: cfg.Database.Username,
+		Path:     cfg.Database.Path,
+		Token:    helpers.GetEnvOrDefault("ASTRA_TOKEN", ""),
+	}
+
+	db := database.NewAstraDB()
+	session, err := db.Connect(context.Background(), astraCfg, 30*time.Second)
+	if err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer session.Close()
+
+	ctx := context.Background()
+	iter := session.Query(`SELECT id, customer_id, status, currency, created_at, updated_at FROM orders`).WithContext(ctx).Iter()
+
+	var written, skipped int
+	var orderId, customerId int64
+	var status, currency string
+	var createdAt, updatedAt time.Time
+	for iter.Scan(&orderId, &customerId, &status, &currency, &createdAt, &updatedAt) {
+		// created_at keys the row, so an order without it cannot be listed
+		if createdAt.IsZero() {
+			slog.Warn("order has no created_at, skipped", "order_id", orderId)
+			skipped++
+			continue
+		}
+
+		// a status change racing the backfill writes the row itself, with the newer status
+		insert := `INSERT INTO orders_by_customer (customer_id, created_at, order_id, status, currency, updated_at) VALUES (?, ?, ?, ?, ?, ?) IF NOT EXISTS`
+		applied, err := session.Query(insert, customerId, createdAt, orderId, status, currency, updatedAt).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+		if err != nil {
+			slog.Error("failed to backfill order", "order_id", orderId, "error", err)
+			os.Exit(1)
+		}
+		if applied {
+			written++
+		}
+	}
+	if err := iter.Close(); err != nil {
+		slog.Error("failed to read orders", "error", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("backfilled %d orders, skipped %d without created_at\n", written, skipped)
+}
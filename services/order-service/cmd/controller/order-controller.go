@@ -2,14 +2,22 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
 	"github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1/ordersv1connect"
+	customers "github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/promotions"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	products "github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -17,11 +25,21 @@ import (
 type OrderController struct {
 	ordersv1connect.UnimplementedOrderServiceHandler
 	orderRepository *repository.OrderRepository
+	history         *repository.HistoryRepository
+	coupons         *promotions.Store
+	taxRates        *tax.RateStore
+	addresses       *customers.AddressRepository
+	skus            *products.SkuRepository
 }
 
-func NewOrderController(orderRepository *repository.OrderRepository) *OrderController {
+func NewOrderController(orderRepository *repository.OrderRepository, history *repository.HistoryRepository, coupons *promotions.Store, taxRates *tax.RateStore, addresses *customers.AddressRepository, skus *products.SkuRepository) *OrderController {
 	return &OrderController{
 		orderRepository: orderRepository,
+		history:         history,
+		coupons:         coupons,
+		taxRates:        taxRates,
+		addresses:       addresses,
+		skus:            skus,
 	}
 }
 
@@ -29,6 +47,30 @@ func (c *OrderController) CreateOrder(ctx context.Context, req *connect.Request[
 	if req.Msg.CustomerId <= 0 || len(req.Msg.Items) == 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id and items are required"))
 	}
+	if wait := req.Msg.MaxBackorderWait; wait != nil && (wait.AsDuration() < 0 || wait.AsDuration() > workflows.MaxBackorderWait) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_backorder_wait must be between 0 and %s", workflows.MaxBackorderWait))
+	}
+	if (req.Msg.ShippingAddressId != 0 && req.Msg.ShippingAddress != nil) || (req.Msg.BillingAddressId != 0 && req.Msg.BillingAddress != nil) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("give either an address or its address book id, not both"))
+	}
+	if err := tax.ValidateAddresses(req.Msg.ShippingAddress, req.Msg.BillingAddress); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Currency != "" {
+		if err := money.Validate(req.Msg.Currency); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	if err := c.resolveSkus(ctx, req.Msg.Items); err != nil {
+		return nil, err
+	}
+
+	// copied onto the order so that later edits to the address book do not change it
+	shipping, billing, err := c.addresses.OrderAddresses(ctx, req.Msg.CustomerId, req.Msg.ShippingAddressId, req.Msg.BillingAddressId, req.Msg.ShippingAddress, req.Msg.BillingAddress)
+	if err != nil {
+		return nil, orderError(err)
+	}
 
 	orderId, err := snowflake.GenerateID()
 	if err != nil {
@@ -39,17 +81,24 @@ func (c *OrderController) CreateOrder(ctx context.Context, req *connect.Request[
 
 	// create order obj
 	order := &v1.Order{
-		OrderId:    int64(orderId),
-		CustomerId: req.Msg.CustomerId,
-		Items:      req.Msg.Items,
-		Status:     v1.OrderStatus_ORDER_STATUS_CREATED,
-		CreatedAt:  timestamppb.New(now),
-		UpdatedAt:  nil,
+		OrderId:          int64(orderId),
+		CustomerId:       req.Msg.CustomerId,
+		Items:            req.Msg.Items,
+		ShipTo:           req.Msg.ShipTo,
+		MaxBackorderWait: req.Msg.MaxBackorderWait,
+		CouponCodes:      req.Msg.CouponCodes,
+		ShippingAddress:  shipping,
+		BillingAddress:   billing,
+		Currency:         req.Msg.Currency,
+		Status:           v1.OrderStatus_ORDER_STATUS_CREATED,
+		CreatedAt:        timestamppb.New(now),
+		UpdatedAt:        nil,
 	}
 
-	// save order to db
-	if err := c.orderRepository.CreateOrder(ctx, order); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed saving order: %w", err))
+	// save order to db; a backordered order comes back before it is persisted
+	order, err = c.orderRepository.CreateOrder(ctx, order)
+	if err != nil {
+		return nil, orderError(fmt.Errorf("failed saving order: %w", err))
 	}
 
 	// build the response
@@ -60,3 +109,295 @@ func (c *OrderController) CreateOrder(ctx context.Context, req *connect.Request[
 	return connect.NewResponse(resp), nil
 
 }
+
+func (c *OrderController) UpdateOrder(ctx context.Context, req *connect.Request[v1.UpdateOrderRequest]) (*connect.Response[v1.UpdateOrderResponse], error) {
+	if req.Msg.OrderId <= 0 || req.Msg.Status == v1.OrderStatus_ORDER_STATUS_UNSPECIFIED {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("order_id and status are required"))
+	}
+
+	if len(req.Msg.Items) > 0 {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("updating order items is not supported"))
+	}
+
+	order, err := c.orderRepository.UpdateOrder(ctx, &v1.Order{
+		OrderId: req.Msg.OrderId,
+		Status:  req.Msg.Status,
+	})
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	// the workflow applies the new status asynchronously
+	order.Status = req.Msg.Status
+	order.UpdatedAt = timestamppb.New(time.Now())
+
+	return connect.NewResponse(&v1.UpdateOrderResponse{
+		Order: order,
+	}), nil
+}
+
+// defaultOrdersPageSize and maxOrdersPageSize bound a page of ListCustomerOrders.
+const (
+	defaultOrdersPageSize = 20
+	maxOrdersPageSize     = 100
+)
+
+func (c *OrderController) ListCustomerOrders(ctx context.Context, req *connect.Request[v1.ListCustomerOrdersRequest]) (*connect.Response[v1.ListCustomerOrdersResponse], error) {
+	if req.Msg.CustomerId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required"))
+	}
+	if _, ok := v1.OrderStatus_name[int32(req.Msg.Status)]; !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown status %d", req.Msg.Status))
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultOrdersPageSize
+	}
+	pageSize = min(pageSize, maxOrdersPageSize)
+
+	pageState, err := base64.URLEncoding.DecodeString(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+	}
+
+	orders, next, err := c.history.ListCustomerOrders(ctx, req.Msg.CustomerId, req.Msg.Status, pageSize, pageState)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.ListCustomerOrdersResponse{
+		Orders:        orders,
+		NextPageToken: base64.URLEncoding.EncodeToString(next),
+	}), nil
+}
+
+func (c *OrderController) WatchOrder(ctx context.Context, req *connect.Request[v1.WatchOrderRequest], stream *connect.ServerStream[v1.OrderEvent]) error {
+	if req.Msg.OrderId <= 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("order_id is required"))
+	}
+
+	err := c.orderRepository.WatchOrder(ctx, req.Msg.OrderId, req.Msg.CustomerId, stream.Send)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return connect.NewError(connect.CodeCanceled, err)
+		}
+		return orderError(err)
+	}
+
+	return nil
+}
+
+func (c *OrderController) CompletePaymentAuthentication(ctx context.Context, req *connect.Request[v1.CompletePaymentAuthenticationRequest]) (*connect.Response[v1.CompletePaymentAuthenticationResponse], error) {
+	if req.Msg.OrderId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("order_id is required"))
+	}
+
+	order, err := c.orderRepository.CompletePaymentAuthentication(ctx, req.Msg.OrderId, req.Msg.CustomerId, req.Msg.Authenticated)
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return connect.NewResponse(&v1.CompletePaymentAuthenticationResponse{
+		Order: order,
+	}), nil
+}
+
+func (c *OrderController) RequestReturn(ctx context.Context, req *connect.Request[v1.RequestReturnRequest]) (*connect.Response[v1.RequestReturnResponse], error) {
+	if req.Msg.OrderId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("order_id is required"))
+	}
+
+	ret, err := c.orderRepository.RequestReturn(ctx, &v1.OrderReturn{
+		OrderId:     req.Msg.OrderId,
+		CustomerId:  req.Msg.CustomerId,
+		Items:       req.Msg.Items,
+		Reason:      req.Msg.Reason,
+		RequestedAt: timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	return connect.NewResponse(&v1.RequestReturnResponse{
+		OrderReturn: ret,
+	}), nil
+}
+
+func (c *OrderController) ReceiveReturn(ctx context.Context, req *connect.Request[v1.ReceiveReturnRequest]) (*connect.Response[v1.ReceiveReturnResponse], error) {
+	if req.Msg.OrderId <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("order_id is required"))
+	}
+
+	ret, err := c.orderRepository.ReceiveReturn(ctx, req.Msg.OrderId)
+	if err != nil {
+		return nil, orderError(err)
+	}
+
+	// the workflow restocks and refunds asynchronously
+	ret.Status = v1.ReturnStatus_RETURN_STATUS_RECEIVED
+	ret.UpdatedAt = timestamppb.New(time.Now())
+
+	return connect.NewResponse(&v1.ReceiveReturnResponse{
+		OrderReturn: ret,
+	}), nil
+}
+
+// resolveSkus points the items ordered by SKU at their variants, which are
+// stocked and priced under their own product ids.
+func (c *OrderController) resolveSkus(ctx context.Context, items []*v1.OrderItem) error {
+	for _, item := range items {
+		if item.Sku == "" {
+			continue
+		}
+		item.Sku = strings.ToUpper(strings.TrimSpace(item.Sku))
+		_, variantId, err := c.skus.Resolve(ctx, item.Sku)
+		if err != nil {
+			if errors.Is(err, products.ErrSkuNotFound) {
+				return connect.NewError(connect.CodeNotFound, err)
+			}
+			return connect.NewError(connect.CodeInternal, err)
+		}
+		if item.ProductId != 0 && item.ProductId != variantId {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("sku %q is not product %d", item.Sku, item.ProductId))
+		}
+		item.ProductId = variantId
+	}
+	return nil
+}
+
+// orderError maps repository errors to connect errors.
+func orderError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrOrderNotFound), errors.Is(err, repository.ErrReturnNotFound), errors.Is(err, customers.ErrAddressNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrInvalidStatusTransition), errors.Is(err, repository.ErrReturnRejected), errors.Is(err, repository.ErrCouponRejected),
+		errors.Is(err, repository.ErrPricingFailed), errors.Is(err, repository.ErrPaymentNotPending):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, repository.ErrReturnExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrInvalidItems):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (c *OrderController) CreateCoupon(ctx context.Context, req *connect.Request[v1.CreateCouponRequest]) (*connect.Response[v1.CreateCouponResponse], error) {
+	if req.Msg.Coupon == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("coupon is required"))
+	}
+	if err := promotions.Validate(req.Msg.Coupon); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := c.coupons.CreateCoupon(ctx, req.Msg.Coupon); err != nil {
+		return nil, couponError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateCouponResponse{
+		Coupon: req.Msg.Coupon,
+	}), nil
+}
+
+func (c *OrderController) GetCoupon(ctx context.Context, req *connect.Request[v1.GetCouponRequest]) (*connect.Response[v1.GetCouponResponse], error) {
+	if req.Msg.Code == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("code is required"))
+	}
+
+	coupon, err := c.coupons.GetCoupon(ctx, req.Msg.Code)
+	if err != nil {
+		return nil, couponError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCouponResponse{
+		Coupon: coupon,
+	}), nil
+}
+
+func (c *OrderController) ListCoupons(ctx context.Context, req *connect.Request[v1.ListCouponsRequest]) (*connect.Response[v1.ListCouponsResponse], error) {
+	coupons, err := c.coupons.ListCoupons(ctx)
+	if err != nil {
+		return nil, couponError(err)
+	}
+
+	return connect.NewResponse(&v1.ListCouponsResponse{
+		Coupons: coupons,
+	}), nil
+}
+
+func (c *OrderController) DeactivateCoupon(ctx context.Context, req *connect.Request[v1.DeactivateCouponRequest]) (*connect.Response[v1.DeactivateCouponResponse], error) {
+	if req.Msg.Code == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("code is required"))
+	}
+
+	if err := c.coupons.Deactivate(ctx, req.Msg.Code); err != nil {
+		return nil, couponError(err)
+	}
+
+	coupon, err := c.coupons.GetCoupon(ctx, req.Msg.Code)
+	if err != nil {
+		return nil, couponError(err)
+	}
+
+	return connect.NewResponse(&v1.DeactivateCouponResponse{
+		Coupon: coupon,
+	}), nil
+}
+
+// couponError maps coupon store errors to Connect codes.
+func couponError(err error) error {
+	switch {
+	case errors.Is(err, promotions.ErrCouponNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, promotions.ErrCouponExists):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (c *OrderController) SetTaxRate(ctx context.Context, req *connect.Request[v1.SetTaxRateRequest]) (*connect.Response[v1.SetTaxRateResponse], error) {
+	if req.Msg.Rate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("rate is required"))
+	}
+	if err := tax.Validate(req.Msg.Rate); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := c.taxRates.SetRate(ctx, req.Msg.Rate); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.SetTaxRateResponse{
+		Rate: req.Msg.Rate,
+	}), nil
+}
+
+func (c *OrderController) ListTaxRates(ctx context.Context, req *connect.Request[v1.ListTaxRatesRequest]) (*connect.Response[v1.ListTaxRatesResponse], error) {
+	rates, err := c.taxRates.ListRates(ctx, req.Msg.Country)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.ListTaxRatesResponse{
+		Rates: rates,
+	}), nil
+}
+
+func (c *OrderController) DeleteTaxRate(ctx context.Context, req *connect.Request[v1.DeleteTaxRateRequest]) (*connect.Response[v1.DeleteTaxRateResponse], error) {
+	if req.Msg.Country == "" || req.Msg.Category == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("country and category are required"))
+	}
+
+	if err := c.taxRates.DeleteRate(ctx, req.Msg.Country, req.Msg.Region, req.Msg.Category); err != nil {
+		if errors.Is(err, tax.ErrRateNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.DeleteTaxRateResponse{
+		Deleted: true,
+	}), nil
+}
@@ -11,15 +11,26 @@ import (
 	"syscall"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/joho/godotenv"
 	"github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1/ordersv1connect"
+	customers "github.com/yaninyzwitty/temporal-microservice-go/services/customer-service/repository"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/cmd/controller"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/promotions"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
+	products "github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
 	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ratelimit"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/tracing"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/workflow"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -33,6 +44,7 @@ func main() {
 		slog.Error("failed to load config", "error", err)
 		os.Exit(1)
 	}
+	slog.SetDefault(logger.New("order-service", cfg.Logging))
 	if err := snowflake.InitSonyFlake(); err != nil {
 		slog.Error("failed to initialize snowflake", "error", err)
 		os.Exit(1)
@@ -43,6 +55,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	shutdownTracer, err := tracing.InitTracer("order-service", cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			slog.Error("failed to shutdown tracing", "error", err)
+		}
+	}()
+
 	astraCfg := &database.AstraConfig{
 		Username: cfg.Database.Username,
 		Path:     cfg.Database.Path,
@@ -57,7 +80,17 @@ func main() {
 	}
 	defer session.Close()
 
-	temporalClient, err := client.Dial(client.Options{})
+	temporalInterceptor, err := tracing.TemporalInterceptor()
+	if err != nil {
+		slog.Error("failed to create temporal tracing interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	temporalClient, err := client.Dial(client.Options{
+		Logger:             logger.NewTemporalLogger(slog.Default()),
+		Interceptors:       []interceptor.ClientInterceptor{temporalInterceptor},
+		ContextPropagators: []workflow.ContextPropagator{logger.NewCorrelationPropagator()},
+	})
 	if err != nil {
 		slog.Error("Unable to create client", "error", err)
 		os.Exit(1)
@@ -65,13 +98,33 @@ func main() {
 
 	defer temporalClient.Close()
 
-	orderServiceAddr := fmt.Sprintf("localhost:%d", cfg.ProductServer.Port)
+	orderServiceAddr := fmt.Sprintf("localhost:%d", cfg.OrderServer.Port)
 	orderRepository := repository.NewOrderRepository(temporalClient)
-	orderController := controller.NewOrderController(orderRepository)
+	orderController := controller.NewOrderController(orderRepository, repository.NewHistoryRepository(session), promotions.NewStore(session), tax.NewRateStore(session), customers.NewAddressRepository(session), products.NewSkuRepository(session))
 
 	mux := http.NewServeMux()
 
-	orderPath, orderHandler := ordersv1connect.NewOrderServiceHandler(orderController)
+	otelInterceptor, err := tracing.ConnectInterceptor()
+	if err != nil {
+		slog.Error("failed to create otel interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	authInterceptor, err := auth.NewInterceptor(cfg.Auth)
+	if err != nil {
+		slog.Error("failed to create auth interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// rate limits are hot-reloaded when config.yaml changes
+	rateLimiter := ratelimit.New("order-service", cfg.RateLimit)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go pkg.WatchConfig(watchCtx, "config.yaml", 10*time.Second, func(c *pkg.Config) {
+		rateLimiter.Update(c.RateLimit)
+	})
+
+	orderPath, orderHandler := ordersv1connect.NewOrderServiceHandler(orderController, connect.WithInterceptors(otelInterceptor, logger.NewInterceptor(), authInterceptor, rateLimiter))
 	mux.Handle(orderPath, orderHandler)
 
 	server := &http.Server{
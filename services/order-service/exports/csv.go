@@ -0,0 +1,78 @@
+package exports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddressesCSV writes the address book of a customer, one address per row.
+func AddressesCSV(addresses []*customersv1.Address) ([]byte, error) {
+	rows := [][]string{{"address_id", "label", "recipient", "line1", "line2", "city", "region", "postal_code", "country", "phone", "is_default", "created_at"}}
+	for _, a := range addresses {
+		rows = append(rows, []string{
+			strconv.FormatInt(a.Id, 10),
+			a.Label,
+			a.Recipient,
+			a.Line1,
+			a.Line2,
+			a.City,
+			a.Region,
+			a.PostalCode,
+			a.Country,
+			a.Phone,
+			strconv.FormatBool(a.IsDefault),
+			timestamp(a.CreatedAt),
+		})
+	}
+	return writeCSV(rows)
+}
+
+// OrdersCSV writes the order history of a customer, one order item per row.
+// Orders without items still get a row of their own.
+func OrdersCSV(orders []*ordersv1.Order) ([]byte, error) {
+	rows := [][]string{{"order_id", "status", "currency", "created_at", "product_id", "sku", "quantity", "unit_price_minor", "line_total_minor"}}
+	for _, order := range orders {
+		head := []string{
+			strconv.FormatInt(order.OrderId, 10),
+			order.Status.String(),
+			order.Currency,
+			timestamp(order.CreatedAt),
+		}
+		if len(order.Items) == 0 {
+			rows = append(rows, append(head, "", "", "", "", ""))
+			continue
+		}
+		for _, item := range order.Items {
+			rows = append(rows, append(append([]string(nil), head...),
+				strconv.FormatInt(item.ProductId, 10),
+				item.Sku,
+				strconv.FormatInt(int64(item.Quantity), 10),
+				strconv.FormatInt(item.UnitPriceMinor, 10),
+				strconv.FormatInt(item.UnitPriceMinor*int64(item.Quantity), 10),
+			))
+		}
+	}
+	return writeCSV(rows)
+}
+
+func writeCSV(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func timestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339)
+}
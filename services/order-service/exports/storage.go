@@ -0,0 +1,57 @@
+// Package exports writes customer data exports and the files they are made of.
+package exports
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+)
+
+// Storage keeps export files and hands out handles to download them.
+type Storage interface {
+	// Put stores data under key, replacing any file already there, and returns its handle.
+	Put(ctx context.Context, key string, data []byte) (string, error)
+}
+
+// NewStorage returns the storage selected in config.
+func NewStorage(cfg pkg.Exports) (Storage, error) {
+	switch cfg.Storage {
+	case "", "local":
+		return NewLocalStorage(cfg.Directory), nil
+	default:
+		return nil, fmt.Errorf("unknown export storage %q", cfg.Storage)
+	}
+}
+
+// LocalStorage writes export files below a directory and hands out file:// handles.
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage returns a storage writing below dir, the working directory when empty.
+func NewLocalStorage(dir string) *LocalStorage {
+	return &LocalStorage{dir: dir}
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, data []byte) (string, error) {
+	path, err := filepath.Abs(filepath.Join(s.dir, filepath.FromSlash(key)))
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	// write to a temporary file first so a retried activity never leaves half a file behind
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return "", fmt.Errorf("failed to write export file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return "", fmt.Errorf("failed to write export file: %w", err)
+	}
+	return "file://" + filepath.ToSlash(path), nil
+}
@@ -0,0 +1,158 @@
+package inventory
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+)
+
+// Allocation strategies.
+const (
+	// StrategyNearest ships from the warehouses closest to the delivery address first.
+	StrategyNearest = "nearest"
+	// StrategyCheapest ships from the warehouses with the lowest shipping cost first.
+	StrategyCheapest = "cheapest"
+	// StrategyFewestSplits ships from as few warehouses as possible.
+	StrategyFewestSplits = "fewest_splits"
+)
+
+// Stock is the on-hand quantity of each product at each warehouse.
+type Stock map[int64]map[string]int32
+
+// Allocate assigns the items to warehouses with the given strategy and returns
+// one shipment per warehouse used. An item is split across warehouses when no
+// single one holds enough of it.
+func Allocate(strategy string, warehouses []pkg.Warehouse, stock Stock, items []*ordersv1.OrderItem, shipTo *ordersv1.GeoPoint) ([]*ordersv1.Shipment, error) {
+	if len(warehouses) == 0 {
+		return nil, fmt.Errorf("no warehouses configured")
+	}
+
+	ranked := slices.Clone(warehouses)
+	switch strategy {
+	case StrategyNearest:
+		if shipTo != nil {
+			slices.SortStableFunc(ranked, func(a, b pkg.Warehouse) int {
+				return cmp.Compare(distanceKm(a, shipTo), distanceKm(b, shipTo))
+			})
+		}
+	case StrategyCheapest:
+		slices.SortStableFunc(ranked, func(a, b pkg.Warehouse) int {
+			return cmp.Compare(a.ShippingCostMinor, b.ShippingCostMinor)
+		})
+	case StrategyFewestSplits, "":
+	default:
+		return nil, fmt.Errorf("unknown allocation strategy %q", strategy)
+	}
+
+	// copy the stock so allocating does not modify the caller's view
+	remaining := make(Stock, len(stock))
+	for productId, levels := range stock {
+		remaining[productId] = make(map[string]int32, len(levels))
+		for location, quantity := range levels {
+			remaining[productId][location] = quantity
+		}
+	}
+
+	var shipments []*ordersv1.Shipment
+	assign := func(warehouseId string, item *ordersv1.OrderItem, quantity int32) {
+		remaining[item.ProductId][warehouseId] -= quantity
+
+		i := slices.IndexFunc(shipments, func(s *ordersv1.Shipment) bool { return s.WarehouseId == warehouseId })
+		if i < 0 {
+			shipments = append(shipments, &ordersv1.Shipment{WarehouseId: warehouseId})
+			i = len(shipments) - 1
+		}
+		shipments[i].Items = append(shipments[i].Items, &ordersv1.OrderItem{
+			ProductId:      item.ProductId,
+			Quantity:       quantity,
+			Price:          item.Price,
+			UnitPriceMinor: item.UnitPriceMinor,
+		})
+	}
+
+	pending := slices.Clone(items)
+
+	if strategy == StrategyFewestSplits || strategy == "" {
+		// repeatedly ship whole items from the warehouse that can fill the most of them
+		for len(pending) > 0 {
+			best, bestCount := "", 0
+			for _, w := range ranked {
+				count := 0
+				for _, item := range pending {
+					if remaining[item.ProductId][w.ID] >= item.Quantity {
+						count++
+					}
+				}
+				if count > bestCount {
+					best, bestCount = w.ID, count
+				}
+			}
+			if bestCount == 0 {
+				break
+			}
+
+			pending = slices.DeleteFunc(pending, func(item *ordersv1.OrderItem) bool {
+				if remaining[item.ProductId][best] < item.Quantity {
+					return false
+				}
+				assign(best, item, item.Quantity)
+				return true
+			})
+		}
+
+		// what is left must be split; take from the warehouses holding the most first
+		for _, item := range pending {
+			byStock := slices.Clone(ranked)
+			slices.SortStableFunc(byStock, func(a, b pkg.Warehouse) int {
+				return cmp.Compare(remaining[item.ProductId][b.ID], remaining[item.ProductId][a.ID])
+			})
+			if err := fill(byStock, remaining, item, assign); err != nil {
+				return nil, err
+			}
+		}
+		return shipments, nil
+	}
+
+	for _, item := range pending {
+		if err := fill(ranked, remaining, item, assign); err != nil {
+			return nil, err
+		}
+	}
+	return shipments, nil
+}
+
+// fill takes the item from the warehouses in order until its quantity is covered.
+func fill(warehouses []pkg.Warehouse, remaining Stock, item *ordersv1.OrderItem, assign func(string, *ordersv1.OrderItem, int32)) error {
+	needed := item.Quantity
+	for _, w := range warehouses {
+		if needed == 0 {
+			break
+		}
+		quantity := min(needed, remaining[item.ProductId][w.ID])
+		if quantity <= 0 {
+			continue
+		}
+		assign(w.ID, item, quantity)
+		needed -= quantity
+	}
+	if needed > 0 {
+		return fmt.Errorf("insufficient stock across warehouses for product %d", item.ProductId)
+	}
+	return nil
+}
+
+// distanceKm is the great-circle distance between a warehouse and a point.
+func distanceKm(w pkg.Warehouse, p *ordersv1.GeoPoint) float64 {
+	const earthRadiusKm = 6371
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(p.Latitude - w.Latitude)
+	dLon := toRad(p.Longitude - w.Longitude)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(w.Latitude))*math.Cos(toRad(p.Latitude))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
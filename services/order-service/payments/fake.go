@@ -0,0 +1,159 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+)
+
+// Outcomes the fake gateway can be configured to produce.
+const (
+	OutcomeApprove    = "approve"
+	OutcomeDecline    = "decline"
+	OutcomeTimeout    = "timeout"
+	OutcomeRequire3DS = "require_3ds"
+)
+
+// Terminal states the fake tracks after authorization.
+const (
+	statusCaptured AuthorizationStatus = "captured"
+	statusVoided   AuthorizationStatus = "voided"
+)
+
+type fakeAuthorization struct {
+	amountMinor   int64
+	refundedMinor int64
+	refunds       map[string]bool // IDs of the refunds made
+	status        AuthorizationStatus
+}
+
+// FakeGateway is a deterministic in-memory gateway for local use. Every
+// authorization gets the configured outcome and its ID is derived from the
+// order ID.
+type FakeGateway struct {
+	cfg pkg.FakePayments
+
+	mu             sync.Mutex
+	authorizations map[string]*fakeAuthorization
+}
+
+// NewFakeGateway returns a fake gateway producing the configured outcome.
+func NewFakeGateway(cfg pkg.FakePayments) *FakeGateway {
+	return &FakeGateway{cfg: cfg, authorizations: make(map[string]*fakeAuthorization)}
+}
+
+func (g *FakeGateway) Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error) {
+	id := fmt.Sprintf("fake_auth_%d", req.OrderID)
+
+	switch g.cfg.Outcome {
+	case OutcomeDecline:
+		return nil, ErrDeclined
+	case OutcomeTimeout:
+		// hang like an unresponsive provider until the caller gives up
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if auth, ok := g.authorizations[id]; ok {
+		return g.result(id, auth), nil
+	}
+
+	auth := &fakeAuthorization{amountMinor: req.AmountMinor, status: StatusAuthorized}
+	if g.cfg.Outcome == OutcomeRequire3DS {
+		auth.status = StatusRequiresAction
+	}
+	g.authorizations[id] = auth
+
+	return g.result(id, auth), nil
+}
+
+func (g *FakeGateway) CompleteAuthentication(_ context.Context, authorizationID string) (*Authorization, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[authorizationID]
+	if !ok {
+		return nil, ErrUnknownAuthorization
+	}
+	if auth.status == StatusRequiresAction {
+		auth.status = StatusAuthorized
+	}
+	return g.result(authorizationID, auth), nil
+}
+
+func (g *FakeGateway) Capture(_ context.Context, authorizationID string, amountMinor int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[authorizationID]
+	if !ok {
+		return ErrUnknownAuthorization
+	}
+
+	switch auth.status {
+	case statusCaptured:
+		return nil
+	case StatusAuthorized:
+		if amountMinor > auth.amountMinor {
+			return fmt.Errorf("capture of %d exceeds authorized amount %d", amountMinor, auth.amountMinor)
+		}
+		auth.status = statusCaptured
+		return nil
+	default:
+		return fmt.Errorf("cannot capture authorization in status %s", auth.status)
+	}
+}
+
+func (g *FakeGateway) Void(_ context.Context, authorizationID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[authorizationID]
+	if !ok {
+		// voiding something never authorized is a no-op
+		return nil
+	}
+	if auth.status == statusCaptured {
+		return fmt.Errorf("cannot void captured authorization %s", authorizationID)
+	}
+	auth.status = statusVoided
+	return nil
+}
+
+func (g *FakeGateway) Refund(_ context.Context, authorizationID, refundID string, amountMinor int64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[authorizationID]
+	if !ok {
+		return ErrUnknownAuthorization
+	}
+	if auth.refunds[refundID] {
+		return nil
+	}
+	if auth.status != statusCaptured {
+		return fmt.Errorf("cannot refund authorization in status %s", auth.status)
+	}
+	if auth.refundedMinor+amountMinor > auth.amountMinor {
+		return fmt.Errorf("refund of %d exceeds remaining captured amount %d", amountMinor, auth.amountMinor-auth.refundedMinor)
+	}
+	auth.refundedMinor += amountMinor
+	if auth.refunds == nil {
+		auth.refunds = make(map[string]bool)
+	}
+	auth.refunds[refundID] = true
+	return nil
+}
+
+func (g *FakeGateway) result(id string, auth *fakeAuthorization) *Authorization {
+	result := &Authorization{ID: id, Status: auth.status}
+	if result.Status == StatusRequiresAction {
+		result.ActionURL = "https://fake-payments.local/3ds/" + id
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+)
+
+var (
+	// ErrDeclined is returned when the issuer refuses the payment.
+	ErrDeclined = errors.New("payment declined")
+	// ErrAuthenticationFailed is returned when 3-D Secure authentication did not succeed.
+	ErrAuthenticationFailed = errors.New("payment authentication failed")
+	// ErrUnknownAuthorization is returned for an authorization the gateway has no record of.
+	ErrUnknownAuthorization = errors.New("unknown authorization")
+)
+
+// AuthorizationStatus is the outcome of an authorization attempt.
+type AuthorizationStatus string
+
+const (
+	StatusAuthorized     AuthorizationStatus = "authorized"
+	StatusRequiresAction AuthorizationStatus = "requires_action"
+)
+
+// AuthorizeRequest asks the gateway to hold funds for an order. OrderID doubles
+// as the idempotency key, so retried authorizations never hold funds twice.
+type AuthorizeRequest struct {
+	OrderID     int64
+	CustomerID  int64
+	AmountMinor int64
+	Currency    string
+}
+
+// Authorization is a hold on the customer's funds.
+type Authorization struct {
+	ID     string
+	Status AuthorizationStatus
+	// ActionURL is where the customer completes 3-D Secure when Status is StatusRequiresAction.
+	ActionURL string
+}
+
+// PaymentGateway authorizes, captures, voids and refunds payments with a payment provider.
+type PaymentGateway interface {
+	Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error)
+	// CompleteAuthentication finishes an authorization that required 3-D Secure.
+	CompleteAuthentication(ctx context.Context, authorizationID string) (*Authorization, error)
+	Capture(ctx context.Context, authorizationID string, amountMinor int64) error
+	Void(ctx context.Context, authorizationID string) error
+	// Refund returns part or all of a captured amount to the customer. refundID
+	// is the idempotency key, so a retried refund never pays out twice.
+	Refund(ctx context.Context, authorizationID, refundID string, amountMinor int64) error
+}
+
+// NewGateway returns the gateway selected in config.
+func NewGateway(cfg pkg.Payments) (PaymentGateway, error) {
+	switch cfg.Gateway {
+	case "", "fake":
+		return NewFakeGateway(cfg.Fake), nil
+	default:
+		return nil, fmt.Errorf("unknown payment gateway %q", cfg.Gateway)
+	}
+}
@@ -0,0 +1,159 @@
+// Package promotions evaluates coupons against an order and keeps track of
+// how often each customer redeemed them.
+package promotions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+)
+
+// ErrCouponRejected is returned when a coupon does not apply to an order.
+var ErrCouponRejected = errors.New("coupon rejected")
+
+func rejected(code, format string, args ...any) error {
+	return fmt.Errorf("%w: %s %s", ErrCouponRejected, code, fmt.Sprintf(format, args...))
+}
+
+// Validate checks that a coupon is well formed before it is stored.
+func Validate(c *ordersv1.Coupon) error {
+	if c.Code == "" {
+		return errors.New("code is required")
+	}
+	switch c.Type {
+	case ordersv1.CouponType_COUPON_TYPE_PERCENTAGE:
+		if c.PercentOff <= 0 || c.PercentOff > 100 {
+			return errors.New("percent_off must be between 1 and 100")
+		}
+	case ordersv1.CouponType_COUPON_TYPE_FIXED_AMOUNT:
+		if c.AmountOffMinor <= 0 {
+			return errors.New("amount_off_minor must be positive")
+		}
+	case ordersv1.CouponType_COUPON_TYPE_BUY_X_GET_Y:
+		if c.ProductId == 0 || c.BuyQuantity <= 0 || c.GetQuantity <= 0 {
+			return errors.New("product_id, buy_quantity and get_quantity are required")
+		}
+	default:
+		return errors.New("type is required")
+	}
+	if c.MinBasketMinor < 0 || c.PerCustomerLimit < 0 {
+		return errors.New("min_basket_minor and per_customer_limit cannot be negative")
+	}
+	if c.Currency != "" {
+		if err := money.Validate(c.Currency); err != nil {
+			return err
+		}
+	} else if c.AmountOffMinor > 0 || c.MinBasketMinor > 0 {
+		return errors.New("currency is required with amount_off_minor or min_basket_minor")
+	}
+	if c.StartsAt != nil && c.EndsAt != nil && !c.EndsAt.AsTime().After(c.StartsAt.AsTime()) {
+		return errors.New("ends_at must be after starts_at")
+	}
+	return nil
+}
+
+// Evaluate works out what the coupons take off the items, priced in currency,
+// at now. Buy-X-get-Y coupons apply first, then percentages, then fixed
+// amounts, each to what the earlier ones left, so the discounts never exceed
+// the items. It fails with ErrCouponRejected when a coupon is inactive, outside
+// its validity window, below its minimum basket, in another currency, cannot be
+// combined or does not apply to any item.
+func Evaluate(coupons []*ordersv1.Coupon, items []*ordersv1.OrderItem, currency string, now time.Time) ([]*ordersv1.Discount, error) {
+	if len(coupons) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool, len(coupons))
+	for _, c := range coupons {
+		if seen[c.Code] {
+			return nil, rejected(c.Code, "is used twice")
+		}
+		seen[c.Code] = true
+		if len(coupons) > 1 && !c.Stackable {
+			return nil, rejected(c.Code, "cannot be combined with other coupons")
+		}
+	}
+
+	// what is left to discount, per product and for the whole basket
+	remaining := make(map[int64]int64, len(items))
+	unitPrice := make(map[int64]int64, len(items))
+	quantity := make(map[int64]int32, len(items))
+	var subtotal int64
+	for _, item := range items {
+		unitPrice[item.ProductId] = item.UnitPriceMinor
+		quantity[item.ProductId] += item.Quantity
+		remaining[item.ProductId] += unitPrice[item.ProductId] * int64(item.Quantity)
+		subtotal += unitPrice[item.ProductId] * int64(item.Quantity)
+	}
+	basket := subtotal
+
+	ordered := append([]*ordersv1.Coupon(nil), coupons...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return applyOrder(ordered[i].Type) < applyOrder(ordered[j].Type)
+	})
+
+	discounts := make([]*ordersv1.Discount, 0, len(ordered))
+	for _, c := range ordered {
+		switch {
+		case !c.Active:
+			return nil, rejected(c.Code, "is not active")
+		case c.StartsAt != nil && now.Before(c.StartsAt.AsTime()):
+			return nil, rejected(c.Code, "is not valid yet")
+		case c.EndsAt != nil && !now.Before(c.EndsAt.AsTime()):
+			return nil, rejected(c.Code, "has expired")
+		case (c.AmountOffMinor > 0 || c.MinBasketMinor > 0) && c.Currency != currency:
+			return nil, rejected(c.Code, "only applies to orders in %s", c.Currency)
+		case subtotal < c.MinBasketMinor:
+			return nil, rejected(c.Code, "needs a basket of at least %d", c.MinBasketMinor)
+		}
+
+		// a coupon on one product only reaches what is left of that product
+		available := basket
+		if c.ProductId != 0 {
+			available = min(remaining[c.ProductId], basket)
+		}
+
+		var amount int64
+		switch c.Type {
+		case ordersv1.CouponType_COUPON_TYPE_BUY_X_GET_Y:
+			free := quantity[c.ProductId] / (c.BuyQuantity + c.GetQuantity) * c.GetQuantity
+			amount = int64(free) * unitPrice[c.ProductId]
+		case ordersv1.CouponType_COUPON_TYPE_PERCENTAGE:
+			amount = available * int64(c.PercentOff) / 100
+		case ordersv1.CouponType_COUPON_TYPE_FIXED_AMOUNT:
+			amount = c.AmountOffMinor
+		}
+		amount = min(amount, available)
+		if amount <= 0 {
+			return nil, rejected(c.Code, "does not apply to any item")
+		}
+
+		if c.ProductId != 0 {
+			remaining[c.ProductId] -= amount
+		}
+		basket -= amount
+
+		discounts = append(discounts, &ordersv1.Discount{
+			CouponCode:  c.Code,
+			Description: c.Description,
+			ProductId:   c.ProductId,
+			AmountMinor: amount,
+		})
+	}
+	return discounts, nil
+}
+
+func applyOrder(t ordersv1.CouponType) int {
+	switch t {
+	case ordersv1.CouponType_COUPON_TYPE_BUY_X_GET_Y:
+		return 0
+	case ordersv1.CouponType_COUPON_TYPE_PERCENTAGE:
+		return 1
+	default:
+		return 2
+	}
+}
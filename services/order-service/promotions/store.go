@@ -0,0 +1,220 @@
+package promotions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	// ErrCouponNotFound is returned when no coupon has the code.
+	ErrCouponNotFound = errors.New("coupon not found")
+	// ErrCouponExists is returned when creating a coupon whose code is taken.
+	ErrCouponExists = errors.New("coupon already exists")
+	// ErrUsageLimitReached is returned when the customer redeemed the coupon as often as allowed.
+	ErrUsageLimitReached = errors.New("coupon usage limit reached")
+)
+
+// Store keeps coupons and their redemptions per customer.
+type Store struct {
+	session *gocql.Session
+}
+
+func NewStore(session *gocql.Session) *Store {
+	return &Store{session: session}
+}
+
+const couponColumns = `code, type, description, percent_off, amount_off_minor, product_id, buy_quantity, get_quantity, min_basket_minor, per_customer_limit, starts_at, ends_at, stackable, active, currency`
+
+// CreateCoupon stores a new coupon; the code must not be taken.
+func (s *Store) CreateCoupon(ctx context.Context, c *ordersv1.Coupon) error {
+	query := `INSERT INTO coupons (` + couponColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) IF NOT EXISTS`
+	applied, err := s.session.Query(query,
+		c.Code, c.Type.String(), c.Description, c.PercentOff, c.AmountOffMinor, c.ProductId, c.BuyQuantity, c.GetQuantity,
+		c.MinBasketMinor, c.PerCustomerLimit, optionalTime(c.StartsAt), optionalTime(c.EndsAt), c.Stackable, c.Active, c.Currency,
+	).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to create coupon: %w", err)
+	}
+	if !applied {
+		return ErrCouponExists
+	}
+	return nil
+}
+
+// GetCoupon returns the coupon with the code.
+func (s *Store) GetCoupon(ctx context.Context, code string) (*ordersv1.Coupon, error) {
+	scanner := s.session.Query(`SELECT `+couponColumns+` FROM coupons WHERE code = ?`, code).WithContext(ctx).Iter().Scanner()
+	if !scanner.Next() {
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", ErrCouponNotFound, code)
+	}
+
+	c, err := scanCoupon(scanner)
+	if err != nil {
+		return nil, err
+	}
+	return c, scanner.Err()
+}
+
+// GetCoupons returns the coupons with the codes, in the same order.
+func (s *Store) GetCoupons(ctx context.Context, codes []string) ([]*ordersv1.Coupon, error) {
+	coupons := make([]*ordersv1.Coupon, 0, len(codes))
+	for _, code := range codes {
+		c, err := s.GetCoupon(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, c)
+	}
+	return coupons, nil
+}
+
+// ListCoupons returns every coupon.
+func (s *Store) ListCoupons(ctx context.Context) ([]*ordersv1.Coupon, error) {
+	scanner := s.session.Query(`SELECT ` + couponColumns + ` FROM coupons`).WithContext(ctx).Iter().Scanner()
+
+	var coupons []*ordersv1.Coupon
+	for scanner.Next() {
+		c, err := scanCoupon(scanner)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, c)
+	}
+	return coupons, scanner.Err()
+}
+
+// Deactivate stops the coupon from being redeemed.
+func (s *Store) Deactivate(ctx context.Context, code string) error {
+	applied, err := s.session.Query(`UPDATE coupons SET active = false WHERE code = ? IF EXISTS`, code).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to deactivate coupon: %w", err)
+	}
+	if !applied {
+		return fmt.Errorf("%w: %s", ErrCouponNotFound, code)
+	}
+	return nil
+}
+
+// scanCoupon reads the coupon in the current row of scanner.
+func scanCoupon(scanner gocql.Scanner) (*ordersv1.Coupon, error) {
+	var c ordersv1.Coupon
+	var couponType string
+	var startsAt, endsAt time.Time
+	err := scanner.Scan(
+		&c.Code, &couponType, &c.Description, &c.PercentOff, &c.AmountOffMinor, &c.ProductId, &c.BuyQuantity, &c.GetQuantity,
+		&c.MinBasketMinor, &c.PerCustomerLimit, &startsAt, &endsAt, &c.Stackable, &c.Active, &c.Currency,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Type = ordersv1.CouponType(ordersv1.CouponType_value[couponType])
+	if !startsAt.IsZero() {
+		c.StartsAt = timestamppb.New(startsAt)
+	}
+	if !endsAt.IsZero() {
+		c.EndsAt = timestamppb.New(endsAt)
+	}
+	return &c, nil
+}
+
+// Redeem counts a use of the coupon by the customer for the order, failing with
+// ErrUsageLimitReached when limit uses were counted already; 0 is unlimited.
+// The count is a lightweight transaction, so concurrent orders cannot both take
+// the last use, and redeeming again for the same order is a no-op.
+func (s *Store) Redeem(ctx context.Context, code string, customerId, orderId int64, limit int32) error {
+	for {
+		uses, orderIds, found, err := s.usage(ctx, code, customerId)
+		if err != nil {
+			return err
+		}
+		if contains(orderIds, orderId) {
+			return nil
+		}
+		if limit > 0 && uses >= int(limit) {
+			return fmt.Errorf("%w: %s", ErrUsageLimitReached, code)
+		}
+
+		var query string
+		var args []interface{}
+		if !found {
+			query = `INSERT INTO coupon_usage (code, customer_id, uses, order_ids) VALUES (?, ?, 1, ?) IF NOT EXISTS`
+			args = []interface{}{code, customerId, []int64{orderId}}
+		} else {
+			query = `UPDATE coupon_usage SET uses = ?, order_ids = order_ids + ? WHERE code = ? AND customer_id = ? IF uses = ?`
+			args = []interface{}{uses + 1, []int64{orderId}, code, customerId, uses}
+		}
+
+		applied, err := s.session.Query(query, args...).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+		if err != nil {
+			return fmt.Errorf("failed to redeem coupon %s: %w", code, err)
+		}
+		if applied {
+			return nil
+		}
+		// another order of the customer redeemed the coupon in between; count again
+	}
+}
+
+// Release gives back the use of the coupon an order redeemed, when the order
+// did not go through. Releasing a use that was not redeemed is a no-op.
+func (s *Store) Release(ctx context.Context, code string, customerId, orderId int64) error {
+	for {
+		uses, orderIds, _, err := s.usage(ctx, code, customerId)
+		if err != nil {
+			return err
+		}
+		if !contains(orderIds, orderId) {
+			return nil
+		}
+
+		query := `UPDATE coupon_usage SET uses = ?, order_ids = order_ids - ? WHERE code = ? AND customer_id = ? IF uses = ?`
+		applied, err := s.session.Query(query, uses-1, []int64{orderId}, code, customerId, uses).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+		if err != nil {
+			return fmt.Errorf("failed to release coupon %s: %w", code, err)
+		}
+		if applied {
+			return nil
+		}
+	}
+}
+
+func (s *Store) usage(ctx context.Context, code string, customerId int64) (int, []int64, bool, error) {
+	var uses int
+	var orderIds []int64
+	query := `SELECT uses, order_ids FROM coupon_usage WHERE code = ? AND customer_id = ?`
+	err := s.session.Query(query, code, customerId).WithContext(ctx).Scan(&uses, &orderIds)
+	if errors.Is(err, gocql.ErrNotFound) {
+		return 0, nil, false, nil
+	}
+	if err != nil {
+		return 0, nil, false, fmt.Errorf("failed to read usage of coupon %s: %w", code, err)
+	}
+	return uses, orderIds, true, nil
+}
+
+func contains(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// optionalTime binds an unset timestamp as null.
+func optionalTime(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HistoryRepository reads the order history of customers from orders_by_customer,
+// which the order activities keep in step with the orders table.
+type HistoryRepository struct {
+	session *gocql.Session
+}
+
+func NewHistoryRepository(session *gocql.Session) *HistoryRepository {
+	return &HistoryRepository{session: session}
+}
+
+// ListCustomerOrders returns the orders of a customer, newest first, one page at
+// a time. An unspecified status lists every order. pageState is nil for the
+// first page; the returned state is nil after the last.
+//
+// The status is filtered here rather than in Cassandra, where filtering would
+// count skipped rows against the page. Pages are fetched until this one is
+// full, each no larger than what is missing, so it never holds more than
+// pageSize orders and the state resumes right after the last row read.
+func (r *HistoryRepository) ListCustomerOrders(ctx context.Context, customerId int64, status ordersv1.OrderStatus, pageSize int, pageState []byte) ([]*ordersv1.Order, []byte, error) {
+	var orders []*ordersv1.Order
+	for {
+		page, next, err := r.customerOrdersPage(ctx, customerId, status, pageSize-len(orders), pageState)
+		if err != nil {
+			return nil, nil, err
+		}
+		orders = append(orders, page...)
+		if next == nil || len(orders) >= pageSize {
+			return orders, next, nil
+		}
+		pageState = next
+	}
+}
+
+// customerOrdersPage reads one page of at most pageSize rows and returns the
+// orders in it with the given status, or all of them for an unspecified status.
+func (r *HistoryRepository) customerOrdersPage(ctx context.Context, customerId int64, status ordersv1.OrderStatus, pageSize int, pageState []byte) ([]*ordersv1.Order, []byte, error) {
+	query := `SELECT order_id, status, currency, created_at, updated_at FROM orders_by_customer WHERE customer_id = ?`
+	iter := r.session.Query(query, customerId).WithContext(ctx).PageSize(pageSize).PageState(pageState).Iter()
+
+	var orders []*ordersv1.Order
+	var (
+		orderId              int64
+		orderStatus          string
+		currency             string
+		createdAt, updatedAt time.Time
+	)
+	// stop at the end of the page instead of letting the iterator fetch the next
+	for !iter.WillSwitchPage() && iter.Scan(&orderId, &orderStatus, &currency, &createdAt, &updatedAt) {
+		if status != ordersv1.OrderStatus_ORDER_STATUS_UNSPECIFIED && orderStatus != status.String() {
+			continue
+		}
+		orders = append(orders, &ordersv1.Order{
+			OrderId:    orderId,
+			CustomerId: customerId,
+			Status:     ordersv1.OrderStatus(ordersv1.OrderStatus_value[orderStatus]),
+			Currency:   currency,
+			CreatedAt:  timestamppb.New(createdAt),
+			UpdatedAt:  timestamppb.New(updatedAt),
+		})
+	}
+	next := iter.PageState()
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list orders of customer %d: %w", customerId, err)
+	}
+	if len(next) == 0 {
+		next = nil
+	}
+	return orders, next, nil
+}
@@ -2,13 +2,44 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
 )
 
+var (
+	// ErrOrderNotFound is returned when no workflow exists for an order.
+	ErrOrderNotFound = errors.New("order not found")
+	// ErrInvalidStatusTransition is returned when an order cannot move to the requested status.
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	// ErrReturnExists is returned when a return of the order is already in progress.
+	ErrReturnExists = errors.New("return already in progress")
+	// ErrReturnRejected is returned when an order cannot be returned.
+	ErrReturnRejected = errors.New("return rejected")
+	// ErrReturnNotFound is returned when the order has no return in progress.
+	ErrReturnNotFound = errors.New("return not found")
+	// ErrCouponRejected is returned when a coupon of a new order is unknown, does not apply or is used up.
+	ErrCouponRejected = errors.New("coupon rejected")
+	// ErrPricingFailed is returned when a new order cannot be priced in its currency.
+	ErrPricingFailed = errors.New("order cannot be priced")
+	// ErrPaymentNotPending is returned when an order's payment is not waiting for 3-D Secure.
+	ErrPaymentNotPending = errors.New("payment is not waiting for authentication")
+	// ErrInvalidItems is returned when a new order has no items or an item quantity that is not positive.
+	ErrInvalidItems = errors.New("invalid order items")
+)
+
+// watchPollInterval is how often WatchOrder queries the workflow for new events.
+const watchPollInterval = time.Second
+
 type OrderRepository struct {
 	client client.Client
 }
@@ -19,31 +50,303 @@ func NewOrderRepository(client client.Client) *OrderRepository {
 	}
 }
 
-func (r *OrderRepository) CreateOrder(ctx context.Context, order *ordersv1.Order) error {
+func workflowID(orderId int64) string {
+	return activities.OrderWorkflowID(orderId)
+}
+
+// CreateOrder starts the order workflow and waits until the order is persisted,
+// or backordered, and returns it as the workflow holds it.
+func (r *OrderRepository) CreateOrder(ctx context.Context, order *ordersv1.Order) (*ordersv1.Order, error) {
+	items, err := mergeItems(order.Items)
+	if err != nil {
+		return nil, err
+	}
+	order.Items = items
+
+	ctx = logger.WithCorrelationID(ctx, logger.OrderIDKey, strconv.FormatInt(order.OrderId, 10))
 
 	workflowOptions := client.StartWorkflowOptions{
-		ID:        fmt.Sprintf("order-%d", order.OrderId),
-		TaskQueue: "order-service",
+		ID:        workflowID(order.OrderId),
+		TaskQueue: workflows.TaskQueue,
 	}
 
 	we, err := r.client.ExecuteWorkflow(ctx, workflowOptions, workflows.CreateOrderWorkflow, order)
 	if err != nil {
-		return fmt.Errorf("failed to execute workflow: %w", err)
+		return nil, fmt.Errorf("failed to execute workflow: %w", err)
+	}
+	logger.FromContext(ctx).Info("order workflow started", "workflow_id", we.GetID(), "run_id", we.GetRunID())
+
+	// Wait until the order is persisted or backordered; the workflow keeps running to track fulfilment
+	handle, err := r.client.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+		WorkflowID:   we.GetID(),
+		RunID:        we.GetRunID(),
+		UpdateName:   workflows.UpdateAwaitCreated,
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	})
+	if err == nil {
+		err = handle.Get(ctx, nil)
+	}
+	if err != nil {
+		// the workflow may already have failed and closed before accepting the update
+		if wfErr := r.workflowFailure(ctx, we); wfErr != nil {
+			err = wfErr
+		}
+		return nil, orderFailure(err)
+	}
+
+	return r.queryOrder(ctx, order.OrderId)
+}
+
+// mergeItems checks the quantities of the items of a new order and merges the
+// lines of the same product, since stock holds, order items and tax lines are
+// all kept per product. Items ordered by SKU must already be resolved.
+func mergeItems(items []*ordersv1.OrderItem) ([]*ordersv1.OrderItem, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: an order needs at least one item", ErrInvalidItems)
+	}
+	merged := make([]*ordersv1.OrderItem, 0, len(items))
+	byProduct := make(map[int64]*ordersv1.OrderItem, len(items))
+	for _, item := range items {
+		if item.ProductId <= 0 {
+			return nil, fmt.Errorf("%w: product_id or sku is required", ErrInvalidItems)
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity of product %d must be positive", ErrInvalidItems, item.ProductId)
+		}
+		if first, ok := byProduct[item.ProductId]; ok {
+			first.Quantity += item.Quantity
+			if first.Sku == "" {
+				first.Sku = item.Sku
+			}
+			continue
+		}
+		byProduct[item.ProductId] = item
+		merged = append(merged, item)
+	}
+	return merged, nil
+}
+
+// orderFailure maps the error an order workflow failed with to a repository error.
+func orderFailure(err error) error {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		switch appErr.Type() {
+		case activities.ErrTypeCouponRejected:
+			return fmt.Errorf("%w: %s", ErrCouponRejected, appErr.Message())
+		case activities.ErrTypePricingFailed:
+			return fmt.Errorf("%w: %s", ErrPricingFailed, appErr.Message())
+		}
+	}
+	return fmt.Errorf("workflow execution failed: %w", err)
+}
+
+// workflowFailure returns the error a closed workflow failed with, or nil when it is still running.
+func (r *OrderRepository) workflowFailure(ctx context.Context, we client.WorkflowRun) error {
+	desc, err := r.client.DescribeWorkflowExecution(ctx, we.GetID(), we.GetRunID())
+	if err != nil || desc.WorkflowExecutionInfo.GetCloseTime() == nil {
+		return nil
+	}
+	return we.Get(ctx, nil)
+}
+
+func returnWorkflowID(orderId int64) string {
+	return fmt.Sprintf("return-%d", orderId)
+}
+
+// RequestReturn starts the return workflow of an order and waits until the
+// return is accepted or rejected.
+func (r *OrderRepository) RequestReturn(ctx context.Context, ret *ordersv1.OrderReturn) (*ordersv1.OrderReturn, error) {
+	ctx = logger.WithCorrelationID(ctx, logger.OrderIDKey, strconv.FormatInt(ret.OrderId, 10))
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        returnWorkflowID(ret.OrderId),
+		TaskQueue: workflows.TaskQueue,
+		// an order may be returned again once its earlier return closed, one at a time
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}
+
+	we, err := r.client.ExecuteWorkflow(ctx, workflowOptions, workflows.ReturnWorkflow, ret)
+	if err != nil {
+		var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+		if errors.As(err, &alreadyStarted) {
+			return nil, ErrReturnExists
+		}
+		return nil, fmt.Errorf("failed to execute workflow: %w", err)
+	}
+	logger.FromContext(ctx).Info("return workflow started", "workflow_id", we.GetID(), "run_id", we.GetRunID())
+
+	handle, err := r.client.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+		WorkflowID:   we.GetID(),
+		RunID:        we.GetRunID(),
+		UpdateName:   workflows.UpdateAwaitReturnAccepted,
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	})
+	if err == nil {
+		err = handle.Get(ctx, nil)
+	}
+	if err != nil {
+		if wfErr := r.workflowFailure(ctx, we); wfErr != nil {
+			err = wfErr
+		}
+		return nil, returnFailure(err)
+	}
+
+	return r.queryReturn(ctx, ret.OrderId)
+}
+
+// ReceiveReturn signals the return workflow that the items arrived and returns
+// the return as it was before the refund is applied.
+func (r *OrderRepository) ReceiveReturn(ctx context.Context, orderId int64) (*ordersv1.OrderReturn, error) {
+	ret, err := r.queryReturn(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if ret.Status != ordersv1.ReturnStatus_RETURN_STATUS_REQUESTED {
+		return nil, fmt.Errorf("%w: return is %s", ErrInvalidStatusTransition, ret.Status)
+	}
+
+	if err := r.client.SignalWorkflow(ctx, returnWorkflowID(orderId), "", workflows.SignalReturnReceived, nil); err != nil {
+		return nil, fmt.Errorf("failed to signal return workflow: %w", err)
 	}
 
-	// Wait for workflow completion and get any error result
-	err = we.Get(ctx, nil)
+	return ret, nil
+}
+
+// returnFailure maps the error a return workflow was rejected with to a repository error.
+func returnFailure(err error) error {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		switch appErr.Type() {
+		case activities.ErrTypeOrderNotFound:
+			return ErrOrderNotFound
+		case workflows.ErrTypeReturnRejected:
+			return fmt.Errorf("%w: %s", ErrReturnRejected, appErr.Message())
+		}
+	}
+	return fmt.Errorf("return workflow failed: %w", err)
+}
+
+func (r *OrderRepository) queryReturn(ctx context.Context, orderId int64) (*ordersv1.OrderReturn, error) {
+	value, err := r.client.QueryWorkflow(ctx, returnWorkflowID(orderId), "", workflows.QueryReturn)
 	if err != nil {
-		return fmt.Errorf("workflow execution failed: %w", err)
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return nil, ErrReturnNotFound
+		}
+		return nil, fmt.Errorf("failed to query return workflow: %w", err)
 	}
 
-	return nil
+	var ret ordersv1.OrderReturn
+	if err := value.Get(&ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
 }
 
 func (r *OrderRepository) GetOrder(orderId int64) (*ordersv1.Order, error) {
 	return nil, nil
 }
 
-func (r *OrderRepository) UpdateOrder(order *ordersv1.Order) (*ordersv1.Order, error) {
-	return nil, nil
+// UpdateOrder signals a fulfilment status change to the order workflow and
+// returns the order as it was before the change is applied.
+func (r *OrderRepository) UpdateOrder(ctx context.Context, order *ordersv1.Order) (*ordersv1.Order, error) {
+	current, err := r.queryOrder(ctx, order.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := workflows.StatusTransition(current.Status, order.Status); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatusTransition, err)
+	}
+
+	if err := r.client.SignalWorkflow(ctx, workflowID(order.OrderId), "", workflows.SignalUpdateStatus, order.Status); err != nil {
+		return nil, fmt.Errorf("failed to signal order workflow: %w", err)
+	}
+
+	return current, nil
+}
+
+// CompletePaymentAuthentication signals the order workflow whether the customer
+// passed the 3-D Secure challenge of the order's payment, and returns the order
+// as it was before. A non-zero customerId must own the order.
+func (r *OrderRepository) CompletePaymentAuthentication(ctx context.Context, orderId, customerId int64, authenticated bool) (*ordersv1.Order, error) {
+	order, err := r.queryOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if customerId != 0 && order.CustomerId != customerId {
+		return nil, ErrOrderNotFound
+	}
+	if order.Payment == nil || order.Payment.Status != ordersv1.PaymentStatus_PAYMENT_STATUS_REQUIRES_ACTION {
+		return nil, ErrPaymentNotPending
+	}
+
+	if err := r.client.SignalWorkflow(ctx, workflowID(orderId), "", workflows.SignalPaymentAuthenticated, authenticated); err != nil {
+		return nil, fmt.Errorf("failed to signal order workflow: %w", err)
+	}
+	logger.FromContext(ctx).Info("payment authentication reported", "order_id", orderId, "authenticated", authenticated)
+
+	return order, nil
+}
+
+// WatchOrder sends every status transition of the order to send, starting with
+// those already recorded, until the order is delivered or cancelled or ctx is done.
+func (r *OrderRepository) WatchOrder(ctx context.Context, orderId, customerId int64, send func(*ordersv1.OrderEvent) error) error {
+	if customerId != 0 {
+		order, err := r.queryOrder(ctx, orderId)
+		if err != nil {
+			return err
+		}
+		if order.CustomerId != customerId {
+			return ErrOrderNotFound
+		}
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	sent := 0
+	for {
+		var events []*ordersv1.OrderEvent
+		if err := r.query(ctx, orderId, workflows.QueryOrderEvents, &events); err != nil {
+			return err
+		}
+
+		for _, event := range events[sent:] {
+			if err := send(event); err != nil {
+				return err
+			}
+			sent++
+
+			if event.Type == ordersv1.OrderEventType_ORDER_EVENT_TYPE_DELIVERED || event.Type == ordersv1.OrderEventType_ORDER_EVENT_TYPE_CANCELLED {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OrderRepository) queryOrder(ctx context.Context, orderId int64) (*ordersv1.Order, error) {
+	var order ordersv1.Order
+	if err := r.query(ctx, orderId, workflows.QueryOrder, &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (r *OrderRepository) query(ctx context.Context, orderId int64, queryType string, result interface{}) error {
+	value, err := r.client.QueryWorkflow(ctx, workflowID(orderId), "", queryType)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if errors.As(err, &notFound) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("failed to query order workflow: %w", err)
+	}
+	return value.Get(result)
 }
@@ -0,0 +1,34 @@
+package schedules
+
+import (
+	"context"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"go.temporal.io/sdk/client"
+)
+
+// PurgeDeletedProductsID identifies the purge schedule and the workflows it starts.
+const PurgeDeletedProductsID = "purge-deleted-products"
+
+// SyncPurgeDeletedProducts creates the purge schedule, or updates its cadence,
+// pause state and retention to match cfg when it already exists.
+func SyncPurgeDeletedProducts(ctx context.Context, c client.Client, cfg pkg.Purge) error {
+	return syncSchedule(ctx, c, PurgeDeletedProductsID, "purge", cfg.Schedule, cfg.Paused, purgeSchedule(cfg))
+}
+
+func purgeSchedule(cfg pkg.Purge) client.Schedule {
+	return client.Schedule{
+		Spec: &client.ScheduleSpec{
+			CronExpressions: []string{cfg.Schedule},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        PurgeDeletedProductsID,
+			Workflow:  workflows.PurgeDeletedProductsWorkflow,
+			TaskQueue: workflows.TaskQueue,
+			Args: []interface{}{workflows.PurgeOptions{
+				Retention: cfg.Retention,
+			}},
+		},
+	}
+}
@@ -0,0 +1,36 @@
+// Package schedules keeps the Temporal schedules of the order service in line with config.
+package schedules
+
+import (
+	"context"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"go.temporal.io/sdk/client"
+)
+
+// ReconcileInventoryID identifies the reconciliation schedule and the workflows it starts.
+const ReconcileInventoryID = "reconcile-inventory"
+
+// SyncReconcileInventory creates the reconciliation schedule, or updates its
+// cadence, pause state and options to match cfg when it already exists.
+func SyncReconcileInventory(ctx context.Context, c client.Client, cfg pkg.Reconciliation) error {
+	return syncSchedule(ctx, c, ReconcileInventoryID, "reconciliation", cfg.Schedule, cfg.Paused, reconcileSchedule(cfg))
+}
+
+func reconcileSchedule(cfg pkg.Reconciliation) client.Schedule {
+	return client.Schedule{
+		Spec: &client.ScheduleSpec{
+			CronExpressions: []string{cfg.Schedule},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:        ReconcileInventoryID,
+			Workflow:  workflows.ReconcileInventoryWorkflow,
+			TaskQueue: workflows.TaskQueue,
+			Args: []interface{}{workflows.ReconcileOptions{
+				AutoCorrect:    cfg.AutoCorrect,
+				MaxAutoCorrect: cfg.MaxAutoCorrect,
+			}},
+		},
+	}
+}
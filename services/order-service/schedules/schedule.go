@@ -0,0 +1,51 @@
+package schedules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+// syncSchedule creates the schedule with the given id, or updates its spec,
+// action and pause state when it already exists. name and cron are only logged.
+func syncSchedule(ctx context.Context, c client.Client, id, name, cron string, paused bool, schedule client.Schedule) error {
+	_, err := c.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID:      id,
+		Spec:    *schedule.Spec,
+		Action:  schedule.Action,
+		Overlap: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Paused:  paused,
+	})
+	if err == nil {
+		slog.Info(name+" schedule created", "schedule", cron, "paused", paused)
+		return nil
+	}
+	if !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("failed to create %s schedule: %w", name, err)
+	}
+
+	handle := c.ScheduleClient().GetHandle(ctx, id)
+	err = handle.Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			current := input.Description.Schedule
+			current.Spec = schedule.Spec
+			current.Action = schedule.Action
+			if current.State == nil {
+				current.State = &client.ScheduleState{}
+			}
+			current.State.Paused = paused
+			return &client.ScheduleUpdate{Schedule: &current}, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update %s schedule: %w", name, err)
+	}
+
+	slog.Info(name+" schedule updated", "schedule", cron, "paused", paused)
+	return nil
+}
@@ -0,0 +1,66 @@
+package tax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+)
+
+// ErrRateNotFound is returned when deleting a tax rate that does not exist.
+var ErrRateNotFound = errors.New("tax rate not found")
+
+// RateStore keeps the tax rates per country, region and tax category.
+type RateStore struct {
+	session *gocql.Session
+}
+
+func NewRateStore(session *gocql.Session) *RateStore {
+	return &RateStore{session: session}
+}
+
+// SetRate creates the rate, or replaces the rate of the same country, region and category.
+func (s *RateStore) SetRate(ctx context.Context, rate *ordersv1.TaxRate) error {
+	query := `INSERT INTO tax_rates (country, region, category, rate_bps, inclusive, description) VALUES (?, ?, ?, ?, ?, ?)`
+	if err := s.session.Query(query, rate.Country, rate.Region, rate.Category, rate.RateBps, rate.Inclusive, rate.Description).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to set tax rate: %w", err)
+	}
+	return nil
+}
+
+// ListRates returns the rates of the country, or of every country when it is empty.
+func (s *RateStore) ListRates(ctx context.Context, country string) ([]*ordersv1.TaxRate, error) {
+	query := s.session.Query(`SELECT country, region, category, rate_bps, inclusive, description FROM tax_rates`)
+	if country != "" {
+		query = s.session.Query(`SELECT country, region, category, rate_bps, inclusive, description FROM tax_rates WHERE country = ?`, country)
+	}
+	scanner := query.WithContext(ctx).Iter().Scanner()
+
+	var rates []*ordersv1.TaxRate
+	for scanner.Next() {
+		var rate ordersv1.TaxRate
+		if err := scanner.Scan(&rate.Country, &rate.Region, &rate.Category, &rate.RateBps, &rate.Inclusive, &rate.Description); err != nil {
+			return nil, err
+		}
+		rates = append(rates, &rate)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tax rates: %w", err)
+	}
+	return rates, nil
+}
+
+// DeleteRate removes the rate of the country, region and category.
+func (s *RateStore) DeleteRate(ctx context.Context, country, region, category string) error {
+	query := `DELETE FROM tax_rates WHERE country = ? AND region = ? AND category = ? IF EXISTS`
+	applied, err := s.session.Query(query, country, region, category).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("failed to delete tax rate: %w", err)
+	}
+	if !applied {
+		return ErrRateNotFound
+	}
+	return nil
+}
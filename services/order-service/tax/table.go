@@ -0,0 +1,86 @@
+package tax
+
+import (
+	"context"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+)
+
+// RateTable looks up the tax rates of a country.
+type RateTable interface {
+	ListRates(ctx context.Context, country string) ([]*ordersv1.TaxRate, error)
+}
+
+// TableCalculator taxes each line with the rate of its country, region and tax
+// category. A region without a rate of its own uses the rate of the country,
+// and a category without a rate uses the standard rate. Lines without any
+// matching rate, or orders without an address, are not taxed.
+type TableCalculator struct {
+	rates RateTable
+}
+
+func NewTableCalculator(rates RateTable) *TableCalculator {
+	return &TableCalculator{rates: rates}
+}
+
+func (c *TableCalculator) Calculate(ctx context.Context, req Request) ([]*ordersv1.TaxLine, error) {
+	var country, region string
+	var rates []*ordersv1.TaxRate
+	if req.Address != nil && req.Address.Country != "" {
+		country, region = req.Address.Country, req.Address.Region
+
+		var err error
+		if rates, err = c.rates.ListRates(ctx, country); err != nil {
+			return nil, err
+		}
+	}
+
+	lines := make([]*ordersv1.TaxLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		taxLine := &ordersv1.TaxLine{
+			ProductId:    line.ProductID,
+			Category:     line.Category,
+			Jurisdiction: country,
+			TaxableMinor: line.AmountMinor,
+		}
+
+		if rate := match(rates, region, line.Category); rate != nil {
+			if rate.Region != "" {
+				taxLine.Jurisdiction = country + "-" + rate.Region
+			}
+			taxLine.RateBps = rate.RateBps
+			taxLine.Inclusive = rate.Inclusive
+			if rate.Inclusive {
+				// the price already holds the tax; take it back out
+				taxLine.TaxableMinor = roundDiv(line.AmountMinor*10000, 10000+int64(rate.RateBps))
+				taxLine.TaxMinor = line.AmountMinor - taxLine.TaxableMinor
+			} else {
+				taxLine.TaxMinor = roundDiv(line.AmountMinor*int64(rate.RateBps), 10000)
+			}
+		}
+		lines = append(lines, taxLine)
+	}
+	return lines, nil
+}
+
+// match picks the most specific rate for the region and category.
+func match(rates []*ordersv1.TaxRate, region, category string) *ordersv1.TaxRate {
+	for _, want := range []struct{ region, category string }{
+		{region, category},
+		{"", category},
+		{region, DefaultCategory},
+		{"", DefaultCategory},
+	} {
+		for _, rate := range rates {
+			if rate.Region == want.region && rate.Category == want.category {
+				return rate
+			}
+		}
+	}
+	return nil
+}
+
+// roundDiv divides, rounding halves up.
+func roundDiv(n, d int64) int64 {
+	return (2*n + d) / (2 * d)
+}
@@ -0,0 +1,161 @@
+// Package tax works out the tax on orders from the rates of the country and
+// region they ship to and the tax category of each product.
+package tax
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/address"
+)
+
+// DefaultCategory is the tax category of products that have none, and the rate
+// used for a category that has no rate of its own.
+const DefaultCategory = "standard"
+
+// Line is an order line to be taxed.
+type Line struct {
+	ProductID int64
+	Category  string
+	// AmountMinor is the price of the line after discounts, in minor units
+	AmountMinor int64
+}
+
+// Request asks for the tax on the lines of an order delivered to Address.
+type Request struct {
+	OrderID int64
+	Address *ordersv1.Address
+	Lines   []Line
+}
+
+// TaxCalculator works out the tax of an order, one tax line per order line.
+type TaxCalculator interface {
+	Calculate(ctx context.Context, req Request) ([]*ordersv1.TaxLine, error)
+}
+
+// NewCalculator returns the calculator selected in config.
+func NewCalculator(cfg pkg.Tax, rates RateTable) (TaxCalculator, error) {
+	switch cfg.Calculator {
+	case "", "table":
+		return NewTableCalculator(rates), nil
+	default:
+		return nil, fmt.Errorf("unknown tax calculator %q", cfg.Calculator)
+	}
+}
+
+// Lines turns the items of an order into the lines to be taxed, taking the
+// discounts off first. A discount on one product comes off the lines of that
+// product, a discount on the basket is shared out over every line in
+// proportion to what is left of it.
+func Lines(items []*ordersv1.OrderItem, discounts []*ordersv1.Discount, categories map[int64]string) []Line {
+	lines := make([]Line, len(items))
+	for i, item := range items {
+		category := categories[item.ProductId]
+		if category == "" {
+			category = DefaultCategory
+		}
+		lines[i] = Line{
+			ProductID:   item.ProductId,
+			Category:    category,
+			AmountMinor: item.UnitPriceMinor * int64(item.Quantity),
+		}
+	}
+
+	for _, d := range discounts {
+		var targets []int
+		for i, line := range lines {
+			if d.ProductId == 0 || line.ProductID == d.ProductId {
+				targets = append(targets, i)
+			}
+		}
+		amounts := make([]int64, len(targets))
+		for j, i := range targets {
+			amounts[j] = lines[i].AmountMinor
+		}
+		for j, share := range allocate(d.AmountMinor, amounts) {
+			lines[targets[j]].AmountMinor -= share
+		}
+	}
+	return lines
+}
+
+// allocate shares total out in proportion to amounts, never giving one more
+// than its amount. Rounding leftovers go to the last amounts that have room.
+func allocate(total int64, amounts []int64) []int64 {
+	var sum int64
+	for _, a := range amounts {
+		sum += a
+	}
+	shares := make([]int64, len(amounts))
+	if sum <= 0 {
+		return shares
+	}
+	total = min(total, sum)
+
+	left := total
+	for i, a := range amounts {
+		shares[i] = total * a / sum
+		left -= shares[i]
+	}
+	for i := len(amounts) - 1; i >= 0 && left > 0; i-- {
+		extra := min(left, amounts[i]-shares[i])
+		shares[i] += extra
+		left -= extra
+	}
+	return shares
+}
+
+// Validate checks that a tax rate is well formed before it is stored.
+func Validate(rate *ordersv1.TaxRate) error {
+	if !isCountryCode(rate.Country) {
+		return errors.New("country must be an ISO 3166-1 alpha-2 code, e.g. DE")
+	}
+	if rate.Category == "" {
+		return errors.New("category is required")
+	}
+	if rate.RateBps < 0 || rate.RateBps > 10000 {
+		return errors.New("rate_bps must be between 0 and 10000")
+	}
+	return nil
+}
+
+// ValidateAddresses checks that the addresses of an order, where given, follow
+// the rules of the country they are in.
+func ValidateAddresses(shipping, billing *ordersv1.Address) error {
+	if shipping != nil {
+		if err := address.Validate(postalAddress(shipping)); err != nil {
+			return fmt.Errorf("shipping_address: %w", err)
+		}
+	}
+	if billing != nil {
+		if err := address.Validate(postalAddress(billing)); err != nil {
+			return fmt.Errorf("billing_address: %w", err)
+		}
+	}
+	return nil
+}
+
+func postalAddress(a *ordersv1.Address) address.Address {
+	return address.Address{
+		Line1:      a.Line1,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+func isCountryCode(country string) bool {
+	if len(country) != 2 {
+		return false
+	}
+	for _, c := range country {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
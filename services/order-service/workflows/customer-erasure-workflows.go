@@ -0,0 +1,168 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// QueryErasure returns the current state of the erasure.
+	QueryErasure = "erasure"
+	// UpdateAwaitErasureAccepted completes once the erasure is scheduled, or fails with the reason it was rejected.
+	UpdateAwaitErasureAccepted = "await-accepted"
+	// SignalCancelErasure cancels an erasure during its grace period.
+	SignalCancelErasure = "cancel-erasure"
+)
+
+// ErrTypeErasureRejected is the error type of an erasure that cannot be scheduled.
+const ErrTypeErasureRejected = "ErasureRejected"
+
+// erasureState is the workflow-side record of an erasure, exposed through queries.
+type erasureState struct {
+	erasure  *customersv1.CustomerErasure
+	accepted bool
+	failure  error
+}
+
+// CustomerErasureWorkflow erases the personal data of a customer once the grace
+// period of the erasure is over, unless it is cancelled first. Customers with
+// open orders are not erased. Their past orders are anonymized, every row
+// holding their data is deleted and a tombstone records the erasure.
+func CustomerErasureWorkflow(ctx workflow.Context, erasure *customersv1.CustomerErasure) error {
+	var orderActivityClient *activities.OrderActivity
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	log := logger.Workflow(ctx)
+	log.Info("customer erasure workflow started", "customer_id", erasure.CustomerId, "erase_after", erasure.EraseAfter.AsTime())
+
+	state := &erasureState{erasure: erasure}
+
+	if err := workflow.SetQueryHandler(ctx, QueryErasure, func() (*customersv1.CustomerErasure, error) {
+		return state.erasure, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := workflow.SetUpdateHandler(ctx, UpdateAwaitErasureAccepted, func(ctx workflow.Context) error {
+		if err := workflow.Await(ctx, func() bool { return state.accepted || state.failure != nil }); err != nil {
+			return err
+		}
+		return state.failure
+	}); err != nil {
+		return err
+	}
+
+	var orders []activities.CustomerOrder
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.ListOrdersOfCustomer, erasure.CustomerId).Get(ctx, &orders)
+	if err == nil {
+		if open := openOrders(orders); open > 0 {
+			err = temporal.NewNonRetryableApplicationError(fmt.Sprintf("customer has %d open orders", open), ErrTypeErasureRejected, nil)
+		}
+	}
+	if err == nil {
+		err = workflow.ExecuteActivity(ctx, orderActivityClient.MarkCustomerDeleted, erasure.CustomerId, erasure.RequestedAt.AsTime()).Get(ctx, nil)
+	}
+	if err == nil {
+		err = state.setStatus(ctx, customersv1.ErasureStatus_ERASURE_STATUS_PENDING)
+	}
+	if err != nil {
+		log.Error("erasure rejected", "error", err)
+		state.failure = err
+		_ = workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) })
+		return err
+	}
+	state.accepted = true
+
+	// wait out the grace period
+	cancelled := false
+	if grace := erasure.EraseAfter.AsTime().Sub(workflow.Now(ctx)); grace > 0 {
+		cancelled, _ = workflow.GetSignalChannel(ctx, SignalCancelErasure).ReceiveWithTimeout(ctx, grace, nil)
+	}
+	if cancelled {
+		log.Info("erasure cancelled")
+		if err := workflow.ExecuteActivity(ctx, orderActivityClient.RestoreCustomer, erasure.CustomerId).Get(ctx, nil); err != nil {
+			return fmt.Errorf("failed to restore customer: %w", err)
+		}
+		return state.setStatus(ctx, customersv1.ErasureStatus_ERASURE_STATUS_CANCELLED)
+	}
+
+	// the customer may have ordered during the grace period
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.ListOrdersOfCustomer, erasure.CustomerId).Get(ctx, &orders); err != nil {
+		return fmt.Errorf("failed to list orders of customer: %w", err)
+	}
+	if open := openOrders(orders); open > 0 {
+		erasure.Detail = fmt.Sprintf("customer placed %d orders that are still open", open)
+		log.Info("erasure rejected", "open_orders", open)
+		if err := workflow.ExecuteActivity(ctx, orderActivityClient.RestoreCustomer, erasure.CustomerId).Get(ctx, nil); err != nil {
+			return fmt.Errorf("failed to restore customer: %w", err)
+		}
+		return state.setStatus(ctx, customersv1.ErasureStatus_ERASURE_STATUS_REJECTED)
+	}
+
+	orderIds := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		orderIds = append(orderIds, order.OrderId)
+	}
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.AnonymizeOrders, orderIds).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to anonymize orders: %w", err)
+	}
+	erasure.OrdersAnonymized = int32(len(orderIds))
+
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.EraseCustomer, erasure.CustomerId).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to erase customer: %w", err)
+	}
+
+	erasure.CompletedAt = timestamppb.New(workflow.Now(ctx))
+	if err := state.setStatus(ctx, customersv1.ErasureStatus_ERASURE_STATUS_COMPLETED); err != nil {
+		return err
+	}
+
+	_ = workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) })
+	log.Info("customer erasure workflow completed", "orders_anonymized", erasure.OrdersAnonymized)
+	return nil
+}
+
+// openOrders counts the orders that are neither delivered nor cancelled.
+func openOrders(orders []activities.CustomerOrder) int {
+	open := 0
+	for _, order := range orders {
+		switch order.Status {
+		case ordersv1.OrderStatus_ORDER_STATUS_DELIVERED.String(), ordersv1.OrderStatus_ORDER_STATUS_CANCELLED.String():
+		default:
+			open++
+		}
+	}
+	return open
+}
+
+// setStatus records the erasure on its tombstone and in the workflow state.
+func (s *erasureState) setStatus(ctx workflow.Context, status customersv1.ErasureStatus) error {
+	var orderActivityClient *activities.OrderActivity
+
+	record := proto.Clone(s.erasure).(*customersv1.CustomerErasure)
+	record.Status = status
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.RecordErasure, record).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to record erasure: %w", err)
+	}
+
+	s.erasure.Status = status
+	return nil
+}
@@ -0,0 +1,73 @@
+package workflows
+
+import (
+	"errors"
+	"time"
+
+	customersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/customers/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CustomerExportWorkflow writes the data held about a customer to export
+// storage and records the files, or why it failed, in the audit table the
+// export was requested in.
+func CustomerExportWorkflow(ctx workflow.Context, export *customersv1.CustomerDataExport) error {
+	var orderActivityClient *activities.OrderActivity
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	// customers with a long order history take a while; the heartbeat catches a stuck export
+	writeCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+		},
+	})
+
+	log := logger.Workflow(ctx)
+	log.Info("customer export workflow started", "customer_id", export.CustomerId, "export_id", export.ExportId)
+
+	var files []*customersv1.ExportFile
+	err := workflow.ExecuteActivity(writeCtx, orderActivityClient.WriteCustomerExport, export).Get(ctx, &files)
+
+	export.CompletedAt = timestamppb.New(workflow.Now(ctx))
+	if err != nil {
+		log.Error("customer export failed", "error", err)
+		export.Status = customersv1.ExportStatus_EXPORT_STATUS_FAILED
+		export.Error = err.Error()
+		// keep what the activity failed with, not the workflow's wrapping of it
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) {
+			export.Error = appErr.Message()
+		}
+	} else {
+		export.Status = customersv1.ExportStatus_EXPORT_STATUS_COMPLETED
+		export.Files = files
+	}
+
+	if recordErr := workflow.ExecuteActivity(ctx, orderActivityClient.RecordExport, export).Get(ctx, nil); recordErr != nil {
+		return recordErr
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("customer export workflow completed", "files", len(files))
+	return nil
+}
@@ -0,0 +1,165 @@
+package workflows
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// DefaultBackorderWait is how long an order waits for backordered items when the customer did not choose.
+	DefaultBackorderWait = 14 * 24 * time.Hour
+	// MaxBackorderWait is the longest wait a customer may choose.
+	MaxBackorderWait = 90 * 24 * time.Hour
+	// backorderRecheckInterval is how soon a backordered order first checks
+	// stock on its own, for restocks that nobody signals, such as manual
+	// adjustments. The interval doubles after every recheck up to
+	// maxBackorderRecheckInterval, which keeps the history of a long wait short.
+	backorderRecheckInterval    = time.Hour
+	maxBackorderRecheckInterval = 24 * time.Hour
+)
+
+// ErrTypeBackorderExpired is the error type an order fails with when its
+// backordered items are not back in stock within the maximum wait.
+const ErrTypeBackorderExpired = "BackorderExpired"
+
+// awaitBackorders holds the order in BACKORDERED until every item is in stock
+// and reserved, and reports whether it did. It returns at once when nothing is
+// short, and fails when the wait runs out, the order is cancelled or an item
+// can no longer be backordered.
+func awaitBackorders(ctx workflow.Context, state *orderState) (bool, error) {
+	order := state.order
+	var orderActivityClient *activities.OrderActivity
+
+	var backorders []activities.Backorder
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.CheckBackorders, order.Items).Get(ctx, &backorders); err != nil {
+		return false, fmt.Errorf("failed to check products availability: %w", err)
+	}
+	if len(backorders) == 0 {
+		return false, nil
+	}
+
+	wait := DefaultBackorderWait
+	if order.MaxBackorderWait != nil {
+		wait = order.MaxBackorderWait.AsDuration()
+	}
+	deadline := workflow.Now(ctx).Add(wait)
+
+	for _, b := range backorders {
+		if !b.ExpectedRestockAt.IsZero() && b.ExpectedRestockAt.After(deadline) {
+			msg := fmt.Sprintf("product %d is expected back in stock on %s, after the maximum wait", b.ProductID, b.ExpectedRestockAt.Format(time.DateOnly))
+			return false, temporal.NewNonRetryableApplicationError(msg, ErrTypeBackorderExpired, nil)
+		}
+	}
+
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.RegisterBackorders, order.OrderId, backorders).Get(ctx, nil); err != nil {
+		return false, fmt.Errorf("failed to register backorders: %w", err)
+	}
+
+	markBackordered(order, backorders)
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_BACKORDERED, ordersv1.OrderStatus_ORDER_STATUS_BACKORDERED, backorderMessage(backorders))
+	state.backordered = true
+
+	// a backorder is also a reason to reorder
+	checkReorderPoints(ctx, order.Items)
+
+	err := waitForStock(ctx, state, deadline)
+
+	if clearErr := workflow.ExecuteActivity(ctx, orderActivityClient.ClearBackorders, order.OrderId, order.Items).Get(ctx, nil); clearErr != nil {
+		logger.Workflow(ctx).Error("failed to clear backorders", "error", clearErr)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	markBackordered(order, nil)
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_STOCK_REPLENISHED, ordersv1.OrderStatus_ORDER_STATUS_PROCESSING, "")
+	return true, nil
+}
+
+// waitForStock checks stock again whenever a product is signalled as
+// replenished, and on its own at growing intervals, until nothing is short.
+// The stock is reserved as part of the check, so orders woken by the same
+// restock do not all go on to fail; those that lose keep waiting.
+func waitForStock(ctx workflow.Context, state *orderState, deadline time.Time) error {
+	order := state.order
+	var orderActivityClient *activities.OrderActivity
+	log := logger.Workflow(ctx)
+
+	replenished := workflow.GetSignalChannel(ctx, activities.SignalStockReplenished)
+	updates := workflow.GetSignalChannel(ctx, SignalUpdateStatus)
+
+	interval := backorderRecheckInterval
+	for {
+		remaining := deadline.Sub(workflow.Now(ctx))
+		if remaining <= 0 {
+			return temporal.NewNonRetryableApplicationError("backordered items were not back in stock within the maximum wait", ErrTypeBackorderExpired, nil)
+		}
+
+		cancelled := false
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(replenished, func(c workflow.ReceiveChannel, _ bool) {
+			var productId int64
+			c.Receive(ctx, &productId)
+			log.Info("backordered product replenished", "product_id", productId)
+		})
+		selector.AddReceive(updates, func(c workflow.ReceiveChannel, _ bool) {
+			var status ordersv1.OrderStatus
+			c.Receive(ctx, &status)
+			if _, err := StatusTransition(state.order.Status, status); err != nil {
+				log.Warn("ignoring status update", "status", status.String(), "error", err)
+				return
+			}
+			cancelled = true
+		})
+		selector.AddFuture(workflow.NewTimer(timerCtx, min(interval, remaining)), func(workflow.Future) {
+			interval = min(interval*2, maxBackorderRecheckInterval)
+		})
+		selector.Select(ctx)
+		cancelTimer()
+
+		if cancelled {
+			return fmt.Errorf("order cancelled while backordered")
+		}
+
+		var backorders []activities.Backorder
+		if err := workflow.ExecuteActivity(ctx, orderActivityClient.CheckBackorders, order.Items).Get(ctx, &backorders); err != nil {
+			return fmt.Errorf("failed to check products availability: %w", err)
+		}
+		if len(backorders) == 0 {
+			err := reserveStock(ctx, order)
+			if err == nil || !isInsufficientStock(err) {
+				return err
+			}
+			log.Info("stock taken by another order, waiting again", "error", err)
+			continue
+		}
+		markBackordered(order, backorders)
+	}
+}
+
+// markBackordered flags the items of the order that are waiting for stock.
+func markBackordered(order *ordersv1.Order, backorders []activities.Backorder) {
+	short := make(map[int64]bool, len(backorders))
+	for _, b := range backorders {
+		short[b.ProductID] = true
+	}
+	for _, item := range order.Items {
+		item.Backordered = short[item.ProductId]
+	}
+}
+
+func backorderMessage(backorders []activities.Backorder) string {
+	products := make([]string, 0, len(backorders))
+	for _, b := range backorders {
+		products = append(products, fmt.Sprintf("%d", b.ProductID))
+	}
+	return "waiting for products " + strings.Join(products, ", ")
+}
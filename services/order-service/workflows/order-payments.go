@@ -0,0 +1,175 @@
+package workflows
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// SignalPaymentAuthenticated reports the outcome (a bool) of the customer's 3-D Secure challenge.
+const SignalPaymentAuthenticated = "payment-authenticated"
+
+// paymentAuthenticationTimeout is how long the order waits for 3-D Secure before giving up.
+const paymentAuthenticationTimeout = 15 * time.Minute
+
+// paymentActivityOptions bound calls to the gateway so a hanging provider fails
+// the order instead of blocking it forever.
+var paymentActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: 30 * time.Second,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		MaximumInterval:    10 * time.Second,
+		BackoffCoefficient: 2,
+		MaximumAttempts:    3,
+		NonRetryableErrorTypes: []string{
+			activities.ErrTypePaymentDeclined,
+			activities.ErrTypePaymentAuthenticationFailed,
+		},
+	},
+}
+
+// orderAmountMinor totals the order in minor units.
+func orderAmountMinor(items []*ordersv1.OrderItem) int64 {
+	var total int64
+	for _, item := range items {
+		total += itemAmountMinor(item)
+	}
+	return total
+}
+
+// amountDueMinor is what the customer pays for the order: the items minus the
+// discounts, plus the tax that is not included in the prices.
+func amountDueMinor(order *ordersv1.Order) int64 {
+	amount := orderAmountMinor(order.Items)
+	for _, d := range order.Discounts {
+		amount -= d.AmountMinor
+	}
+	return max(amount, 0) + exclusiveTaxMinor(order)
+}
+
+// itemAmountMinor is the price of an order line in minor units.
+func itemAmountMinor(item *ordersv1.OrderItem) int64 {
+	return item.UnitPriceMinor * int64(item.Quantity)
+}
+
+// authorizePayment holds the order amount on the customer's payment method,
+// waiting for 3-D Secure when the gateway asks for it.
+func authorizePayment(ctx workflow.Context, state *orderState) error {
+	var orderActivityClient *activities.OrderActivity
+	order := state.order
+	paymentCtx := workflow.WithActivityOptions(ctx, paymentActivityOptions)
+
+	amount := amountDueMinor(order)
+	order.Payment = &ordersv1.Payment{Status: ordersv1.PaymentStatus_PAYMENT_STATUS_PENDING, AmountMinor: amount}
+
+	var payment *ordersv1.Payment
+	err := workflow.ExecuteActivity(paymentCtx, orderActivityClient.AuthorizePayment, order.OrderId, order.CustomerId, amount, order.Currency).Get(ctx, &payment)
+	if err != nil {
+		order.Payment.Status = failedPaymentStatus(err)
+		order.Payment.FailureReason = err.Error()
+		return fmt.Errorf("failed to authorize payment: %w", err)
+	}
+	order.Payment = payment
+
+	if payment.Status == ordersv1.PaymentStatus_PAYMENT_STATUS_REQUIRES_ACTION {
+		state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_PAYMENT_ACTION_REQUIRED, ordersv1.OrderStatus_ORDER_STATUS_PROCESSING, payment.ActionUrl)
+
+		var authenticated bool
+		received, _ := workflow.GetSignalChannel(ctx, SignalPaymentAuthenticated).ReceiveWithTimeout(ctx, paymentAuthenticationTimeout, &authenticated)
+		if !received || !authenticated {
+			payment.Status = ordersv1.PaymentStatus_PAYMENT_STATUS_DECLINED
+			payment.FailureReason = "3-D Secure authentication failed or timed out"
+			return errors.New(payment.FailureReason)
+		}
+
+		err = workflow.ExecuteActivity(paymentCtx, orderActivityClient.CompletePaymentAuthentication, payment).Get(ctx, &payment)
+		if err != nil {
+			order.Payment.Status = failedPaymentStatus(err)
+			order.Payment.FailureReason = err.Error()
+			return fmt.Errorf("failed to complete payment authentication: %w", err)
+		}
+		order.Payment = payment
+	}
+
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_PAYMENT_AUTHORIZED, ordersv1.OrderStatus_ORDER_STATUS_PROCESSING, "")
+	return nil
+}
+
+// capturePayment takes the authorized funds once the order is persisted.
+func capturePayment(ctx workflow.Context, state *orderState) error {
+	var orderActivityClient *activities.OrderActivity
+	payment := state.order.Payment
+	paymentCtx := workflow.WithActivityOptions(ctx, paymentActivityOptions)
+
+	if err := workflow.ExecuteActivity(paymentCtx, orderActivityClient.CapturePayment, payment).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to capture payment: %w", err)
+	}
+	payment.Status = ordersv1.PaymentStatus_PAYMENT_STATUS_CAPTURED
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_PAYMENT_CAPTURED, state.order.Status, "")
+
+	return recordPayment(ctx, state)
+}
+
+// voidPayment releases an authorization that will not be captured. It is a
+// no-op when nothing was authorized or the payment is already settled.
+func voidPayment(ctx workflow.Context, state *orderState, reason string) {
+	var orderActivityClient *activities.OrderActivity
+	payment := state.order.Payment
+	if payment == nil || payment.AuthorizationId == "" {
+		return
+	}
+	if payment.Status == ordersv1.PaymentStatus_PAYMENT_STATUS_CAPTURED || payment.Status == ordersv1.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		return
+	}
+
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.VoidPayment, payment).Get(ctx, nil); err != nil {
+		logger.Workflow(ctx).Error("failed to void payment", "authorization_id", payment.AuthorizationId, "error", err)
+		return
+	}
+	payment.Status = ordersv1.PaymentStatus_PAYMENT_STATUS_VOIDED
+	payment.FailureReason = reason
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_PAYMENT_VOIDED, state.order.Status, reason)
+}
+
+// refundPayment gives the customer back the captured amount of a cancelled
+// order. It is a no-op when nothing was captured.
+func refundPayment(ctx workflow.Context, state *orderState) error {
+	var orderActivityClient *activities.OrderActivity
+	payment := state.order.Payment
+	if payment == nil || payment.Status != ordersv1.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		return nil
+	}
+
+	// retried until it succeeds; a cancelled order must not keep the money
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.RefundCancelledOrder, state.order.OrderId, payment).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to refund payment: %w", err)
+	}
+	payment.Status = ordersv1.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_PAYMENT_REFUNDED, state.order.Status, "")
+
+	return recordPayment(ctx, state)
+}
+
+// recordPayment stores the payment state on the persisted order.
+func recordPayment(ctx workflow.Context, state *orderState) error {
+	var orderActivityClient *activities.OrderActivity
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.RecordPayment, state.order.OrderId, state.order.Payment).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to record payment: %w", err)
+	}
+	return nil
+}
+
+func failedPaymentStatus(err error) ordersv1.PaymentStatus {
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && (appErr.Type() == activities.ErrTypePaymentDeclined || appErr.Type() == activities.ErrTypePaymentAuthenticationFailed) {
+		return ordersv1.PaymentStatus_PAYMENT_STATUS_DECLINED
+	}
+	return ordersv1.PaymentStatus_PAYMENT_STATUS_FAILED
+}
@@ -0,0 +1,27 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"go.temporal.io/sdk/workflow"
+)
+
+// priceItems prices the items of the order in its currency from the current
+// product prices, whatever the client sent, and keeps the exchange rates the
+// prices were converted at with the order.
+func priceItems(ctx workflow.Context, state *orderState) error {
+	order := state.order
+	var orderActivityClient *activities.OrderActivity
+
+	var priced *activities.PricedItems
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.PriceItems, order.Currency, order.Items).Get(ctx, &priced)
+	if err != nil {
+		return fmt.Errorf("failed to price order: %w", err)
+	}
+
+	order.Currency = priced.Currency
+	order.Items = priced.Items
+	order.ExchangeRates = priced.Rates
+	return nil
+}
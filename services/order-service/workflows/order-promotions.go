@@ -0,0 +1,39 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/workflow"
+)
+
+// applyCoupons evaluates the coupons of the order, redeems them for the
+// customer and records what they take off as discount lines.
+func applyCoupons(ctx workflow.Context, state *orderState) error {
+	order := state.order
+	if len(order.CouponCodes) == 0 {
+		return nil
+	}
+	var orderActivityClient *activities.OrderActivity
+
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.ApplyCoupons, order.OrderId, order.CustomerId, order.CouponCodes, order.Items, order.Currency).Get(ctx, &order.Discounts)
+	if err != nil {
+		return fmt.Errorf("failed to apply coupons: %w", err)
+	}
+	state.couponsRedeemed = true
+	return nil
+}
+
+// releaseCoupons gives back the coupon uses of an order that failed.
+func releaseCoupons(ctx workflow.Context, state *orderState) {
+	if !state.couponsRedeemed {
+		return
+	}
+	var orderActivityClient *activities.OrderActivity
+
+	order := state.order
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.ReleaseCoupons, order.OrderId, order.CustomerId, order.CouponCodes).Get(ctx, nil); err != nil {
+		logger.Workflow(ctx).Error("failed to release coupons", "error", err)
+	}
+}
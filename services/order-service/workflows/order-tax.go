@@ -0,0 +1,44 @@
+package workflows
+
+import (
+	"fmt"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"go.temporal.io/sdk/workflow"
+)
+
+// calculateTax works out the tax on the order once its discounts are known.
+// The jurisdiction is where the order ships to, or where it is billed when
+// nothing is shipped.
+func calculateTax(ctx workflow.Context, state *orderState) error {
+	order := state.order
+	var orderActivityClient *activities.OrderActivity
+
+	address := order.ShippingAddress
+	if address == nil {
+		address = order.BillingAddress
+	}
+
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.CalculateTax, order.OrderId, address, order.Items, order.Discounts).Get(ctx, &order.TaxLines)
+	if err != nil {
+		return fmt.Errorf("failed to calculate tax: %w", err)
+	}
+
+	order.TaxMinor = 0
+	for _, line := range order.TaxLines {
+		order.TaxMinor += line.TaxMinor
+	}
+	return nil
+}
+
+// exclusiveTaxMinor is the tax added on top of the prices of the order.
+func exclusiveTaxMinor(order *ordersv1.Order) int64 {
+	var amount int64
+	for _, line := range order.TaxLines {
+		if !line.Inclusive {
+			amount += line.TaxMinor
+		}
+	}
+	return amount
+}
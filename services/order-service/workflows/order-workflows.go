@@ -1,16 +1,75 @@
 package workflows
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// CreateOrderWorkflow is the temporal workflow that CheckCustomerExists, CheckProductsAvailability and ReserveStock
+// TaskQueue is the task queue the worker polls for order workflows and activities.
+const TaskQueue = "order-service-queue"
+
+const (
+	// QueryOrder returns the current state of the order.
+	QueryOrder = "order"
+	// QueryOrderEvents returns every status transition recorded so far.
+	QueryOrderEvents = "order-events"
+	// UpdateAwaitCreated completes once the order is persisted, or fails with the reason it was not.
+	UpdateAwaitCreated = "await-created"
+	// SignalUpdateStatus moves a created order to SHIPPED, DELIVERED or CANCELLED, or cancels a backordered one.
+	SignalUpdateStatus = "update-status"
+)
+
+// ReservationHoldTTL is how long stock stays held for an order that is not yet
+// paid for. It must outlast the wait for 3-D Secure.
+const ReservationHoldTTL = 20 * time.Minute
+
+// reservationTTLGrace keeps reservation rows around a little longer than the
+// workflow holds them, so they never vanish while the workflow still counts on them.
+const reservationTTLGrace = 5 * time.Minute
+
+// maxAllocationAttempts bounds how often an order is allocated again after
+// another order took the allocated stock first.
+const maxAllocationAttempts = 3
+
+// maxReservationAttempts bounds how often an order checks stock again after
+// other orders took it between the check and the reservation.
+const maxReservationAttempts = 3
+
+// orderState is the workflow-side record of an order, exposed through queries.
+type orderState struct {
+	order   *ordersv1.Order
+	events  []*ordersv1.OrderEvent
+	created bool
+	// backordered is set once the order waits for stock, which is reported to the caller like created
+	backordered bool
+	// couponsRedeemed is set once the coupons of the order count against the customer's limits
+	couponsRedeemed bool
+	failure         error
+}
+
+func (s *orderState) record(ctx workflow.Context, eventType ordersv1.OrderEventType, status ordersv1.OrderStatus, message string) {
+	now := timestamppb.New(workflow.Now(ctx))
+	s.order.Status = status
+	s.order.UpdatedAt = now
+	s.events = append(s.events, &ordersv1.OrderEvent{
+		OrderId:    s.order.OrderId,
+		Type:       eventType,
+		Status:     status,
+		OccurredAt: now,
+		Message:    message,
+	})
+}
+
+// CreateOrderWorkflow is the temporal workflow that CheckCustomerExists, ReserveStock
+// and takes payment, then tracks the order through fulfilment until it is delivered or cancelled
 func CreateOrderWorkflow(ctx workflow.Context, order *ordersv1.Order) error {
 
 	// Define the activity options, including the retry policy
@@ -27,6 +86,60 @@ func CreateOrderWorkflow(ctx workflow.Context, order *ordersv1.Order) error {
 	}
 
 	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	log := logger.Workflow(ctx)
+	log.Info("order workflow started", "customer_id", order.CustomerId, "items", len(order.Items))
+
+	state := &orderState{order: order}
+
+	if err := workflow.SetQueryHandler(ctx, QueryOrder, func() (*ordersv1.Order, error) {
+		return state.order, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := workflow.SetQueryHandler(ctx, QueryOrderEvents, func() ([]*ordersv1.OrderEvent, error) {
+		return state.events, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := workflow.SetUpdateHandler(ctx, UpdateAwaitCreated, func(ctx workflow.Context) error {
+		if err := workflow.Await(ctx, func() bool { return state.created || state.backordered || state.failure != nil }); err != nil {
+			return err
+		}
+		return state.failure
+	}); err != nil {
+		return err
+	}
+
+	if err := placeOrder(ctx, state); err != nil {
+		log.Error("failed to create order", "error", err)
+		state.failure = err
+		state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_CANCELLED, ordersv1.OrderStatus_ORDER_STATUS_CANCELLED, err.Error())
+		releaseCoupons(ctx, state)
+
+		// let a pending await-created update report the failure before the workflow closes
+		_ = workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) })
+		return err
+	}
+
+	state.created = true
+	log.Info("order created")
+
+	if err := trackFulfilment(ctx, state); err != nil {
+		return err
+	}
+
+	_ = workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) })
+	log.Info("order workflow completed", "status", state.order.Status.String())
+	return nil
+
+}
+
+// placeOrder verifies the customer and holds stock, then finalizes the order.
+func placeOrder(ctx workflow.Context, state *orderState) error {
+	order := state.order
 	var orderActivityClient *activities.OrderActivity
 
 	// first check if customer exists
@@ -41,31 +154,244 @@ func CreateOrderWorkflow(ctx workflow.Context, order *ordersv1.Order) error {
 	if !exists {
 		return fmt.Errorf("customer not found")
 	}
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_CUSTOMER_VERIFIED, ordersv1.OrderStatus_ORDER_STATUS_PROCESSING, "")
 
-	// check if products are available
-	var productExists bool
-	err = workflow.ExecuteActivity(ctx, orderActivityClient.CheckProductsAvailability, order.Items).Get(ctx, &productExists)
-	if err != nil {
-		return fmt.Errorf("failed to check products availability: %w", err)
+	if err := priceItems(ctx, state); err != nil {
+		return err
 	}
 
-	if !productExists {
-		return fmt.Errorf("products not found")
+	if err := applyCoupons(ctx, state); err != nil {
+		return err
 	}
 
-	// reserve stock
-	err = workflow.ExecuteActivity(ctx, orderActivityClient.ReserveStock, order.Items).Get(ctx, &productExists)
+	if err := calculateTax(ctx, state); err != nil {
+		return err
+	}
+
+	// out-of-stock items that can be backordered are waited for, and held as
+	// soon as they are back
+	if err := holdStock(ctx, state); err != nil {
+		return err
+	}
+	state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_STOCK_RESERVED, ordersv1.OrderStatus_ORDER_STATUS_PROCESSING, "")
+
+	checkReorderPoints(ctx, order.Items)
+
+	return finalizeOrder(ctx, state)
+}
+
+// holdStock reserves the items of the order, waiting for the short ones as
+// backorders. When other orders take the stock between the check and the
+// reservation, the order checks again, at most maxReservationAttempts times.
+func holdStock(ctx workflow.Context, state *orderState) error {
+	order := state.order
+
+	var err error
+	for attempt := 0; attempt < maxReservationAttempts; attempt++ {
+		var reserved bool
+		reserved, err = awaitBackorders(ctx, state)
+		if err != nil || reserved {
+			return err
+		}
+
+		// ReserveStock checks availability itself, after writing the hold
+		err = reserveStock(ctx, order)
+		if err == nil || !isInsufficientStock(err) {
+			return err
+		}
+		logger.Workflow(ctx).Warn("stock taken by another order, checking again", "attempt", attempt+1)
+	}
+	return err
+}
 
+// reserveStock holds the items of the order. The row TTL outlives the workflow
+// timer, so the workflow decides when a hold expires and the TTL only cleans up
+// after workflows that died.
+func reserveStock(ctx workflow.Context, order *ordersv1.Order) error {
+	var orderActivityClient *activities.OrderActivity
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.ReserveStock, order.OrderId, order.Items, ReservationHoldTTL+reservationTTLGrace).Get(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to reserve stock: %w", err)
 	}
+	return nil
+}
 
-	// create order
-	err = workflow.ExecuteActivity(ctx, orderActivityClient.CreateOrder, order.Items, order.OrderId, order.CustomerId, order.Status).Get(ctx, nil)
-	if err != nil {
-		return err
+// isInsufficientStock reports whether an activity failed because other orders hold the stock.
+func isInsufficientStock(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == activities.ErrTypeInsufficientStock
+}
+
+// finalizeOrder authorizes payment and persists the order while the stock is
+// held, then confirms the reservation and only then captures the payment, so
+// money is never taken for stock the order did not get. If the hold expires
+// first, or any step fails, the payment is voided, stock already confirmed is
+// given back, the hold released and a persisted order cancelled.
+func finalizeOrder(ctx workflow.Context, state *orderState) error {
+	order := state.order
+	var orderActivityClient *activities.OrderActivity
+
+	holdCtx, cancelHold := workflow.WithCancel(ctx)
+	defer cancelHold()
+
+	expired := false
+	workflow.Go(holdCtx, func(ctx workflow.Context) {
+		if err := workflow.NewTimer(ctx, ReservationHoldTTL).Get(ctx, nil); err == nil {
+			expired = true
+			cancelHold()
+		}
+	})
+
+	persisted := false
+	err := authorizePayment(holdCtx, state)
+	if err == nil {
+		err = workflow.ExecuteActivity(holdCtx, orderActivityClient.CreateOrder, order.Items, order.OrderId, order.CustomerId, ordersv1.OrderStatus_ORDER_STATUS_CREATED.String(), order.Currency, order.ExchangeRates).Get(holdCtx, nil)
+		if err == nil {
+			persisted = true
+			state.record(ctx, ordersv1.OrderEventType_ORDER_EVENT_TYPE_CREATED, ordersv1.OrderStatus_ORDER_STATUS_CREATED, "")
+		}
+	}
+	if err == nil && (order.ShippingAddress != nil || order.BillingAddress != nil) {
+		err = workflow.ExecuteActivity(holdCtx, orderActivityClient.RecordAddresses, order.OrderId, order.ShippingAddress, order.BillingAddress).Get(holdCtx, nil)
+	}
+	if err == nil && len(order.Discounts) > 0 {
+		err = workflow.ExecuteActivity(holdCtx, orderActivityClient.RecordDiscounts, order.OrderId, order.Discounts).Get(holdCtx, nil)
+	}
+	if err == nil && len(order.TaxLines) > 0 {
+		err = workflow.ExecuteActivity(holdCtx, orderActivityClient.RecordTax, order.OrderId, order.TaxLines, order.TaxMinor).Get(holdCtx, nil)
+	}
+	if err == nil {
+		err = recordPayment(holdCtx, state)
 	}
 
-	return nil
+	confirming := false
+	if err == nil && !expired {
+		// the hold is turned into stock while the authorization is open; the
+		// timer is stopped so it cannot cancel the confirmation halfway
+		cancelHold()
+		confirming = true
+		err = allocateShipments(ctx, state)
+		if err == nil {
+			err = capturePayment(ctx, state)
+		}
+		if err == nil {
+			return nil
+		}
+	}
+
+	if expired {
+		err = errors.New("stock reservation expired before the order was paid")
+	}
+
+	voidPayment(ctx, state, err.Error())
+
+	if confirming {
+		if revertErr := workflow.ExecuteActivity(ctx, orderActivityClient.RevertConfirmation, order.OrderId).Get(ctx, nil); revertErr != nil {
+			logger.Workflow(ctx).Error("failed to give back confirmed stock", "error", revertErr)
+		}
+	}
+
+	if releaseErr := workflow.ExecuteActivity(ctx, orderActivityClient.ReleaseReservation, order.OrderId, order.Items).Get(ctx, nil); releaseErr != nil {
+		logger.Workflow(ctx).Error("failed to release stock reservation", "error", releaseErr)
+	}
 
+	if persisted {
+		cancelErr := workflow.ExecuteActivity(ctx, orderActivityClient.UpdateOrderStatus, order.OrderId, ordersv1.OrderStatus_ORDER_STATUS_CANCELLED.String()).Get(ctx, nil)
+		if cancelErr != nil {
+			logger.Workflow(ctx).Error("failed to cancel order after payment failure", "error", cancelErr)
+		}
+	}
+
+	return err
+}
+
+// allocateShipments splits the order across warehouses and confirms the stock
+// reservation there, allocating again when another order took the stock first.
+func allocateShipments(ctx workflow.Context, state *orderState) error {
+	order := state.order
+	var orderActivityClient *activities.OrderActivity
+
+	var err error
+	for attempt := 0; attempt < maxAllocationAttempts; attempt++ {
+		var shipments []*ordersv1.Shipment
+		err = workflow.ExecuteActivity(ctx, orderActivityClient.AllocateShipments, order.Items, order.ShipTo).Get(ctx, &shipments)
+		if err != nil {
+			return fmt.Errorf("failed to allocate order: %w", err)
+		}
+
+		err = workflow.ExecuteActivity(ctx, orderActivityClient.ConfirmReservation, order.OrderId, order.Items, shipments).Get(ctx, nil)
+		if err == nil {
+			order.Shipments = shipments
+			return nil
+		}
+
+		var appErr *temporal.ApplicationError
+		if !errors.As(err, &appErr) || appErr.Type() != activities.ErrTypeAllocationConflict {
+			break
+		}
+		logger.Workflow(ctx).Warn("allocation went stale, allocating again", "attempt", attempt+1)
+	}
+	return fmt.Errorf("failed to confirm stock reservation: %w", err)
+}
+
+// trackFulfilment applies status updates signalled by the order service until
+// the order reaches DELIVERED or CANCELLED.
+func trackFulfilment(ctx workflow.Context, state *orderState) error {
+	var orderActivityClient *activities.OrderActivity
+	log := logger.Workflow(ctx)
+	updates := workflow.GetSignalChannel(ctx, SignalUpdateStatus)
+
+	for {
+		var status ordersv1.OrderStatus
+		updates.Receive(ctx, &status)
+
+		eventType, err := StatusTransition(state.order.Status, status)
+		if err != nil {
+			log.Warn("ignoring status update", "status", status.String(), "error", err)
+			continue
+		}
+
+		err = workflow.ExecuteActivity(ctx, orderActivityClient.UpdateOrderStatus, state.order.OrderId, status.String()).Get(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to update order status: %w", err)
+		}
+		state.record(ctx, eventType, status, "")
+
+		if status == ordersv1.OrderStatus_ORDER_STATUS_CANCELLED {
+			if err := cancelFulfilment(ctx, state); err != nil {
+				return err
+			}
+			releaseCoupons(ctx, state)
+		}
+		if status == ordersv1.OrderStatus_ORDER_STATUS_DELIVERED || status == ordersv1.OrderStatus_ORDER_STATUS_CANCELLED {
+			return nil
+		}
+	}
+}
+
+// cancelFulfilment undoes a paid order that is cancelled before it ships: the
+// confirmed stock is given back and the captured payment refunded.
+func cancelFulfilment(ctx workflow.Context, state *orderState) error {
+	var orderActivityClient *activities.OrderActivity
+
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.RevertConfirmation, state.order.OrderId).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to give back confirmed stock: %w", err)
+	}
+	return refundPayment(ctx, state)
+}
+
+// StatusTransition validates a fulfilment status transition and returns the event it produces.
+func StatusTransition(from, to ordersv1.OrderStatus) (ordersv1.OrderEventType, error) {
+	switch {
+	case to == ordersv1.OrderStatus_ORDER_STATUS_SHIPPED && from == ordersv1.OrderStatus_ORDER_STATUS_CREATED:
+		return ordersv1.OrderEventType_ORDER_EVENT_TYPE_SHIPPED, nil
+	case to == ordersv1.OrderStatus_ORDER_STATUS_DELIVERED && from == ordersv1.OrderStatus_ORDER_STATUS_SHIPPED:
+		return ordersv1.OrderEventType_ORDER_EVENT_TYPE_DELIVERED, nil
+	case to == ordersv1.OrderStatus_ORDER_STATUS_CANCELLED && from == ordersv1.OrderStatus_ORDER_STATUS_CREATED:
+		return ordersv1.OrderEventType_ORDER_EVENT_TYPE_CANCELLED, nil
+	case to == ordersv1.OrderStatus_ORDER_STATUS_CANCELLED && from == ordersv1.OrderStatus_ORDER_STATUS_BACKORDERED:
+		return ordersv1.OrderEventType_ORDER_EVENT_TYPE_CANCELLED, nil
+	default:
+		return ordersv1.OrderEventType_ORDER_EVENT_TYPE_UNSPECIFIED, errors.New("invalid status transition from " + from.String())
+	}
 }
@@ -0,0 +1,79 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// PurgeOptions controls which deleted products PurgeDeletedProductsWorkflow removes.
+type PurgeOptions struct {
+	// Retention is how long a deleted product can be restored before it is purged.
+	Retention time.Duration
+}
+
+// PurgeDeletedProductsWorkflow hard-deletes the products that were deleted
+// longer than the retention period ago. Products that any order refers to stay
+// deleted but are never purged, so order history keeps its products.
+func PurgeDeletedProductsWorkflow(ctx workflow.Context, opts PurgeOptions) error {
+	var purgeActivityClient *activities.PurgeActivity
+
+	// scans run long, so they heartbeat to be retried promptly when a worker dies
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	log := logger.Workflow(ctx)
+
+	deletedBefore := workflow.Now(ctx).Add(-opts.Retention)
+	var candidates []activities.PurgeCandidate
+	if err := workflow.ExecuteActivity(ctx, purgeActivityClient.ListPurgeableProducts, deletedBefore).Get(ctx, &candidates); err != nil {
+		return fmt.Errorf("failed to list deleted products: %w", err)
+	}
+	if len(candidates) == 0 {
+		log.Info("no deleted products to purge")
+		return nil
+	}
+
+	productIds := make([]int64, len(candidates))
+	for i, c := range candidates {
+		productIds[i] = c.ProductID
+	}
+	var referenced []int64
+	if err := workflow.ExecuteActivity(ctx, purgeActivityClient.ProductsInOrders, productIds).Get(ctx, &referenced); err != nil {
+		return fmt.Errorf("failed to find products in orders: %w", err)
+	}
+	kept := make(map[int64]bool, len(referenced))
+	for _, id := range referenced {
+		kept[id] = true
+	}
+
+	purged := 0
+	for _, c := range candidates {
+		if kept[c.ProductID] {
+			continue
+		}
+		var ok bool
+		if err := workflow.ExecuteActivity(ctx, purgeActivityClient.PurgeProduct, c).Get(ctx, &ok); err != nil {
+			return fmt.Errorf("failed to purge product %d: %w", c.ProductID, err)
+		}
+		if ok {
+			purged++
+		}
+	}
+
+	log.Info("deleted products purged", "deleted_before", deletedBefore, "purged", purged, "kept_for_orders", len(referenced))
+	return nil
+}
@@ -0,0 +1,81 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ReconcileOptions controls what ReconcileInventoryWorkflow does about the drift it finds.
+type ReconcileOptions struct {
+	AutoCorrect bool
+	// MaxAutoCorrect is the largest drift, in units, that is corrected
+	// automatically; larger drift is only reported.
+	MaxAutoCorrect int32
+}
+
+// ReconcileInventoryWorkflow compares the stock of every product with the
+// stock its confirmed orders and ledger imply, optionally corrects small drift
+// and writes what it found to the drift report table.
+func ReconcileInventoryWorkflow(ctx workflow.Context, opts ReconcileOptions) error {
+	var reconcileActivityClient *activities.ReconcileActivity
+
+	// scans run long, so they heartbeat to be retried promptly when a worker dies
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		HeartbeatTimeout:    time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+			MaximumAttempts:    5,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	log := logger.Workflow(ctx)
+	runId := workflow.GetInfo(ctx).WorkflowExecution.RunID
+
+	var confirmed map[int64]int32
+	if err := workflow.ExecuteActivity(ctx, reconcileActivityClient.ConfirmedOrderQuantities).Get(ctx, &confirmed); err != nil {
+		return fmt.Errorf("failed to sum confirmed orders: %w", err)
+	}
+
+	var drifts []activities.StockDrift
+	if err := workflow.ExecuteActivity(ctx, reconcileActivityClient.ComputeStockDrift, confirmed).Get(ctx, &drifts); err != nil {
+		return fmt.Errorf("failed to compute stock drift: %w", err)
+	}
+
+	corrected := 0
+	if opts.AutoCorrect {
+		for i, d := range drifts {
+			if abs(d.Drift()) > opts.MaxAutoCorrect {
+				continue
+			}
+			if err := workflow.ExecuteActivity(ctx, reconcileActivityClient.CorrectStock, d).Get(ctx, &drifts[i].Corrected); err != nil {
+				return fmt.Errorf("failed to correct stock: %w", err)
+			}
+			if drifts[i].Corrected {
+				corrected++
+			}
+		}
+	}
+
+	if err := workflow.ExecuteActivity(ctx, reconcileActivityClient.WriteDriftReport, runId, drifts).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to write drift report: %w", err)
+	}
+
+	log.Info("inventory reconciled", "run_id", runId, "drifted", len(drifts), "corrected", corrected)
+	return nil
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
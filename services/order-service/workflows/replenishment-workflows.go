@@ -0,0 +1,142 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// ReplenishmentPollInterval is how often a replenishment checks whether stock is back.
+	ReplenishmentPollInterval = 15 * time.Minute
+	// ReplenishmentTimeout is how long a purchase order may stay open before it is expired.
+	ReplenishmentTimeout = 30 * 24 * time.Hour
+	// replenishmentPollsPerRun is how many times a run polls before it
+	// continues as new, which keeps the history of a month-long wait small.
+	replenishmentPollsPerRun = 96
+)
+
+// ReplenishmentProgress is what a replenishment carries into the next run when
+// it continues as new.
+type ReplenishmentProgress struct {
+	PurchaseOrder activities.PurchaseOrder
+	LastAvailable int
+}
+
+// ReplenishmentWorkflow raises a purchase order for a product on its first
+// low-stock alert, then waits until the available stock is back at the reorder
+// threshold. It runs under ReplenishmentWorkflowID, so alerts that arrive while
+// it waits are absorbed instead of raising another purchase order. A run that
+// continues as new gets the open purchase order as progress and keeps waiting.
+func ReplenishmentWorkflow(ctx workflow.Context, productId int64, progress *ReplenishmentProgress) error {
+	var orderActivityClient *activities.OrderActivity
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			MaximumInterval:    time.Minute,
+			BackoffCoefficient: 2,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	log := logger.Workflow(ctx)
+	alerts := workflow.GetSignalChannel(ctx, activities.SignalLowStock)
+
+	if progress == nil {
+		var alert activities.LowStockAlert
+		alerts.Receive(ctx, &alert)
+		log.Info("replenishment started", "product_id", productId, "available", alert.Available, "threshold", alert.Threshold)
+
+		po := activities.PurchaseOrder{
+			ID:        workflow.GetInfo(ctx).WorkflowExecution.RunID,
+			ProductID: productId,
+			Quantity:  alert.ReorderQuantity,
+			Available: alert.Available,
+			Threshold: alert.Threshold,
+			CreatedAt: workflow.Now(ctx),
+		}
+		if err := workflow.ExecuteActivity(ctx, orderActivityClient.CreatePurchaseOrder, po).Get(ctx, nil); err != nil {
+			return fmt.Errorf("failed to create purchase order: %w", err)
+		}
+		progress = &ReplenishmentProgress{PurchaseOrder: po, LastAvailable: alert.Available}
+	}
+	po := progress.PurchaseOrder
+
+	deadline := po.CreatedAt.Add(ReplenishmentTimeout)
+	status := "expired"
+	for polls := 0; workflow.Now(ctx).Before(deadline); polls++ {
+		if polls == replenishmentPollsPerRun || workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			// the alerts are covered by the open purchase order, so none is lost
+			for alerts.ReceiveAsync(nil) {
+			}
+			log.Info("replenishment continues as new", "product_id", productId, "purchase_order_id", po.ID)
+			return workflow.NewContinueAsNewError(ctx, ReplenishmentWorkflow, productId, progress)
+		}
+
+		polled := false
+		selector := workflow.NewSelector(ctx)
+		selector.AddReceive(alerts, func(c workflow.ReceiveChannel, _ bool) {
+			var duplicate activities.LowStockAlert
+			c.Receive(ctx, &duplicate)
+			log.Debug("low-stock alert absorbed by open purchase order", "purchase_order_id", po.ID, "available", duplicate.Available)
+		})
+		selector.AddFuture(workflow.NewTimer(ctx, ReplenishmentPollInterval), func(workflow.Future) {
+			polled = true
+		})
+		for !polled {
+			selector.Select(ctx)
+		}
+
+		var level activities.StockLevel
+		if err := workflow.ExecuteActivity(ctx, orderActivityClient.GetStockLevel, productId).Get(ctx, &level); err != nil {
+			return fmt.Errorf("failed to get stock level: %w", err)
+		}
+		// backordered orders recheck themselves whenever anything arrives
+		if level.Available > progress.LastAvailable && level.Available > 0 {
+			if err := workflow.ExecuteActivity(ctx, orderActivityClient.NotifyBackorders, productId).Get(ctx, nil); err != nil {
+				return fmt.Errorf("failed to notify backordered orders: %w", err)
+			}
+		}
+		progress.LastAvailable = level.Available
+
+		if level.Available >= int(po.Threshold) {
+			status = "fulfilled"
+			break
+		}
+	}
+
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.ClosePurchaseOrder, po.ID, status).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to close purchase order: %w", err)
+	}
+
+	// alerts raised while stock was still low are covered by this purchase order;
+	// drain them so they do not keep the run from completing
+	for alerts.ReceiveAsync(nil) {
+	}
+
+	log.Info("replenishment completed", "product_id", productId, "purchase_order_id", po.ID, "status", status)
+	return nil
+}
+
+// checkReorderPoints alerts replenishment for the items that fell below their
+// reorder threshold. A missed alert must not fail the order; the next order
+// for the product raises it again.
+func checkReorderPoints(ctx workflow.Context, items []*ordersv1.OrderItem) {
+	var orderActivityClient *activities.OrderActivity
+
+	ctx = workflow.WithRetryPolicy(ctx, temporal.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumAttempts:    3,
+	})
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.CheckReorderPoints, items).Get(ctx, nil); err != nil {
+		logger.Workflow(ctx).Warn("failed to check reorder points", "error", err)
+	}
+}
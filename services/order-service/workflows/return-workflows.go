@@ -0,0 +1,225 @@
+package workflows
+
+import (
+	"fmt"
+	"time"
+
+	ordersv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/orders/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// QueryReturn returns the current state of the return.
+	QueryReturn = "return"
+	// UpdateAwaitReturnAccepted completes once the return is accepted, or fails with the reason it was rejected.
+	UpdateAwaitReturnAccepted = "await-accepted"
+	// SignalReturnReceived reports that the returned items arrived at the warehouse.
+	SignalReturnReceived = "return-received"
+)
+
+// ErrTypeReturnRejected is the error type of a return that cannot be accepted.
+const ErrTypeReturnRejected = "ReturnRejected"
+
+// ReturnReceiveTimeout is how long a return waits for the items before it is closed.
+const ReturnReceiveTimeout = 30 * 24 * time.Hour
+
+// returnState is the workflow-side record of a return, exposed through queries.
+type returnState struct {
+	ret      *ordersv1.OrderReturn
+	accepted bool
+	failure  error
+}
+
+// ReturnWorkflow accepts the return of items of a delivered order, waits for them to
+// be received, then restocks them and refunds their price. A return whose items are
+// not received within ReturnReceiveTimeout is closed as expired.
+func ReturnWorkflow(ctx workflow.Context, ret *ordersv1.OrderReturn) error {
+	var orderActivityClient *activities.OrderActivity
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:        time.Second,
+			MaximumInterval:        time.Minute,
+			BackoffCoefficient:     2,
+			NonRetryableErrorTypes: []string{activities.ErrTypeOrderNotFound},
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, ao)
+
+	log := logger.Workflow(ctx)
+	log.Info("return workflow started", "items", len(ret.Items))
+
+	state := &returnState{ret: ret}
+
+	if err := workflow.SetQueryHandler(ctx, QueryReturn, func() (*ordersv1.OrderReturn, error) {
+		return state.ret, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := workflow.SetUpdateHandler(ctx, UpdateAwaitReturnAccepted, func(ctx workflow.Context) error {
+		if err := workflow.Await(ctx, func() bool { return state.accepted || state.failure != nil }); err != nil {
+			return err
+		}
+		return state.failure
+	}); err != nil {
+		return err
+	}
+
+	// the run identifies the return, since an order can be returned more than once
+	returnId := workflow.GetInfo(ctx).WorkflowExecution.RunID
+
+	var order *ordersv1.Order
+	var returned *activities.ReturnedItems
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.GetOrder, ret.OrderId).Get(ctx, &order)
+	if err == nil {
+		err = workflow.ExecuteActivity(ctx, orderActivityClient.GetReturnedItems, ret.OrderId).Get(ctx, &returned)
+	}
+	if err == nil {
+		err = acceptReturn(state, order, returned)
+	}
+	if err == nil {
+		err = workflow.ExecuteActivity(ctx, orderActivityClient.RecordReturn, ret.OrderId, returnId, ret.Items, ret.RefundAmountMinor).Get(ctx, nil)
+	}
+	if err == nil {
+		err = state.setStatus(ctx, ordersv1.ReturnStatus_RETURN_STATUS_REQUESTED)
+	}
+	if err != nil {
+		log.Error("return rejected", "error", err)
+		state.failure = err
+		_ = workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) })
+		return err
+	}
+	state.accepted = true
+
+	// wait for the items to arrive
+	received, _ := workflow.GetSignalChannel(ctx, SignalReturnReceived).ReceiveWithTimeout(ctx, ReturnReceiveTimeout, nil)
+	if !received {
+		log.Info("return expired")
+		if err := workflow.ExecuteActivity(ctx, orderActivityClient.ExpireReturn, ret.OrderId, returnId).Get(ctx, nil); err != nil {
+			return fmt.Errorf("failed to expire return: %w", err)
+		}
+		return state.setStatus(ctx, ordersv1.ReturnStatus_RETURN_STATUS_EXPIRED)
+	}
+
+	if err := state.setStatus(ctx, ordersv1.ReturnStatus_RETURN_STATUS_RECEIVED); err != nil {
+		return err
+	}
+
+	// restock the returned items
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.RestockItems, ret.OrderId, returnId, ret.Items).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to restock returned items: %w", err)
+	}
+
+	// refund their price
+	if err := workflow.ExecuteActivity(ctx, orderActivityClient.RefundPayment, ret.OrderId, returnId, order.Payment, ret.RefundAmountMinor).Get(ctx, nil); err != nil {
+		return fmt.Errorf("failed to refund payment: %w", err)
+	}
+
+	if err := state.setStatus(ctx, ordersv1.ReturnStatus_RETURN_STATUS_REFUNDED); err != nil {
+		return err
+	}
+
+	_ = workflow.Await(ctx, func() bool { return workflow.AllHandlersFinished(ctx) })
+	log.Info("return workflow completed", "refund_amount_minor", ret.RefundAmountMinor)
+	return nil
+}
+
+// acceptReturn checks the order can be returned and resolves the returned items
+// and refund amount against it and against what earlier returns took back.
+func acceptReturn(state *returnState, order *ordersv1.Order, returned *activities.ReturnedItems) error {
+	ret := state.ret
+
+	if ret.CustomerId != 0 && order.CustomerId != ret.CustomerId {
+		return temporal.NewNonRetryableApplicationError("order not found", activities.ErrTypeOrderNotFound, nil)
+	}
+	ret.CustomerId = order.CustomerId
+
+	if order.Status != ordersv1.OrderStatus_ORDER_STATUS_DELIVERED {
+		return rejectReturn("only delivered orders can be returned, order is %s", order.Status)
+	}
+	if order.Payment == nil || order.Payment.Status != ordersv1.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		return rejectReturn("order has no captured payment to refund")
+	}
+
+	ordered := make(map[int64]*ordersv1.OrderItem, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.ProductId] = item
+	}
+
+	// an empty request returns everything that was not returned yet
+	if len(ret.Items) == 0 {
+		for _, item := range order.Items {
+			if remaining := item.Quantity - returned.Quantities[item.ProductId]; remaining > 0 {
+				ret.Items = append(ret.Items, &ordersv1.OrderItem{ProductId: item.ProductId, Quantity: remaining})
+			}
+		}
+		if len(ret.Items) == 0 {
+			return rejectReturn("every item of the order was already returned")
+		}
+	}
+
+	// lines of the same product are merged, so together they cannot exceed what was ordered
+	var items []*ordersv1.OrderItem
+	lines := make(map[int64]*ordersv1.OrderItem, len(ret.Items))
+	for _, item := range ret.Items {
+		if item.Quantity <= 0 {
+			return rejectReturn("cannot return %d of product %d", item.Quantity, item.ProductId)
+		}
+		if line, ok := lines[item.ProductId]; ok {
+			line.Quantity += item.Quantity
+			continue
+		}
+		line := &ordersv1.OrderItem{ProductId: item.ProductId, Quantity: item.Quantity, Sku: item.Sku}
+		lines[item.ProductId] = line
+		items = append(items, line)
+	}
+	ret.Items = items
+
+	var refund int64
+	for _, item := range ret.Items {
+		orderedItem, ok := ordered[item.ProductId]
+		if !ok {
+			return rejectReturn("product %d is not part of the order", item.ProductId)
+		}
+		if remaining := orderedItem.Quantity - returned.Quantities[item.ProductId]; item.Quantity > remaining {
+			return rejectReturn("cannot return %d of product %d, %d were ordered and %d can still be returned", item.Quantity, item.ProductId, orderedItem.Quantity, max(remaining, 0))
+		}
+		item.Sku = orderedItem.Sku
+		item.Price = orderedItem.Price
+		item.UnitPriceMinor = orderedItem.UnitPriceMinor
+		refund += itemAmountMinor(item)
+	}
+
+	// discounts and tax are shared out over the items in proportion to their price
+	if gross := orderAmountMinor(order.Items); gross > 0 && gross != order.Payment.AmountMinor {
+		refund = refund * order.Payment.AmountMinor / gross
+	}
+
+	// never refund more than was captured, counting what earlier returns refunded
+	ret.RefundAmountMinor = max(min(refund, order.Payment.AmountMinor-returned.RefundAmountMinor), 0)
+	return nil
+}
+
+func rejectReturn(format string, args ...any) error {
+	return temporal.NewNonRetryableApplicationError(fmt.Sprintf(format, args...), ErrTypeReturnRejected, nil)
+}
+
+// setStatus records the return status on the order and in the workflow state.
+func (s *returnState) setStatus(ctx workflow.Context, status ordersv1.ReturnStatus) error {
+	var orderActivityClient *activities.OrderActivity
+
+	err := workflow.ExecuteActivity(ctx, orderActivityClient.UpdateReturnStatus, s.ret.OrderId, status.String(), s.ret.RefundAmountMinor).Get(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update return status: %w", err)
+	}
+
+	s.ret.Status = status
+	s.ret.UpdatedAt = timestamppb.New(workflow.Now(ctx))
+	return nil
+}
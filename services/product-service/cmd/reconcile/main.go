@@ -0,0 +1,65 @@
+// Command reconcile recomputes the stock of every product from the stock ledger
+// and reports the products whose stock drifted from it. It exits with status 1
+// when any drift is found.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+)
+
+func main() {
+	cfg := pkg.Config{}
+
+	if err := cfg.LoadConfig("config.yaml"); err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger.New("reconcile", cfg.Logging))
+
+	if err := godotenv.Load(); err != nil {
+		slog.Error("failed to load .env file", "error", err)
+		os.Exit(1)
+	}
+
+	astraCfg := &database.AstraConfig{
+		Username: cfg.Database.Username,
+		Path:     cfg.Database.Path,
+		Token:    helpers.GetEnvOrDefault("ASTRA_TOKEN", ""),
+	}
+
+	db := database.NewAstraDB()
+	session, err := db.Connect(context.Background(), astraCfg, 30*time.Second)
+	if err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer session.Close()
+
+	drifts, err := ledger.New(session, "products_keyspace").Reconcile(context.Background(), "products_keyspace.products")
+	if err != nil {
+		slog.Error("failed to reconcile stock", "error", err)
+		os.Exit(1)
+	}
+
+	if len(drifts) == 0 {
+		fmt.Println("no drift: products.stock matches the stock ledger")
+		return
+	}
+
+	fmt.Printf("%-20s %10s %10s %10s\n", "PRODUCT", "STOCK", "LEDGER", "DRIFT")
+	for _, d := range drifts {
+		fmt.Printf("%-20d %10d %10d %+10d\n", d.ProductID, d.Stock, d.Ledger, d.Stock-d.Ledger)
+	}
+	os.Exit(1)
+}
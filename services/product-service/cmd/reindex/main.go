@@ -0,0 +1,84 @@
+// Command reindex rebuilds the product search index from Cassandra. The
+// product service keeps the index open, so it has to be stopped first.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/search"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+)
+
+func main() {
+	cfg := pkg.Config{}
+
+	if err := cfg.LoadConfig("config.yaml"); err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(logger.New("reindex", cfg.Logging))
+
+	if err := godotenv.Load(); err != nil {
+		slog.Error("failed to load .env file", "error", err)
+		os.Exit(1)
+	}
+
+	// opening the index first fails while the product service holds it; it
+	// indexes nothing, so it needs no converter
+	index, err := search.Open(cfg.Search.IndexPath, nil, cfg.Search.Currency)
+	if err != nil {
+		slog.Error("failed to open search index, is product-service still running?", "error", err)
+		os.Exit(1)
+	}
+	if err := index.Close(); err != nil {
+		slog.Error("failed to close search index", "error", err)
+		os.Exit(1)
+	}
+
+	astraCfg := &database.AstraConfig{
+		Username: cfg.Database.Username,
+		Path:     cfg.Database.Path,
+		Token:    helpers.GetEnvOrDefault("ASTRA_TOKEN", ""),
+	}
+
+	db := database.NewAstraDB()
+	session, err := db.Connect(context.Background(), astraCfg, 30*time.Second)
+	if err != nil {
+		slog.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer session.Close()
+
+	// the index is created again, so changes to its mapping take effect too
+	if err := os.RemoveAll(cfg.Search.IndexPath); err != nil {
+		slog.Error("failed to remove search index", "error", err)
+		os.Exit(1)
+	}
+	index, err = search.Open(cfg.Search.IndexPath, money.NewConverter(session, "products_keyspace"), cfg.Search.Currency)
+	if err != nil {
+		slog.Error("failed to create search index", "error", err)
+		os.Exit(1)
+	}
+	defer index.Close()
+
+	var defaultLocation string
+	if len(cfg.Inventory.Warehouses) > 0 {
+		defaultLocation = cfg.Inventory.Warehouses[0].ID
+	}
+	indexed, err := index.Refresh(context.Background(), repository.NewProductRepository(session, defaultLocation))
+	if err != nil {
+		slog.Error("failed to index products", "error", err)
+		os.Exit(1)
+	}
+	fmt.Printf("indexed %d products\n", indexed)
+}
@@ -11,14 +11,26 @@ import (
 	"syscall"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/joho/godotenv"
-	"github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1/v1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1/moneyv1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1/productsv1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/backorders"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/controllers"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/search"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
 	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ratelimit"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/tracing"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/workflow"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -30,6 +42,7 @@ func main() {
 		slog.Error("failed to load config", "error", err)
 		os.Exit(1)
 	}
+	slog.SetDefault(logger.New("product-service", cfg.Logging))
 	if err := snowflake.InitSonyFlake(); err != nil {
 		slog.Error("failed to initialize snowflake", "error", err)
 		os.Exit(1)
@@ -40,6 +53,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	shutdownTracer, err := tracing.InitTracer("product-service", cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			slog.Error("failed to shutdown tracing", "error", err)
+		}
+	}()
+
 	astraCfg := &database.AstraConfig{
 		Username: cfg.Database.Username,
 		Path:     cfg.Database.Path,
@@ -54,14 +78,85 @@ func main() {
 	}
 	defer session.Close()
 
+	temporalInterceptor, err := tracing.TemporalInterceptor()
+	if err != nil {
+		slog.Error("failed to create temporal tracing interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// restocks wake the backordered orders waiting for the product
+	temporalClient, err := client.Dial(client.Options{
+		Logger:             logger.NewTemporalLogger(slog.Default()),
+		Interceptors:       []interceptor.ClientInterceptor{temporalInterceptor},
+		ContextPropagators: []workflow.ContextPropagator{logger.NewCorrelationPropagator()},
+	})
+	if err != nil {
+		slog.Error("Unable to create client", "error", err)
+		os.Exit(1)
+	}
+
+	defer temporalClient.Close()
+
 	productServiceAddr := fmt.Sprintf("localhost:%d", cfg.ProductServer.Port)
-	productRepository := repository.NewProductRepository(session)
-	productController := controllers.NewProductController(productRepository)
+	var defaultLocation string
+	if len(cfg.Inventory.Warehouses) > 0 {
+		defaultLocation = cfg.Inventory.Warehouses[0].ID
+	}
+	productRepository := repository.NewProductRepository(session, defaultLocation)
+	categoryRepository := repository.NewCategoryRepository(session)
+
+	converter := money.NewConverter(session, "products_keyspace")
+	index, err := search.Open(cfg.Search.IndexPath, converter, cfg.Search.Currency)
+	if err != nil {
+		slog.Error("failed to open search index", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := index.Close(); err != nil {
+			slog.Error("failed to close search index", "error", err)
+		}
+	}()
 
-	productPath, productHandler := v1connect.NewProductServiceHandler(productController)
+	// the index is refreshed from Cassandra in the background: right away when
+	// it is new, then every refresh_interval to pick up stock changed by orders
+	refreshCtx, stopRefreshing := context.WithCancel(context.Background())
+	defer stopRefreshing()
+	go refreshIndex(refreshCtx, index, productRepository, cfg.Search.RefreshInterval)
+
+	productController := controllers.NewProductController(productRepository, categoryRepository, index, backorders.NewNotifier(session, temporalClient, "products_keyspace"), cfg.Inventory.Warehouses)
+	categoryController := controllers.NewCategoryController(categoryRepository)
+	currencyController := controllers.NewCurrencyController(converter)
+
+	otelInterceptor, err := tracing.ConnectInterceptor()
+	if err != nil {
+		slog.Error("failed to create otel interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	authInterceptor, err := auth.NewInterceptor(cfg.Auth)
+	if err != nil {
+		slog.Error("failed to create auth interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// rate limits are hot-reloaded when config.yaml changes
+	rateLimiter := ratelimit.New("product-service", cfg.RateLimit)
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go pkg.WatchConfig(watchCtx, "config.yaml", 10*time.Second, func(c *pkg.Config) {
+		rateLimiter.Update(c.RateLimit)
+	})
+
+	interceptors := connect.WithInterceptors(otelInterceptor, logger.NewInterceptor(), authInterceptor, rateLimiter)
+	productPath, productHandler := productsv1connect.NewProductServiceHandler(productController, interceptors)
+	categoryPath, categoryHandler := productsv1connect.NewCategoryServiceHandler(categoryController, interceptors)
+	// exchange rates are served next to the prices they convert
+	currencyPath, currencyHandler := moneyv1connect.NewCurrencyServiceHandler(currencyController, interceptors)
 
 	mux := http.NewServeMux()
 	mux.Handle(productPath, productHandler)
+	mux.Handle(categoryPath, categoryHandler)
+	mux.Handle(currencyPath, currencyHandler)
 
 	server := &http.Server{
 		Addr:    productServiceAddr,
@@ -98,3 +193,35 @@ func main() {
 	wg.Wait()
 	slog.Info("service shutdown complete")
 }
+
+func refreshIndex(ctx context.Context, index *search.Index, products *repository.ProductRepository, interval time.Duration) {
+	refresh := func() {
+		start := time.Now()
+		indexed, err := index.Refresh(ctx, products)
+		if err != nil {
+			slog.Error("failed to refresh search index", "error", err)
+			return
+		}
+		slog.Info("search index refreshed", "products", indexed, "duration", time.Since(start))
+	}
+
+	if empty, err := index.Empty(); err != nil {
+		slog.Error("failed to read search index", "error", err)
+	} else if empty {
+		refresh()
+	}
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refresh()
+		}
+	}
+}
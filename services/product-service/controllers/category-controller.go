@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1/productsv1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CategoryController struct {
+	productsv1connect.UnimplementedCategoryServiceHandler
+	categoryRepository *repository.CategoryRepository
+}
+
+func NewCategoryController(categoryRepository *repository.CategoryRepository) *CategoryController {
+	return &CategoryController{
+		categoryRepository: categoryRepository,
+	}
+}
+
+var (
+	slugPattern   = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	slugSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// categorySlug validates the slug of a category, or derives it from the name when it is empty.
+func categorySlug(name, slug string) (string, error) {
+	if slug == "" {
+		slug = strings.Trim(slugSeparator.ReplaceAllString(strings.ToLower(name), "-"), "-")
+		if slug == "" {
+			return "", errors.New("slug is required when the name has no letters or digits")
+		}
+		return slug, nil
+	}
+	if !slugPattern.MatchString(slug) {
+		return "", fmt.Errorf("slug %q must be lower case letters and digits separated by dashes", slug)
+	}
+	return slug, nil
+}
+
+// categoryError maps the errors of the category repository to connect codes.
+func categoryError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrSlugTaken):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrInvalidParent):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, repository.ErrCategoryNotEmpty):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (c *CategoryController) CreateCategory(ctx context.Context, req *connect.Request[v1.CreateCategoryRequest]) (*connect.Response[v1.CreateCategoryResponse], error) {
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	slug, err := categorySlug(name, req.Msg.Slug)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	categoryId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	category := &v1.Category{
+		Id:          int64(categoryId),
+		Name:        name,
+		Slug:        slug,
+		ParentId:    req.Msg.ParentId,
+		Description: req.Msg.Description,
+		CreatedAt:   timestamppb.New(time.Now()),
+		UpdatedAt:   timestamppb.New(time.Now()),
+	}
+	if err := c.categoryRepository.CreateCategory(ctx, category); err != nil {
+		if errors.Is(err, repository.ErrCategoryNotFound) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parent: %w", err))
+		}
+		return nil, categoryError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateCategoryResponse{
+		Category: category,
+	}), nil
+}
+
+func (c *CategoryController) GetCategory(ctx context.Context, req *connect.Request[v1.GetCategoryRequest]) (*connect.Response[v1.GetCategoryResponse], error) {
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	category, err := c.categoryRepository.GetCategory(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	ancestors, err := c.categoryRepository.Ancestors(ctx, category)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+	children, err := c.categoryRepository.ListCategories(ctx, category.Id)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+
+	return connect.NewResponse(&v1.GetCategoryResponse{
+		Category:  category,
+		Ancestors: ancestors,
+		Children:  children,
+	}), nil
+}
+
+func (c *CategoryController) UpdateCategory(ctx context.Context, req *connect.Request[v1.UpdateCategoryRequest]) (*connect.Response[v1.UpdateCategoryResponse], error) {
+	name := strings.TrimSpace(req.Msg.Name)
+	if req.Msg.Id == 0 || name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id and name are required"))
+	}
+	slug, err := categorySlug(name, req.Msg.Slug)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	category, err := c.categoryRepository.UpdateCategory(ctx, &v1.Category{
+		Id:          req.Msg.Id,
+		Name:        name,
+		Slug:        slug,
+		ParentId:    req.Msg.ParentId,
+		Description: req.Msg.Description,
+	})
+	if err != nil {
+		return nil, categoryError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateCategoryResponse{
+		Category: category,
+	}), nil
+}
+
+func (c *CategoryController) DeleteCategory(ctx context.Context, req *connect.Request[v1.DeleteCategoryRequest]) (*connect.Response[v1.DeleteCategoryResponse], error) {
+	if req.Msg.Id == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	if err := c.categoryRepository.DeleteCategory(ctx, req.Msg.Id); err != nil {
+		return nil, categoryError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteCategoryResponse{
+		Deleted: true,
+	}), nil
+}
+
+func (c *CategoryController) ListCategories(ctx context.Context, req *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
+	categories, err := c.categoryRepository.ListCategories(ctx, req.Msg.ParentId)
+	if err != nil {
+		return nil, categoryError(err)
+	}
+
+	return connect.NewResponse(&v1.ListCategoriesResponse{
+		Categories: categories,
+	}), nil
+}
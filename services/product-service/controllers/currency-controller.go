@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1/moneyv1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CurrencyController struct {
+	moneyv1connect.UnimplementedCurrencyServiceHandler
+	converter *money.Converter
+}
+
+func NewCurrencyController(converter *money.Converter) *CurrencyController {
+	return &CurrencyController{
+		converter: converter,
+	}
+}
+
+func (c *CurrencyController) SetExchangeRate(ctx context.Context, req *connect.Request[v1.SetExchangeRateRequest]) (*connect.Response[v1.SetExchangeRateResponse], error) {
+	if req.Msg.Rate == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("rate is required"))
+	}
+	if req.Msg.Rate.BaseCurrency == req.Msg.Rate.QuoteCurrency {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("base_currency and quote_currency must differ"))
+	}
+	value, err := money.ParseRate(req.Msg.Rate.Rate)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	rate := money.Rate{Base: req.Msg.Rate.BaseCurrency, Quote: req.Msg.Rate.QuoteCurrency, Rate: value}
+	if err := c.converter.SetRate(ctx, rate); err != nil {
+		if errors.Is(err, money.ErrUnknownCurrency) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	stored, err := c.converter.Rate(ctx, rate.Base, rate.Quote)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.SetExchangeRateResponse{
+		Rate: exchangeRate(stored),
+	}), nil
+}
+
+func (c *CurrencyController) ListExchangeRates(ctx context.Context, req *connect.Request[v1.ListExchangeRatesRequest]) (*connect.Response[v1.ListExchangeRatesResponse], error) {
+	rates, err := c.converter.ListRates(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &v1.ListExchangeRatesResponse{}
+	for _, rate := range rates {
+		resp.Rates = append(resp.Rates, exchangeRate(rate))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (c *CurrencyController) Convert(ctx context.Context, req *connect.Request[v1.ConvertRequest]) (*connect.Response[v1.ConvertResponse], error) {
+	if req.Msg.Amount == nil || req.Msg.Currency == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("amount and currency are required"))
+	}
+
+	amount, rate, err := c.converter.Convert(ctx, req.Msg.Amount.AmountMinor, req.Msg.Amount.Currency, req.Msg.Currency)
+	if err != nil {
+		switch {
+		case errors.Is(err, money.ErrUnknownCurrency):
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case errors.Is(err, money.ErrRateNotFound):
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return connect.NewResponse(&v1.ConvertResponse{
+		Amount: &v1.Money{Currency: req.Msg.Currency, AmountMinor: amount},
+		Rate:   exchangeRate(rate),
+	}), nil
+}
+
+// exchangeRate turns a rate into its API representation.
+func exchangeRate(rate money.Rate) *v1.ExchangeRate {
+	return &v1.ExchangeRate{
+		BaseCurrency:  rate.Base,
+		QuoteCurrency: rate.Quote,
+		Rate:          rate.Rate.String(),
+		UpdatedAt:     timestamppb.New(rate.UpdatedAt),
+	}
+}
@@ -2,32 +2,89 @@ package controllers
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/gocql/gocql"
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
 	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
-	v1connect "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1/v1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1/productsv1connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/backorders"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/search"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/snowflake"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProductController struct {
-	v1connect.UnimplementedProductServiceHandler
-	productRepository *repository.ProductRepository
+	productsv1connect.UnimplementedProductServiceHandler
+	productRepository  *repository.ProductRepository
+	categoryRepository *repository.CategoryRepository
+	index              *search.Index
+	backorders         *backorders.Notifier
+	warehouses         []pkg.Warehouse
 }
 
-func NewProductController(productRepository *repository.ProductRepository) *ProductController {
+func NewProductController(productRepository *repository.ProductRepository, categoryRepository *repository.CategoryRepository, index *search.Index, backorders *backorders.Notifier, warehouses []pkg.Warehouse) *ProductController {
 	return &ProductController{
-		productRepository: productRepository,
+		productRepository:  productRepository,
+		categoryRepository: categoryRepository,
+		index:              index,
+		backorders:         backorders,
+		warehouses:         warehouses,
+	}
+}
+
+// reindex brings the search index up to date with a product that was written.
+// The index is derived from Cassandra, so a failure is only logged; the next
+// refresh repairs it.
+func (c *ProductController) reindex(ctx context.Context, id int64) {
+	if err := c.index.Update(ctx, c.productRepository, id); err != nil {
+		logger.FromContext(ctx).Error("failed to update search index", "product_id", id, "error", err)
 	}
 }
 
 func (c *ProductController) CreateProduct(ctx context.Context, req *connect.Request[v1.CreateProductRequest]) (*connect.Response[v1.CreateProductResponse], error) {
-	if req.Msg.Name == "" || req.Msg.Description == "" || req.Msg.Price == 0 || req.Msg.Currency == "" || req.Msg.ImageUrl == "" || req.Msg.Stock == 0 {
-		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name, description, price, currency, image_url and stock are required"))
+	basePrice := req.Msg.BasePrice
+	if basePrice == nil && req.Msg.Price != 0 {
+		// clients that predate base_price send a floating point price
+		amount, err := money.FromFloat(req.Msg.Price, req.Msg.Currency)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		basePrice = &moneyv1.Money{Currency: req.Msg.Currency, AmountMinor: amount}
+	}
+
+	if req.Msg.Name == "" || req.Msg.Description == "" || basePrice == nil || req.Msg.ImageUrl == "" || req.Msg.Stock == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name, description, base_price, image_url and stock are required"))
+	}
+	if err := validatePrices(basePrice, req.Msg.PriceList); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if req.Msg.ReorderThreshold < 0 || req.Msg.ReorderQuantity < 0 || (req.Msg.ReorderThreshold > 0 && req.Msg.ReorderQuantity == 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reorder_quantity is required when reorder_threshold is set"))
+	}
+
+	taxCategory := req.Msg.TaxCategory
+	if taxCategory == "" {
+		taxCategory = tax.DefaultCategory
+	}
+
+	tags, attributes, err := c.validateCatalog(ctx, req.Msg.CategoryId, req.Msg.Tags, req.Msg.Attributes)
+	if err != nil {
+		return nil, err
 	}
 
 	productId, err := snowflake.GenerateID()
@@ -36,20 +93,33 @@ func (c *ProductController) CreateProduct(ctx context.Context, req *connect.Requ
 	}
 
 	product := &v1.Product{
-		Id:          int64(productId),
-		Name:        req.Msg.Name,
-		Description: req.Msg.Description,
-		Price:       req.Msg.Price,
-		Currency:    req.Msg.Currency,
-		ImageUrl:    req.Msg.ImageUrl,
-		Stock:       req.Msg.Stock,
-		CreatedAt:   timestamppb.New(time.Now()),
-		UpdatedAt:   timestamppb.New(time.Now()),
+		Id:                int64(productId),
+		Name:              req.Msg.Name,
+		Description:       req.Msg.Description,
+		Price:             money.ToFloat(basePrice.AmountMinor, basePrice.Currency),
+		Currency:          basePrice.Currency,
+		BasePrice:         basePrice,
+		PriceList:         req.Msg.PriceList,
+		ImageUrl:          req.Msg.ImageUrl,
+		Stock:             req.Msg.Stock,
+		AvailableStock:    req.Msg.Stock,
+		ReorderThreshold:  req.Msg.ReorderThreshold,
+		ReorderQuantity:   req.Msg.ReorderQuantity,
+		AllowBackorder:    req.Msg.AllowBackorder,
+		ExpectedRestockAt: req.Msg.ExpectedRestockAt,
+		TaxCategory:       taxCategory,
+		CategoryId:        req.Msg.CategoryId,
+		Tags:              tags,
+		Attributes:        attributes,
+		CreatedAt:         timestamppb.New(time.Now()),
+		UpdatedAt:         timestamppb.New(time.Now()),
 	}
 
-	if err := c.productRepository.CreateProduct(product); err != nil {
+	if err := c.productRepository.CreateProduct(ctx, product); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	logger.FromContext(ctx).Info("product created", "product_id", product.Id, "stock", product.Stock)
+	c.reindex(ctx, product.Id)
 
 	return &connect.Response[v1.CreateProductResponse]{
 		Msg: &v1.CreateProductResponse{
@@ -68,8 +138,14 @@ func (c *ProductController) GetProduct(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeInternal, errors.New("cannot parse id to int64"))
 	}
 
-	product, err := c.productRepository.GetProduct(int64(productId))
+	product, err := c.productRepository.GetProduct(ctx, int64(productId), req.Msg.IncludeDeleted)
 	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := c.setVariants(ctx, product); err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
@@ -90,9 +166,13 @@ func (c *ProductController) DeleteProduct(ctx context.Context, req *connect.Requ
 		return nil, connect.NewError(connect.CodeInternal, errors.New("cannot parse id to int64"))
 	}
 
-	if err := c.productRepository.DeleteProduct(int64(productId)); err != nil {
+	if err := c.productRepository.DeleteProduct(ctx, int64(productId)); err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	c.reindex(ctx, int64(productId))
 
 	return &connect.Response[v1.DeleteProductResponse]{
 		Msg: &v1.DeleteProductResponse{
@@ -100,3 +180,661 @@ func (c *ProductController) DeleteProduct(ctx context.Context, req *connect.Requ
 		},
 	}, nil
 }
+
+func (c *ProductController) RestoreProduct(ctx context.Context, req *connect.Request[v1.RestoreProductRequest]) (*connect.Response[v1.RestoreProductResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	product, err := c.productRepository.RestoreProduct(ctx, int64(productId))
+	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		if errors.Is(err, repository.ErrParentDeleted) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := c.setVariants(ctx, product); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.reindex(ctx, product.Id)
+
+	return connect.NewResponse(&v1.RestoreProductResponse{
+		Product: product,
+	}), nil
+}
+
+func (c *ProductController) AdjustInventory(ctx context.Context, req *connect.Request[v1.AdjustInventoryRequest]) (*connect.Response[v1.AdjustInventoryResponse], error) {
+	if req.Msg.Id == "" || req.Msg.LocationId == "" || req.Msg.Delta == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id, location_id and delta are required"))
+	}
+
+	if !slices.ContainsFunc(c.warehouses, func(w pkg.Warehouse) bool { return w.ID == req.Msg.LocationId }) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown location %q", req.Msg.LocationId))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	level, stock, err := c.productRepository.AdjustInventory(ctx, int64(productId), req.Msg.LocationId, req.Msg.Delta)
+	if err != nil {
+		if errors.Is(err, repository.ErrInsufficientStock) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.reindex(ctx, int64(productId))
+
+	// backordered orders wait for a signal; a missed one only delays them until their next recheck
+	if req.Msg.Delta > 0 {
+		notified, err := c.backorders.Notify(ctx, int64(productId))
+		if err != nil {
+			logger.FromContext(ctx).Error("failed to notify backordered orders", "product_id", productId, "error", err)
+		} else if notified > 0 {
+			logger.FromContext(ctx).Info("backordered orders notified", "product_id", productId, "orders", notified)
+		}
+	}
+
+	return connect.NewResponse(&v1.AdjustInventoryResponse{
+		Level: level,
+		Stock: stock,
+	}), nil
+}
+
+func (c *ProductController) GetInventory(ctx context.Context, req *connect.Request[v1.GetInventoryRequest]) (*connect.Response[v1.GetInventoryResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	product, err := c.productRepository.GetProduct(ctx, int64(productId), true)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	levels, err := c.productRepository.GetInventory(ctx, int64(productId))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.GetInventoryResponse{
+		Levels:         levels,
+		Stock:          product.Stock,
+		AvailableStock: product.AvailableStock,
+	}), nil
+}
+
+// defaultMovementsPageSize and maxMovementsPageSize bound a page of ListStockMovements.
+const (
+	defaultMovementsPageSize = 50
+	maxMovementsPageSize     = 500
+)
+
+func (c *ProductController) ListStockMovements(ctx context.Context, req *connect.Request[v1.ListStockMovementsRequest]) (*connect.Response[v1.ListStockMovementsResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	from := time.Unix(0, 0)
+	if req.Msg.StartTime != nil {
+		from = req.Msg.StartTime.AsTime()
+	}
+	to := time.Now()
+	if req.Msg.EndTime != nil {
+		to = req.Msg.EndTime.AsTime()
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultMovementsPageSize
+	}
+	pageSize = min(pageSize, maxMovementsPageSize)
+
+	pageState, err := base64.URLEncoding.DecodeString(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+	}
+
+	movements, next, err := c.productRepository.ListStockMovements(ctx, int64(productId), from, to, pageSize, pageState)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.ListStockMovementsResponse{
+		Movements:     movements,
+		NextPageToken: base64.URLEncoding.EncodeToString(next),
+	}), nil
+}
+
+func (c *ProductController) UpdateReorderPolicy(ctx context.Context, req *connect.Request[v1.UpdateReorderPolicyRequest]) (*connect.Response[v1.UpdateReorderPolicyResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+	if req.Msg.ReorderThreshold < 0 || req.Msg.ReorderQuantity < 0 || (req.Msg.ReorderThreshold > 0 && req.Msg.ReorderQuantity == 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("reorder_quantity is required when reorder_threshold is set"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	if err := c.productRepository.UpdateReorderPolicy(ctx, int64(productId), req.Msg.ReorderThreshold, req.Msg.ReorderQuantity); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	product, err := c.productRepository.GetProduct(ctx, int64(productId), true)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.reindex(ctx, product.Id)
+
+	return connect.NewResponse(&v1.UpdateReorderPolicyResponse{
+		Product: product,
+	}), nil
+}
+
+func (c *ProductController) UpdateBackorderPolicy(ctx context.Context, req *connect.Request[v1.UpdateBackorderPolicyRequest]) (*connect.Response[v1.UpdateBackorderPolicyResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	if err := c.productRepository.UpdateBackorderPolicy(ctx, int64(productId), req.Msg.AllowBackorder, req.Msg.ExpectedRestockAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	product, err := c.productRepository.GetProduct(ctx, int64(productId), true)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.reindex(ctx, product.Id)
+
+	return connect.NewResponse(&v1.UpdateBackorderPolicyResponse{
+		Product: product,
+	}), nil
+}
+
+func (c *ProductController) UpdateTaxCategory(ctx context.Context, req *connect.Request[v1.UpdateTaxCategoryRequest]) (*connect.Response[v1.UpdateTaxCategoryResponse], error) {
+	if req.Msg.Id == "" || req.Msg.TaxCategory == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id and tax_category are required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	if err := c.productRepository.UpdateTaxCategory(ctx, int64(productId), req.Msg.TaxCategory); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	product, err := c.productRepository.GetProduct(ctx, int64(productId), true)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.reindex(ctx, product.Id)
+
+	return connect.NewResponse(&v1.UpdateTaxCategoryResponse{
+		Product: product,
+	}), nil
+}
+
+func (c *ProductController) SetPriceList(ctx context.Context, req *connect.Request[v1.SetPriceListRequest]) (*connect.Response[v1.SetPriceListResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	product, err := c.productRepository.GetProduct(ctx, int64(productId), true)
+	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if product.ParentId != 0 && !product.PriceOverride {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("variant %d takes its prices from product %d until it has a price override", product.Id, product.ParentId))
+	}
+	if err := validatePrices(product.BasePrice, req.Msg.Prices); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := c.productRepository.SetPriceList(ctx, product.Id, req.Msg.Prices); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("product not found"))
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	product.PriceList = req.Msg.Prices
+	c.reindex(ctx, product.Id)
+
+	return connect.NewResponse(&v1.SetPriceListResponse{
+		Product: product,
+	}), nil
+}
+
+// validatePrices checks that the base price and the price list are positive
+// amounts in supported currencies, with at most one price per currency.
+func validatePrices(basePrice *moneyv1.Money, priceList []*moneyv1.Money) error {
+	if err := money.Validate(basePrice.Currency); err != nil {
+		return fmt.Errorf("base_price: %w", err)
+	}
+	if basePrice.AmountMinor <= 0 {
+		return errors.New("base_price must be positive")
+	}
+
+	seen := map[string]bool{basePrice.Currency: true}
+	for _, price := range priceList {
+		if err := money.Validate(price.Currency); err != nil {
+			return fmt.Errorf("price_list: %w", err)
+		}
+		if price.AmountMinor <= 0 {
+			return fmt.Errorf("price_list: the price in %s must be positive", price.Currency)
+		}
+		if seen[price.Currency] {
+			return fmt.Errorf("price_list: %s is priced twice, or is the currency of base_price", price.Currency)
+		}
+		seen[price.Currency] = true
+	}
+	return nil
+}
+
+// validateCatalog checks that a category exists and normalizes tags and attributes.
+func (c *ProductController) validateCatalog(ctx context.Context, categoryId int64, tags []string, attributes []*v1.Attribute) ([]string, []*v1.Attribute, error) {
+	if categoryId != 0 {
+		if _, err := c.categoryRepository.GetCategory(ctx, categoryId); err != nil {
+			if errors.Is(err, repository.ErrCategoryNotFound) {
+				return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			return nil, nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	tags, err := repository.NormalizeTags(tags)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	attributes, err = repository.NormalizeAttributes(attributes)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return tags, attributes, nil
+}
+
+func (c *ProductController) UpdateProductCatalog(ctx context.Context, req *connect.Request[v1.UpdateProductCatalogRequest]) (*connect.Response[v1.UpdateProductCatalogResponse], error) {
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id is required"))
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	tags, attributes, err := c.validateCatalog(ctx, req.Msg.CategoryId, req.Msg.Tags, req.Msg.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := c.productRepository.UpdateCatalog(ctx, int64(productId), req.Msg.CategoryId, tags, attributes)
+	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		if errors.Is(err, repository.ErrIsVariant) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	c.reindex(ctx, product.Id)
+
+	return connect.NewResponse(&v1.UpdateProductCatalogResponse{
+		Product: product,
+	}), nil
+}
+
+// defaultListingPageSize and maxListingPageSize bound a page of ListProductsByCategory and ListProductsByTag.
+const (
+	defaultListingPageSize = 20
+	maxListingPageSize     = 100
+)
+
+// listingPage reads the page size and page token of a listing request.
+func listingPage(size int32, token string) (int, []byte, error) {
+	pageSize := int(size)
+	if pageSize <= 0 {
+		pageSize = defaultListingPageSize
+	}
+	pageSize = min(pageSize, maxListingPageSize)
+
+	pageState, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+	}
+	return pageSize, pageState, nil
+}
+
+func (c *ProductController) ListProductsByCategory(ctx context.Context, req *connect.Request[v1.ListProductsByCategoryRequest]) (*connect.Response[v1.ListProductsByCategoryResponse], error) {
+	if req.Msg.CategoryId == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("category_id is required"))
+	}
+	pageSize, pageState, err := listingPage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	products, next, err := c.productRepository.ListProductsByCategory(ctx, req.Msg.CategoryId, pageSize, pageState)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.ListProductsByCategoryResponse{
+		Products:      products,
+		NextPageToken: base64.URLEncoding.EncodeToString(next),
+	}), nil
+}
+
+func (c *ProductController) ListProductsByTag(ctx context.Context, req *connect.Request[v1.ListProductsByTagRequest]) (*connect.Response[v1.ListProductsByTagResponse], error) {
+	tag := strings.ToLower(strings.TrimSpace(req.Msg.Tag))
+	if tag == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tag is required"))
+	}
+	pageSize, pageState, err := listingPage(req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	products, next, err := c.productRepository.ListProductsByTag(ctx, tag, pageSize, pageState)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.ListProductsByTagResponse{
+		Products:      products,
+		NextPageToken: base64.URLEncoding.EncodeToString(next),
+	}), nil
+}
+
+// setVariants fills in the variants of a product; variants have none.
+func (c *ProductController) setVariants(ctx context.Context, product *v1.Product) error {
+	if product.ParentId != 0 {
+		return nil
+	}
+	variants, err := c.productRepository.ListVariants(ctx, product.Id, false)
+	if err != nil {
+		return err
+	}
+	product.Variants = variants
+	return nil
+}
+
+var skuPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9._-]{0,63}$`)
+
+// normalizeSku upper-cases and trims a SKU and checks its characters.
+func normalizeSku(sku string) (string, error) {
+	sku = strings.ToUpper(strings.TrimSpace(sku))
+	if !skuPattern.MatchString(sku) {
+		return "", fmt.Errorf("sku %q must be up to 64 letters, digits, dots, dashes or underscores", sku)
+	}
+	return sku, nil
+}
+
+// validatePriceOverride checks that a price override is a positive amount in the currency of the product.
+func validatePriceOverride(override *moneyv1.Money, currency string) error {
+	if override == nil {
+		return nil
+	}
+	if override.Currency != currency {
+		return fmt.Errorf("price_override must be in %s, the currency of the product", currency)
+	}
+	if override.AmountMinor <= 0 {
+		return errors.New("price_override must be positive")
+	}
+	return nil
+}
+
+// variantError maps the errors of creating and updating variants to connect codes.
+func variantError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, repository.ErrSkuTaken), errors.Is(err, repository.ErrDuplicateVariant):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, repository.ErrIsVariant), errors.Is(err, repository.ErrNotVariant):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (c *ProductController) CreateVariant(ctx context.Context, req *connect.Request[v1.CreateVariantRequest]) (*connect.Response[v1.CreateVariantResponse], error) {
+	if req.Msg.ProductId == "" || req.Msg.Sku == "" || len(req.Msg.Attributes) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("product_id, sku and attributes are required"))
+	}
+	if req.Msg.Stock < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stock cannot be negative"))
+	}
+	sku, err := normalizeSku(req.Msg.Sku)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	attributes, err := repository.NormalizeAttributes(req.Msg.Attributes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	productId, err := strconv.ParseUint(req.Msg.ProductId, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse product_id to int64"))
+	}
+
+	parent, err := c.productRepository.GetProduct(ctx, int64(productId), false)
+	if err != nil {
+		return nil, variantError(err)
+	}
+	if err := validatePriceOverride(req.Msg.PriceOverride, parent.BasePrice.Currency); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	variantId, err := snowflake.GenerateID()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	variant := &v1.Product{
+		Id:             int64(variantId),
+		Sku:            sku,
+		BasePrice:      req.Msg.PriceOverride,
+		Stock:          req.Msg.Stock,
+		AvailableStock: req.Msg.Stock,
+		Attributes:     attributes,
+		CreatedAt:      timestamppb.New(time.Now()),
+		UpdatedAt:      timestamppb.New(time.Now()),
+	}
+	if err := c.productRepository.CreateVariant(ctx, parent, variant); err != nil {
+		return nil, variantError(err)
+	}
+	c.reindex(ctx, parent.Id)
+
+	return connect.NewResponse(&v1.CreateVariantResponse{
+		Variant: variant,
+	}), nil
+}
+
+func (c *ProductController) UpdateVariant(ctx context.Context, req *connect.Request[v1.UpdateVariantRequest]) (*connect.Response[v1.UpdateVariantResponse], error) {
+	if req.Msg.Id == "" || len(req.Msg.Attributes) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("id and attributes are required"))
+	}
+	attributes, err := repository.NormalizeAttributes(req.Msg.Attributes)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	variantId, err := strconv.ParseUint(req.Msg.Id, 10, 64)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("cannot parse id to int64"))
+	}
+
+	variant, err := c.productRepository.GetProduct(ctx, int64(variantId), true)
+	if err != nil {
+		return nil, variantError(err)
+	}
+	if err := validatePriceOverride(req.Msg.PriceOverride, variant.BasePrice.Currency); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	variant, err = c.productRepository.UpdateVariant(ctx, variant, req.Msg.PriceOverride, attributes)
+	if err != nil {
+		return nil, variantError(err)
+	}
+	c.reindex(ctx, variant.ParentId)
+
+	return connect.NewResponse(&v1.UpdateVariantResponse{
+		Variant: variant,
+	}), nil
+}
+
+func (c *ProductController) GetProductBySku(ctx context.Context, req *connect.Request[v1.GetProductBySkuRequest]) (*connect.Response[v1.GetProductBySkuResponse], error) {
+	sku, err := normalizeSku(req.Msg.Sku)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	product, variant, err := c.productRepository.GetProductBySku(ctx, sku)
+	if err != nil {
+		if errors.Is(err, repository.ErrSkuNotFound) || errors.Is(err, repository.ErrProductNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&v1.GetProductBySkuResponse{
+		Product: product,
+		Variant: variant,
+	}), nil
+}
+
+// defaultSearchPageSize and maxSearchPageSize bound a page of SearchProducts.
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+func (c *ProductController) SearchProducts(ctx context.Context, req *connect.Request[v1.SearchProductsRequest]) (*connect.Response[v1.SearchProductsResponse], error) {
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	pageSize = min(pageSize, maxSearchPageSize)
+
+	// the page token is the offset of the page in the results
+	var from int
+	if req.Msg.PageToken != "" {
+		offset, err := base64.URLEncoding.DecodeString(req.Msg.PageToken)
+		if err == nil {
+			from, err = strconv.Atoi(string(offset))
+		}
+		if err != nil || from < 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token"))
+		}
+	}
+
+	result, err := c.index.Search(ctx, search.Request{
+		Text:        strings.TrimSpace(req.Msg.Query),
+		CategoryID:  req.Msg.CategoryId,
+		PriceBucket: req.Msg.PriceBucket,
+		InStockOnly: req.Msg.InStockOnly,
+		From:        from,
+		Size:        pageSize,
+	})
+	if err != nil {
+		if errors.Is(err, search.ErrUnknownPriceBucket) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// products are read from Cassandra, so stock and prices are current
+	resp := &v1.SearchProductsResponse{TotalHits: int64(result.Total)}
+	for _, hit := range result.Hits {
+		product, err := c.productRepository.GetProduct(ctx, hit.ProductID, false)
+		if errors.Is(err, repository.ErrProductNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if err := c.setVariants(ctx, product); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		resp.Hits = append(resp.Hits, &v1.SearchHit{Product: product, Score: hit.Score})
+	}
+
+	categories := &v1.SearchFacet{Name: "category"}
+	for _, value := range result.Categories {
+		label := value.Value
+		if id, err := strconv.ParseInt(value.Value, 10, 64); err == nil {
+			if category, err := c.categoryRepository.GetCategory(ctx, id); err == nil {
+				label = category.Name
+			}
+		}
+		categories.Values = append(categories.Values, &v1.SearchFacetValue{Value: value.Value, Label: label, Count: int32(value.Count)})
+	}
+	prices := &v1.SearchFacet{Name: "price"}
+	for _, value := range result.Prices {
+		for _, bucket := range search.PriceBuckets {
+			if bucket.Name == value.Value {
+				prices.Values = append(prices.Values, &v1.SearchFacetValue{Value: value.Value, Label: bucket.Label + " " + c.index.Currency(), Count: int32(value.Count)})
+			}
+		}
+	}
+	inStock := &v1.SearchFacet{Name: "in_stock"}
+	for _, value := range result.InStock {
+		label := "In stock"
+		if value.Value == "false" {
+			label = "Out of stock"
+		}
+		inStock.Values = append(inStock.Values, &v1.SearchFacetValue{Value: value.Value, Label: label, Count: int32(value.Count)})
+	}
+	resp.Facets = []*v1.SearchFacet{categories, prices, inStock}
+
+	if next := from + len(result.Hits); uint64(next) < result.Total {
+		resp.NextPageToken = base64.URLEncoding.EncodeToString([]byte(strconv.Itoa(next)))
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"gopkg.in/inf.v0"
+)
+
+const (
+	maxTags            = 20
+	maxTagLength       = 40
+	maxAttributes      = 50
+	maxAttributeLength = 100
+)
+
+// NormalizeTags lower-cases and trims tags, drops duplicates and sorts them.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, errors.New("tags cannot be empty")
+		}
+		if len(tag) > maxTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > maxTags {
+		return nil, fmt.Errorf("a product has at most %d tags", maxTags)
+	}
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// NormalizeAttributes lower-cases and trims attribute names, checks that every
+// attribute has a value and a unique name, and sorts them by name.
+func NormalizeAttributes(attributes []*v1.Attribute) ([]*v1.Attribute, error) {
+	if len(attributes) > maxAttributes {
+		return nil, fmt.Errorf("a product has at most %d attributes", maxAttributes)
+	}
+	normalized := make([]*v1.Attribute, 0, len(attributes))
+	seen := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		name := strings.ToLower(strings.TrimSpace(attribute.Name))
+		if name == "" {
+			return nil, errors.New("attribute names are required")
+		}
+		if len(name) > maxAttributeLength || len(attribute.GetText()) > maxAttributeLength {
+			return nil, fmt.Errorf("attribute %q is longer than %d characters", name, maxAttributeLength)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("attribute %q is set twice", name)
+		}
+		seen[name] = true
+
+		normalized = append(normalized, &v1.Attribute{Name: name, Value: attribute.Value, Unit: strings.TrimSpace(attribute.Unit)})
+		switch attribute.Value.(type) {
+		case *v1.Attribute_Text, *v1.Attribute_Flag:
+			if attribute.Unit != "" {
+				return nil, fmt.Errorf("attribute %q: only numbers have a unit", name)
+			}
+		case *v1.Attribute_Number:
+		default:
+			return nil, fmt.Errorf("attribute %q has no value", name)
+		}
+	}
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Name < normalized[j].Name
+	})
+	return normalized, nil
+}
+
+// attributeColumns splits attributes into the maps they are stored in, one per type.
+func attributeColumns(attributes []*v1.Attribute) (texts map[string]string, numbers map[string]float64, flags map[string]bool, units map[string]string) {
+	texts, numbers, flags, units = map[string]string{}, map[string]float64{}, map[string]bool{}, map[string]string{}
+	for _, attribute := range attributes {
+		switch value := attribute.Value.(type) {
+		case *v1.Attribute_Text:
+			texts[attribute.Name] = value.Text
+		case *v1.Attribute_Number:
+			numbers[attribute.Name] = value.Number
+			if attribute.Unit != "" {
+				units[attribute.Name] = attribute.Unit
+			}
+		case *v1.Attribute_Flag:
+			flags[attribute.Name] = value.Flag
+		}
+	}
+	return texts, numbers, flags, units
+}
+
+// attributesFromColumns is the inverse of attributeColumns.
+func attributesFromColumns(texts map[string]string, numbers map[string]float64, flags map[string]bool, units map[string]string) []*v1.Attribute {
+	var attributes []*v1.Attribute
+	for name, text := range texts {
+		attributes = append(attributes, &v1.Attribute{Name: name, Value: &v1.Attribute_Text{Text: text}})
+	}
+	for name, number := range numbers {
+		attributes = append(attributes, &v1.Attribute{Name: name, Value: &v1.Attribute_Number{Number: number}, Unit: units[name]})
+	}
+	for name, flag := range flags {
+		attributes = append(attributes, &v1.Attribute{Name: name, Value: &v1.Attribute_Flag{Flag: flag}})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
+	return attributes
+}
+
+// addListings adds the statements that list a product under its category and
+// each of its tags. The rows are upserted, so they also refresh what they copy.
+func addListings(batch *gocql.Batch, product *v1.Product) error {
+	basePrice, err := money.ToDecimal(product.BasePrice.AmountMinor, product.BasePrice.Currency)
+	if err != nil {
+		return err
+	}
+	if product.CategoryId != 0 {
+		batch.Query(`
+			INSERT INTO products_keyspace.products_by_category (category_id, product_id, name, image_url, base_price, currency, tags)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, product.CategoryId, product.Id, product.Name, product.ImageUrl, basePrice, product.BasePrice.Currency, product.Tags)
+	}
+	for _, tag := range product.Tags {
+		batch.Query(`
+			INSERT INTO products_keyspace.products_by_tag (tag, product_id, name, image_url, base_price, currency, category_id, tags)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`, tag, product.Id, product.Name, product.ImageUrl, basePrice, product.BasePrice.Currency, product.CategoryId, product.Tags)
+	}
+	return nil
+}
+
+// removeListings adds the statements that remove the listings of a product
+// under categoryId and tags.
+func removeListings(batch *gocql.Batch, productId, categoryId int64, tags []string) {
+	if categoryId != 0 {
+		batch.Query(`DELETE FROM products_keyspace.products_by_category WHERE category_id = ? AND product_id = ?`, categoryId, productId)
+	}
+	for _, tag := range tags {
+		batch.Query(`DELETE FROM products_keyspace.products_by_tag WHERE tag = ? AND product_id = ?`, tag, productId)
+	}
+}
+
+// UpdateCatalog replaces the category, tags and attributes of a product and
+// moves its listings along in the same logged batch. The listings of a deleted
+// product were removed when it was deleted and are left alone. Variants have
+// neither category nor tags; their attributes are set with UpdateVariant.
+func (r *ProductRepository) UpdateCatalog(ctx context.Context, id, categoryId int64, tags []string, attributes []*v1.Attribute) (*v1.Product, error) {
+	product, err := r.GetProduct(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if product.ParentId != 0 {
+		// variants are listed through their product
+		return nil, fmt.Errorf("%w: %d", ErrIsVariant, id)
+	}
+
+	texts, numbers, flags, units := attributeColumns(attributes)
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`
+		UPDATE products_keyspace.products
+		SET category_id = ?, tags = ?, text_attributes = ?, number_attributes = ?, bool_attributes = ?, attribute_units = ?, updated_at = ?
+		WHERE id = ?
+	`, categoryId, tags, texts, numbers, flags, units, time.Now(), id)
+
+	if product.DeletedAt == nil {
+		// statements of a batch share a timestamp and a delete wins a tie, so
+		// only the listings the product leaves are removed
+		var oldCategory int64
+		if product.CategoryId != categoryId {
+			oldCategory = product.CategoryId
+		}
+		var oldTags []string
+		for _, tag := range product.Tags {
+			if !slices.Contains(tags, tag) {
+				oldTags = append(oldTags, tag)
+			}
+		}
+		removeListings(batch, id, oldCategory, oldTags)
+	}
+
+	product.CategoryId = categoryId
+	product.Tags = tags
+	product.Attributes = attributes
+	if product.DeletedAt == nil {
+		if err := addListings(batch, product); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return nil, fmt.Errorf("failed to update catalog of product %d: %w", id, err)
+	}
+	logger.FromContext(ctx).Info("product catalog updated", "product_id", id, "category_id", categoryId, "tags", len(tags), "attributes", len(attributes))
+	return product, nil
+}
+
+// ListProductsByCategory returns a page of the products filed directly under a
+// category. pageState is nil for the first page; the returned state is nil after the last.
+func (r *ProductRepository) ListProductsByCategory(ctx context.Context, categoryId int64, pageSize int, pageState []byte) ([]*v1.Product, []byte, error) {
+	query := `
+		SELECT product_id, name, image_url, base_price, currency, category_id, tags
+		FROM products_keyspace.products_by_category
+		WHERE category_id = ?
+	`
+	return r.listProducts(ctx, r.session.Query(query, categoryId), pageSize, pageState)
+}
+
+// ListProductsByTag returns a page of the products with a tag.
+func (r *ProductRepository) ListProductsByTag(ctx context.Context, tag string, pageSize int, pageState []byte) ([]*v1.Product, []byte, error) {
+	query := `
+		SELECT product_id, name, image_url, base_price, currency, category_id, tags
+		FROM products_keyspace.products_by_tag
+		WHERE tag = ?
+	`
+	return r.listProducts(ctx, r.session.Query(query, tag), pageSize, pageState)
+}
+
+// listProducts reads a page of listing rows into products.
+func (r *ProductRepository) listProducts(ctx context.Context, query *gocql.Query, pageSize int, pageState []byte) ([]*v1.Product, []byte, error) {
+	iter := query.WithContext(ctx).PageSize(pageSize).PageState(pageState).Iter()
+
+	var products []*v1.Product
+	var (
+		productId, categoryId int64
+		name, imageUrl        string
+		basePrice             *inf.Dec
+		currency              string
+		tags                  []string
+	)
+	for iter.Scan(&productId, &name, &imageUrl, &basePrice, &currency, &categoryId, &tags) {
+		amount, err := money.FromDecimal(basePrice, currency)
+		if err != nil {
+			iter.Close()
+			return nil, nil, fmt.Errorf("price of product %d: %w", productId, err)
+		}
+		sort.Strings(tags)
+		products = append(products, &v1.Product{
+			Id:         productId,
+			Name:       name,
+			ImageUrl:   imageUrl,
+			Currency:   currency,
+			BasePrice:  &moneyv1.Money{Currency: currency, AmountMinor: amount},
+			Price:      money.ToFloat(amount, currency),
+			CategoryId: categoryId,
+			Tags:       tags,
+		})
+	}
+	next := iter.PageState()
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list products: %w", err)
+	}
+	if len(next) == 0 {
+		next = nil
+	}
+	return products, next, nil
+}
+
+// ProductIDs returns the ids of every product that is neither deleted nor a variant.
+func (r *ProductRepository) ProductIDs(ctx context.Context) ([]int64, error) {
+	iter := r.session.Query(`SELECT id, parent_id, deleted_at FROM products_keyspace.products`).WithContext(ctx).Iter()
+
+	var ids []int64
+	var id, parentId int64
+	var deletedAt time.Time
+	for iter.Scan(&id, &parentId, &deletedAt) {
+		if parentId == 0 && deletedAt.IsZero() {
+			ids = append(ids, id)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+	return ids, nil
+}
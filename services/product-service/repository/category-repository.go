@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gocql/gocql"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxCategoryDepth is how many levels the category tree has at most, root categories included.
+const MaxCategoryDepth = 5
+
+var (
+	// ErrCategoryNotFound is returned for a category, or parent category, that does not exist.
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrSlugTaken is returned when a sibling category already has the slug.
+	ErrSlugTaken = errors.New("slug is taken by a sibling category")
+	// ErrCategoryNotEmpty is returned when deleting a category with subcategories or products.
+	ErrCategoryNotEmpty = errors.New("category has subcategories or products")
+	// ErrInvalidParent is returned when a category would end up under itself or too deep in the tree.
+	ErrInvalidParent = errors.New("invalid parent category")
+)
+
+// CategoryRepository stores the category tree in categories, with a copy of
+// every category in categories_by_parent to list the children of a category.
+type CategoryRepository struct {
+	session *gocql.Session
+}
+
+func NewCategoryRepository(session *gocql.Session) *CategoryRepository {
+	return &CategoryRepository{session: session}
+}
+
+func (r *CategoryRepository) GetCategory(ctx context.Context, id int64) (*v1.Category, error) {
+	query := `
+		SELECT id, name, slug, parent_id, description, created_at, updated_at
+		FROM products_keyspace.categories
+		WHERE id = ?
+	`
+	var category v1.Category
+	var createdAt, updatedAt time.Time
+	if err := r.session.Query(query, id).WithContext(ctx).Scan(&category.Id, &category.Name, &category.Slug, &category.ParentId, &category.Description, &createdAt, &updatedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrCategoryNotFound, id)
+		}
+		return nil, err
+	}
+	category.CreatedAt = timestamppb.New(createdAt)
+	category.UpdatedAt = timestamppb.New(updatedAt)
+	return &category, nil
+}
+
+// ListCategories returns the children of a category, or the root categories
+// for parent 0, sorted by name.
+func (r *CategoryRepository) ListCategories(ctx context.Context, parentId int64) ([]*v1.Category, error) {
+	query := `
+		SELECT category_id, name, slug, description, created_at, updated_at
+		FROM products_keyspace.categories_by_parent
+		WHERE parent_id = ?
+	`
+	iter := r.session.Query(query, parentId).WithContext(ctx).Iter()
+
+	var categories []*v1.Category
+	var (
+		id                      int64
+		name, slug, description string
+		createdAt, updatedAt    time.Time
+	)
+	for iter.Scan(&id, &name, &slug, &description, &createdAt, &updatedAt) {
+		categories = append(categories, &v1.Category{
+			Id:          id,
+			Name:        name,
+			Slug:        slug,
+			ParentId:    parentId,
+			Description: description,
+			CreatedAt:   timestamppb.New(createdAt),
+			UpdatedAt:   timestamppb.New(updatedAt),
+		})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list categories of %d: %w", parentId, err)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Name < categories[j].Name
+	})
+	return categories, nil
+}
+
+// Ancestors returns the categories above a category, from the root down to its parent.
+func (r *CategoryRepository) Ancestors(ctx context.Context, category *v1.Category) ([]*v1.Category, error) {
+	var ancestors []*v1.Category
+	for parentId := category.ParentId; parentId != 0; {
+		if len(ancestors) >= MaxCategoryDepth {
+			return nil, fmt.Errorf("category %d is nested deeper than %d levels", category.Id, MaxCategoryDepth)
+		}
+		parent, err := r.GetCategory(ctx, parentId)
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append([]*v1.Category{parent}, ancestors...)
+		parentId = parent.ParentId
+	}
+	return ancestors, nil
+}
+
+// CreateCategory files a new category under its parent.
+func (r *CategoryRepository) CreateCategory(ctx context.Context, category *v1.Category) error {
+	if err := r.checkParent(ctx, category, 1); err != nil {
+		return err
+	}
+	if err := r.checkSlug(ctx, category); err != nil {
+		return err
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	r.writeCategory(batch, category)
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+	logger.FromContext(ctx).Info("category created", "category_id", category.Id, "parent_id", category.ParentId)
+	return nil
+}
+
+// UpdateCategory replaces a category. A new parent moves the category together
+// with its subcategories, as long as it is not one of them.
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, category *v1.Category) (*v1.Category, error) {
+	current, err := r.GetCategory(ctx, category.Id)
+	if err != nil {
+		return nil, err
+	}
+	if category.ParentId != current.ParentId {
+		height, err := r.height(ctx, category.Id, 1)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.checkParent(ctx, category, height); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.checkSlug(ctx, category); err != nil {
+		return nil, err
+	}
+
+	category.CreatedAt = current.CreatedAt
+	category.UpdatedAt = timestamppb.New(time.Now())
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	if category.ParentId != current.ParentId {
+		batch.Query(`DELETE FROM products_keyspace.categories_by_parent WHERE parent_id = ? AND category_id = ?`, current.ParentId, category.Id)
+	}
+	r.writeCategory(batch, category)
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return nil, fmt.Errorf("failed to update category %d: %w", category.Id, err)
+	}
+	logger.FromContext(ctx).Info("category updated", "category_id", category.Id, "parent_id", category.ParentId)
+	return category, nil
+}
+
+// DeleteCategory deletes a category without subcategories or products.
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, id int64) error {
+	category, err := r.GetCategory(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var childId int64
+	err = r.session.Query(`SELECT category_id FROM products_keyspace.categories_by_parent WHERE parent_id = ? LIMIT 1`, id).WithContext(ctx).Scan(&childId)
+	if err == nil {
+		return ErrCategoryNotEmpty
+	}
+	if !errors.Is(err, gocql.ErrNotFound) {
+		return err
+	}
+	var productId int64
+	err = r.session.Query(`SELECT product_id FROM products_keyspace.products_by_category WHERE category_id = ? LIMIT 1`, id).WithContext(ctx).Scan(&productId)
+	if err == nil {
+		return ErrCategoryNotEmpty
+	}
+	if !errors.Is(err, gocql.ErrNotFound) {
+		return err
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`DELETE FROM products_keyspace.categories WHERE id = ?`, id)
+	batch.Query(`DELETE FROM products_keyspace.categories_by_parent WHERE parent_id = ? AND category_id = ?`, category.ParentId, id)
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("failed to delete category %d: %w", id, err)
+	}
+	logger.FromContext(ctx).Info("category deleted", "category_id", id)
+	return nil
+}
+
+// writeCategory adds the statements that write a category and its copy under its parent.
+func (r *CategoryRepository) writeCategory(batch *gocql.Batch, category *v1.Category) {
+	batch.Query(`
+		INSERT INTO products_keyspace.categories (id, name, slug, parent_id, description, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, category.Id, category.Name, category.Slug, category.ParentId, category.Description, category.CreatedAt.AsTime(), category.UpdatedAt.AsTime())
+	batch.Query(`
+		INSERT INTO products_keyspace.categories_by_parent (parent_id, category_id, name, slug, description, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, category.ParentId, category.Id, category.Name, category.Slug, category.Description, category.CreatedAt.AsTime(), category.UpdatedAt.AsTime())
+}
+
+// checkParent checks that the parent of a category exists, is not the category
+// or below it, and leaves room for height levels under it.
+func (r *CategoryRepository) checkParent(ctx context.Context, category *v1.Category, height int) error {
+	if category.ParentId == 0 {
+		if height > MaxCategoryDepth {
+			return fmt.Errorf("%w: the tree would be deeper than %d levels", ErrInvalidParent, MaxCategoryDepth)
+		}
+		return nil
+	}
+	if category.ParentId == category.Id {
+		return fmt.Errorf("%w: a category cannot be its own parent", ErrInvalidParent)
+	}
+
+	parent, err := r.GetCategory(ctx, category.ParentId)
+	if err != nil {
+		return err
+	}
+	ancestors, err := r.Ancestors(ctx, parent)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.Id == category.Id {
+			return fmt.Errorf("%w: category %d is below category %d", ErrInvalidParent, parent.Id, category.Id)
+		}
+	}
+	if len(ancestors)+1+height > MaxCategoryDepth {
+		return fmt.Errorf("%w: the tree would be deeper than %d levels", ErrInvalidParent, MaxCategoryDepth)
+	}
+	return nil
+}
+
+// checkSlug checks that no other child of the parent has the slug of the category.
+func (r *CategoryRepository) checkSlug(ctx context.Context, category *v1.Category) error {
+	siblings, err := r.ListCategories(ctx, category.ParentId)
+	if err != nil {
+		return err
+	}
+	for _, sibling := range siblings {
+		if sibling.Id != category.Id && sibling.Slug == category.Slug {
+			return fmt.Errorf("%w: %q", ErrSlugTaken, category.Slug)
+		}
+	}
+	return nil
+}
+
+// height is the number of levels of the subtree under a category, the category
+// included. It stops counting past MaxCategoryDepth.
+func (r *CategoryRepository) height(ctx context.Context, id int64, level int) (int, error) {
+	if level > MaxCategoryDepth {
+		return level, nil
+	}
+	children, err := r.ListCategories(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	height := 1
+	for _, child := range children {
+		h, err := r.height(ctx, child.Id, level+1)
+		if err != nil {
+			return 0, err
+		}
+		height = max(height, h+1)
+	}
+	return height, nil
+}
@@ -1,53 +1,557 @@
 package repository
 
 import (
-	"log/slog"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/gocql/gocql"
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
 	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/inf.v0"
+)
+
+var (
+	// ErrInsufficientStock is returned when an adjustment would remove stock that is not there or is held for orders.
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrProductNotFound is returned for a product that does not exist, or is deleted unless asked for.
+	ErrProductNotFound = errors.New("product not found")
 )
 
 type ProductRepository struct {
 	session *gocql.Session
+	// defaultLocation is the warehouse that holds the stock of products created
+	// before inventory was tracked per warehouse
+	defaultLocation string
+	ledger          *ledger.Ledger
+	skus            *SkuRepository
 }
 
-func NewProductRepository(session *gocql.Session) *ProductRepository {
-	return &ProductRepository{session: session}
+func NewProductRepository(session *gocql.Session, defaultLocation string) *ProductRepository {
+	return &ProductRepository{
+		session:         session,
+		defaultLocation: defaultLocation,
+		ledger:          ledger.New(session, "products_keyspace"),
+		skus:            NewSkuRepository(session),
+	}
 }
 
-func (r *ProductRepository) CreateProduct(product *v1.Product) error {
+// actor names the caller in the stock ledger.
+func actor(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+	return "anonymous"
+}
+
+func (r *ProductRepository) CreateProduct(ctx context.Context, product *v1.Product) error {
+	basePrice, err := money.ToDecimal(product.BasePrice.AmountMinor, product.BasePrice.Currency)
+	if err != nil {
+		return err
+	}
+	priceList, err := decimalPrices(product.PriceList)
+	if err != nil {
+		return err
+	}
+
+	texts, numbers, flags, units := attributeColumns(product.Attributes)
 
 	query := `
-		INSERT INTO products_keyspace.products (id, name, description, price, currency, image_url, stock, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO products_keyspace.products (id, name, description, base_price, currency, price_list, image_url, stock, reorder_threshold, reorder_quantity, allow_backorder, expected_restock_at, tax_category, category_id, tags, text_attributes, number_attributes, bool_attributes, attribute_units, parent_id, sku, price_override, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	return r.session.Query(query, product.Id, product.Name, product.Description, product.Price, product.Currency, product.ImageUrl, product.Stock, product.CreatedAt.AsTime(), product.UpdatedAt.AsTime()).Exec()
+	// the product and its category and tag listings are written together
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(query, product.Id, product.Name, product.Description, basePrice, product.BasePrice.Currency, priceList, product.ImageUrl, product.Stock, product.ReorderThreshold, product.ReorderQuantity, product.AllowBackorder, optionalTime(product.ExpectedRestockAt), product.TaxCategory, product.CategoryId, product.Tags, texts, numbers, flags, units, product.ParentId, product.Sku, product.PriceOverride, product.CreatedAt.AsTime(), product.UpdatedAt.AsTime())
+	if err := addListings(batch, product); err != nil {
+		return err
+	}
+	if product.ParentId != 0 {
+		batch.Query(`
+			INSERT INTO products_keyspace.variants_by_product (product_id, variant_id, sku) VALUES (?, ?, ?)
+		`, product.ParentId, product.Id, product.Sku)
+	}
+	if err := r.session.ExecuteBatch(batch); err != nil {
+		return err
+	}
+
+	if r.defaultLocation != "" {
+		// new stock arrives at the default warehouse
+		inventoryQuery := `
+			INSERT INTO products_keyspace.inventory (product_id, location_id, quantity)
+			VALUES (?, ?, ?)
+		`
+		if err := r.session.Query(inventoryQuery, product.Id, r.defaultLocation, product.Stock).WithContext(ctx).Exec(); err != nil {
+			return err
+		}
+	}
+
+	return r.ledger.Record(ctx, ledger.Movement{
+		ProductID:  product.Id,
+		LocationID: r.defaultLocation,
+		Delta:      product.Stock,
+		Reason:     ledger.ReasonInitialStock,
+		Actor:      actor(ctx),
+	})
 
 }
 
-func (r *ProductRepository) GetProduct(id int64) (*v1.Product, error) {
+// GetProduct returns a product. Deleted products are only returned with includeDeleted.
+func (r *ProductRepository) GetProduct(ctx context.Context, id int64, includeDeleted bool) (*v1.Product, error) {
 	var product v1.Product
 	query := `
-		SELECT id, name, description, price, currency, image_url, stock, created_at, updated_at	
+		SELECT id, name, description, price, base_price, currency, price_list, image_url, stock, reorder_threshold, reorder_quantity, allow_backorder, expected_restock_at, tax_category, category_id, tags, text_attributes, number_attributes, bool_attributes, attribute_units, parent_id, sku, price_override, created_at, updated_at, deleted_at
 		FROM products_keyspace.products
 		WHERE id = ?
 	`
-	var createdAt, updatedAt time.Time
-	if err := r.session.Query(query, id).Scan(&product.Id, &product.Name, &product.Description, &product.Price, &product.Currency, &product.ImageUrl, &product.Stock, &createdAt, &updatedAt); err != nil {
+	var expectedRestockAt, createdAt, updatedAt, deletedAt time.Time
+	var legacyPrice float64
+	var basePrice *inf.Dec
+	var priceList map[string]*inf.Dec
+	var texts, units map[string]string
+	var numbers map[string]float64
+	var flags map[string]bool
+	if err := r.session.Query(query, id).WithContext(ctx).Scan(&product.Id, &product.Name, &product.Description, &legacyPrice, &basePrice, &product.Currency, &priceList, &product.ImageUrl, &product.Stock, &product.ReorderThreshold, &product.ReorderQuantity, &product.AllowBackorder, &expectedRestockAt, &product.TaxCategory, &product.CategoryId, &product.Tags, &texts, &numbers, &flags, &units, &product.ParentId, &product.Sku, &product.PriceOverride, &createdAt, &updatedAt, &deletedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return nil, fmt.Errorf("%w: %d", ErrProductNotFound, id)
+		}
+		return nil, err
+	}
+	if !deletedAt.IsZero() {
+		if !includeDeleted {
+			return nil, fmt.Errorf("%w: %d", ErrProductNotFound, id)
+		}
+		product.DeletedAt = timestamppb.New(deletedAt)
+	}
+	if err := setPrices(&product, legacyPrice, basePrice, priceList); err != nil {
 		return nil, err
 	}
+	sort.Strings(product.Tags)
+	product.Attributes = attributesFromColumns(texts, numbers, flags, units)
+	if !expectedRestockAt.IsZero() {
+		product.ExpectedRestockAt = timestamppb.New(expectedRestockAt)
+	}
 	product.CreatedAt = timestamppb.New(createdAt)
 	product.UpdatedAt = timestamppb.New(updatedAt)
+
+	reserved, err := r.reservedStock(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	product.AvailableStock = product.Stock - reserved
+
 	return &product, nil
 }
 
-func (r *ProductRepository) DeleteProduct(id int64) error {
-	slog.Info("Deleting product", "id", id)
+func (r *ProductRepository) UpdateReorderPolicy(ctx context.Context, id int64, threshold, quantity int32) error {
+	query := `
+		UPDATE products_keyspace.products SET reorder_threshold = ?, reorder_quantity = ?, updated_at = ? WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, threshold, quantity, time.Now(), id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return gocql.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ProductRepository) UpdateBackorderPolicy(ctx context.Context, id int64, allowBackorder bool, expectedRestockAt *timestamppb.Timestamp) error {
+	query := `
+		UPDATE products_keyspace.products SET allow_backorder = ?, expected_restock_at = ?, updated_at = ? WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, allowBackorder, optionalTime(expectedRestockAt), time.Now(), id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return gocql.ErrNotFound
+	}
+
+	// variants take the backorder policy of their product
+	variants, err := r.ListVariants(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	for _, variant := range variants {
+		if err := r.session.Query(query, allowBackorder, optionalTime(expectedRestockAt), time.Now(), variant.Id).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to set backorder policy of variant %d: %w", variant.Id, err)
+		}
+	}
+	return nil
+}
+
+func (r *ProductRepository) UpdateTaxCategory(ctx context.Context, id int64, category string) error {
+	query := `
+		UPDATE products_keyspace.products SET tax_category = ?, updated_at = ? WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, category, time.Now(), id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return gocql.ErrNotFound
+	}
+
+	// variants are taxed like their product
+	variants, err := r.ListVariants(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	for _, variant := range variants {
+		if err := r.session.Query(query, category, time.Now(), variant.Id).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to set tax category of variant %d: %w", variant.Id, err)
+		}
+	}
+	return nil
+}
+
+func (r *ProductRepository) SetPriceList(ctx context.Context, id int64, prices []*moneyv1.Money) error {
+	priceList, err := decimalPrices(prices)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE products_keyspace.products SET price_list = ?, updated_at = ? WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, priceList, time.Now(), id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return gocql.ErrNotFound
+	}
+
+	// variants without a price override follow the prices of their product
+	variants, err := r.ListVariants(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	for _, variant := range variants {
+		if variant.PriceOverride {
+			continue
+		}
+		if err := r.session.Query(query, priceList, time.Now(), variant.Id).WithContext(ctx).Exec(); err != nil {
+			return fmt.Errorf("failed to set price list of variant %d: %w", variant.Id, err)
+		}
+	}
+	return nil
+}
+
+// decimalPrices turns a price list into the decimals it is stored as, keyed by currency.
+func decimalPrices(prices []*moneyv1.Money) (map[string]*inf.Dec, error) {
+	decimals := make(map[string]*inf.Dec, len(prices))
+	for _, price := range prices {
+		amount, err := money.ToDecimal(price.AmountMinor, price.Currency)
+		if err != nil {
+			return nil, err
+		}
+		decimals[price.Currency] = amount
+	}
+	return decimals, nil
+}
+
+// setPrices fills in the prices of a product from its row. Products stored
+// before prices were decimals only have the floating point price.
+func setPrices(product *v1.Product, legacyPrice float64, basePrice *inf.Dec, priceList map[string]*inf.Dec) error {
+	var amount int64
+	var err error
+	if basePrice != nil {
+		amount, err = money.FromDecimal(basePrice, product.Currency)
+	} else {
+		amount, err = money.FromFloat(legacyPrice, product.Currency)
+	}
+	if err != nil {
+		return fmt.Errorf("price of product %d: %w", product.Id, err)
+	}
+	product.BasePrice = &moneyv1.Money{Currency: product.Currency, AmountMinor: amount}
+	product.Price = money.ToFloat(amount, product.Currency)
+
+	product.PriceList = nil
+	for currency, decimal := range priceList {
+		amount, err := money.FromDecimal(decimal, currency)
+		if err != nil {
+			return fmt.Errorf("price of product %d: %w", product.Id, err)
+		}
+		product.PriceList = append(product.PriceList, &moneyv1.Money{Currency: currency, AmountMinor: amount})
+	}
+	sort.Slice(product.PriceList, func(i, j int) bool {
+		return product.PriceList[i].Currency < product.PriceList[j].Currency
+	})
+	return nil
+}
+
+// optionalTime binds an unset timestamp as null.
+func optionalTime(ts *timestamppb.Timestamp) interface{} {
+	if ts == nil {
+		return nil
+	}
+	return ts.AsTime()
+}
+
+// DeleteProduct marks a product deleted, which hides it until it is restored or
+// purged, and takes it out of its category and tag listings. The variants of a
+// product are deleted along with it. Deleting it again keeps the time of the
+// first deletion.
+func (r *ProductRepository) DeleteProduct(ctx context.Context, id int64) error {
+	product, err := r.GetProduct(ctx, id, true)
+	if err != nil {
+		return err
+	}
+	if product.DeletedAt != nil {
+		return nil
+	}
+
+	logger.FromContext(ctx).Info("deleting product", "product_id", id, "actor", actor(ctx))
+	now := time.Now()
+	query := `
+		UPDATE products_keyspace.products SET deleted_at = ?, updated_at = ? WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, now, now, id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+	if !applied {
+		// purged since it was read
+		return fmt.Errorf("%w: %d", ErrProductNotFound, id)
+	}
+
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	removeListings(batch, id, product.CategoryId, product.Tags)
+	if batch.Size() > 0 {
+		if err := r.session.ExecuteBatch(batch); err != nil {
+			return err
+		}
+	}
+
+	// variants are deleted at the same time as their product, which is how
+	// restoring the product tells them from variants deleted on their own
+	variants, err := r.ListVariants(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	for _, variant := range variants {
+		if _, err := r.session.Query(query, now, now, variant.Id).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+			return fmt.Errorf("failed to delete variant %d: %w", variant.Id, err)
+		}
+	}
+	return nil
+}
+
+// RestoreProduct undoes the deletion of a product that has not been purged yet
+// and lists it under its category and tags again. Restoring a product restores
+// the variants deleted along with it; a variant cannot be restored while its
+// product is deleted.
+func (r *ProductRepository) RestoreProduct(ctx context.Context, id int64) (*v1.Product, error) {
+	deleted, err := r.GetProduct(ctx, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if deleted.ParentId != 0 {
+		parent, err := r.GetProduct(ctx, deleted.ParentId, true)
+		if err != nil {
+			return nil, err
+		}
+		if parent.DeletedAt != nil {
+			return nil, fmt.Errorf("%w: %d", ErrParentDeleted, parent.Id)
+		}
+	}
+
+	query := `
+		UPDATE products_keyspace.products SET deleted_at = null, updated_at = ? WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, time.Now(), id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, fmt.Errorf("%w: %d", ErrProductNotFound, id)
+	}
+	logger.FromContext(ctx).Info("product restored", "product_id", id, "actor", actor(ctx))
+
+	product, err := r.GetProduct(ctx, id, false)
+	if err != nil {
+		return nil, err
+	}
+	batch := r.session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	if err := addListings(batch, product); err != nil {
+		return nil, err
+	}
+	if batch.Size() > 0 {
+		if err := r.session.ExecuteBatch(batch); err != nil {
+			return nil, err
+		}
+	}
+
+	if deleted.DeletedAt != nil {
+		variants, err := r.ListVariants(ctx, id, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, variant := range variants {
+			if variant.DeletedAt == nil || !variant.DeletedAt.AsTime().Equal(deleted.DeletedAt.AsTime()) {
+				continue
+			}
+			if _, err := r.session.Query(query, time.Now(), variant.Id).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+				return nil, fmt.Errorf("failed to restore variant %d: %w", variant.Id, err)
+			}
+		}
+	}
+	return product, nil
+}
+
+// reservedStock is the stock held by orders that are not finalized yet, which is not available to new ones.
+func (r *ProductRepository) reservedStock(ctx context.Context, id int64) (int32, error) {
+	var reserved int32
+	query := `
+		SELECT SUM(quantity) FROM products_keyspace.stock_reservations WHERE product_id = ?
+	`
+	if err := r.session.Query(query, id).WithContext(ctx).Scan(&reserved); err != nil {
+		return 0, err
+	}
+	return reserved, nil
+}
+
+func (r *ProductRepository) GetInventory(ctx context.Context, id int64) ([]*v1.InventoryLevel, error) {
 	query := `
-		DELETE FROM products_keyspace.products WHERE id = ?
+		SELECT location_id, quantity FROM products_keyspace.inventory WHERE product_id = ?
+	`
+	iter := r.session.Query(query, id).WithContext(ctx).Iter()
+
+	var levels []*v1.InventoryLevel
+	var level v1.InventoryLevel
+	for iter.Scan(&level.LocationId, &level.Quantity) {
+		levels = append(levels, &v1.InventoryLevel{LocationId: level.LocationId, Quantity: level.Quantity})
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	if len(levels) == 0 && r.defaultLocation != "" {
+		// stock of products created before inventory was tracked per warehouse sits at the default one
+		product, err := r.GetProduct(ctx, id, true)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, &v1.InventoryLevel{LocationId: r.defaultLocation, Quantity: product.Stock})
+	}
+	return levels, nil
+}
+
+// AdjustInventory adds delta to the stock of a product at one warehouse and to
+// its total stock. Both are updated with compare-and-set so concurrent
+// adjustments and orders never lose an update.
+func (r *ProductRepository) AdjustInventory(ctx context.Context, id int64, locationId string, delta int32) (*v1.InventoryLevel, int32, error) {
+	product, err := r.GetProduct(ctx, id, true)
+	if err != nil {
+		return nil, 0, err
+	}
+	if product.AvailableStock+delta < 0 {
+		return nil, 0, ErrInsufficientStock
+	}
+
+	levels, err := r.GetInventory(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// materialize the rows GetInventory may have derived, then make sure the adjusted one exists
+	seedQuery := `
+		INSERT INTO products_keyspace.inventory (product_id, location_id, quantity) VALUES (?, ?, ?) IF NOT EXISTS
 	`
-	return r.session.Query(query, id).Exec()
+	for _, level := range levels {
+		if _, err := r.session.Query(seedQuery, id, level.LocationId, level.Quantity).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+			return nil, 0, err
+		}
+	}
+	if _, err := r.session.Query(seedQuery, id, locationId, 0).WithContext(ctx).MapScanCAS(map[string]interface{}{}); err != nil {
+		return nil, 0, err
+	}
+
+	quantity, err := r.compareAndAdd(ctx, `
+		SELECT quantity FROM products_keyspace.inventory WHERE product_id = ? AND location_id = ?
+	`, `
+		UPDATE products_keyspace.inventory SET quantity = ? WHERE product_id = ? AND location_id = ? IF quantity = ?
+	`, delta, id, locationId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stock, err := r.compareAndAdd(ctx, `
+		SELECT stock FROM products_keyspace.products WHERE id = ?
+	`, `
+		UPDATE products_keyspace.products SET stock = ? WHERE id = ? IF stock = ?
+	`, delta, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = r.ledger.Record(ctx, ledger.Movement{
+		ProductID:  id,
+		LocationID: locationId,
+		Delta:      delta,
+		Reason:     ledger.ReasonAdjustment,
+		Actor:      actor(ctx),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logger.FromContext(ctx).Info("inventory adjusted", "product_id", id, "location_id", locationId, "delta", delta, "quantity", quantity)
+	return &v1.InventoryLevel{LocationId: locationId, Quantity: quantity}, stock, nil
+}
+
+// compareAndAdd adds delta to the int column read by selectQuery, retrying
+// until updateQuery applies. Both queries take the key as their last arguments.
+func (r *ProductRepository) compareAndAdd(ctx context.Context, selectQuery, updateQuery string, delta int32, key ...interface{}) (int32, error) {
+	for {
+		var current int32
+		if err := r.session.Query(selectQuery, key...).WithContext(ctx).Scan(&current); err != nil {
+			return 0, err
+		}
+		if current+delta < 0 {
+			return 0, ErrInsufficientStock
+		}
+
+		args := append(append([]interface{}{current + delta}, key...), current)
+		applied, err := r.session.Query(updateQuery, args...).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+		if err != nil {
+			return 0, err
+		}
+		if applied {
+			return current + delta, nil
+		}
+	}
+}
+
+// ListStockMovements returns a page of the stock ledger of a product, newest first.
+func (r *ProductRepository) ListStockMovements(ctx context.Context, id int64, from, to time.Time, pageSize int, pageState []byte) ([]*v1.StockMovement, []byte, error) {
+	movements, next, err := r.ledger.List(ctx, id, from, to, pageSize, pageState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]*v1.StockMovement, 0, len(movements))
+	for _, m := range movements {
+		result = append(result, &v1.StockMovement{
+			ProductId:  m.ProductID,
+			LocationId: m.LocationID,
+			Delta:      m.Delta,
+			Reason:     m.Reason,
+			OrderId:    m.OrderID,
+			Actor:      m.Actor,
+			OccurredAt: timestamppb.New(m.OccurredAt),
+		})
+	}
+	return result, next, nil
 }
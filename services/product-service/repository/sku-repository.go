@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gocql/gocql"
+)
+
+var (
+	// ErrSkuTaken is returned when another variant already has the SKU.
+	ErrSkuTaken = errors.New("sku is taken")
+	// ErrSkuNotFound is returned for a SKU no variant has.
+	ErrSkuNotFound = errors.New("sku not found")
+)
+
+// SkuRepository keeps SKUs unique with the skus table, which maps every SKU to
+// its variant and is only written with compare-and-set.
+type SkuRepository struct {
+	session *gocql.Session
+}
+
+func NewSkuRepository(session *gocql.Session) *SkuRepository {
+	return &SkuRepository{session: session}
+}
+
+// Claim reserves a SKU for a variant. Claiming it again for the same variant
+// succeeds, so a retried create does not trip over its own claim.
+func (r *SkuRepository) Claim(ctx context.Context, sku string, productId, variantId int64) error {
+	query := `
+		INSERT INTO products_keyspace.skus (sku, product_id, variant_id) VALUES (?, ?, ?) IF NOT EXISTS
+	`
+	existing := map[string]interface{}{}
+	applied, err := r.session.Query(query, sku, productId, variantId).WithContext(ctx).MapScanCAS(existing)
+	if err != nil {
+		return fmt.Errorf("failed to claim sku %q: %w", sku, err)
+	}
+	if !applied && existing["variant_id"] != variantId {
+		return fmt.Errorf("%w: %q", ErrSkuTaken, sku)
+	}
+	return nil
+}
+
+// Release frees a SKU for other variants.
+func (r *SkuRepository) Release(ctx context.Context, sku string) error {
+	if err := r.session.Query(`DELETE FROM products_keyspace.skus WHERE sku = ?`, sku).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to release sku %q: %w", sku, err)
+	}
+	return nil
+}
+
+// Resolve returns the product and the variant a SKU belongs to.
+func (r *SkuRepository) Resolve(ctx context.Context, sku string) (productId, variantId int64, err error) {
+	query := `SELECT product_id, variant_id FROM products_keyspace.skus WHERE sku = ?`
+	if err := r.session.Query(query, sku).WithContext(ctx).Scan(&productId, &variantId); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return 0, 0, fmt.Errorf("%w: %q", ErrSkuNotFound, sku)
+		}
+		return 0, 0, err
+	}
+	return productId, variantId, nil
+}
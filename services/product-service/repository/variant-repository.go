@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	moneyv1 "github.com/yaninyzwitty/temporal-microservice-go/gen/money/v1"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrIsVariant is returned for operations on a variant that only products support.
+	ErrIsVariant = errors.New("product is a variant")
+	// ErrNotVariant is returned for operations on a product that only variants support.
+	ErrNotVariant = errors.New("product is not a variant")
+	// ErrParentDeleted is returned when restoring a variant of a deleted product.
+	ErrParentDeleted = errors.New("the product of the variant is deleted")
+	// ErrDuplicateVariant is returned when a sibling variant has the same attributes.
+	ErrDuplicateVariant = errors.New("a variant with the same attributes exists")
+)
+
+// CreateVariant adds a variant to a product. The variant is stored as a
+// product of its own, so stock, reservations and orders work on it unchanged;
+// it takes its name, description, image, tax category and backorder policy
+// from its product, and its prices too unless it has a price override.
+func (r *ProductRepository) CreateVariant(ctx context.Context, parent, variant *v1.Product) error {
+	if parent.ParentId != 0 {
+		return fmt.Errorf("%w: %d", ErrIsVariant, parent.Id)
+	}
+	if err := r.checkSiblings(ctx, parent.Id, variant); err != nil {
+		return err
+	}
+
+	variant.ParentId = parent.Id
+	variant.Name = parent.Name
+	variant.Description = parent.Description
+	variant.ImageUrl = parent.ImageUrl
+	variant.TaxCategory = parent.TaxCategory
+	variant.AllowBackorder = parent.AllowBackorder
+	variant.ExpectedRestockAt = parent.ExpectedRestockAt
+	setVariantPrice(parent, variant, variant.BasePrice)
+
+	if err := r.skus.Claim(ctx, variant.Sku, parent.Id, variant.Id); err != nil {
+		return err
+	}
+	if err := r.CreateProduct(ctx, variant); err != nil {
+		if releaseErr := r.skus.Release(ctx, variant.Sku); releaseErr != nil {
+			logger.FromContext(ctx).Error("failed to release sku", "sku", variant.Sku, "error", releaseErr)
+		}
+		return err
+	}
+
+	logger.FromContext(ctx).Info("variant created", "product_id", parent.Id, "variant_id", variant.Id, "sku", variant.Sku)
+	return nil
+}
+
+// UpdateVariant replaces the price override and attributes of a variant. A nil
+// override prices the variant at the price of its product again.
+func (r *ProductRepository) UpdateVariant(ctx context.Context, variant *v1.Product, priceOverride *moneyv1.Money, attributes []*v1.Attribute) (*v1.Product, error) {
+	if variant.ParentId == 0 {
+		return nil, fmt.Errorf("%w: %d", ErrNotVariant, variant.Id)
+	}
+	parent, err := r.GetProduct(ctx, variant.ParentId, true)
+	if err != nil {
+		return nil, err
+	}
+	variant.Attributes = attributes
+	if err := r.checkSiblings(ctx, parent.Id, variant); err != nil {
+		return nil, err
+	}
+	setVariantPrice(parent, variant, priceOverride)
+
+	basePrice, err := money.ToDecimal(variant.BasePrice.AmountMinor, variant.BasePrice.Currency)
+	if err != nil {
+		return nil, err
+	}
+	priceList, err := decimalPrices(variant.PriceList)
+	if err != nil {
+		return nil, err
+	}
+	texts, numbers, flags, units := attributeColumns(attributes)
+	query := `
+		UPDATE products_keyspace.products
+		SET base_price = ?, price_list = ?, price_override = ?, text_attributes = ?, number_attributes = ?, bool_attributes = ?, attribute_units = ?, updated_at = ?
+		WHERE id = ? IF EXISTS
+	`
+	applied, err := r.session.Query(query, basePrice, priceList, variant.PriceOverride, texts, numbers, flags, units, time.Now(), variant.Id).WithContext(ctx).MapScanCAS(map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, fmt.Errorf("%w: %d", ErrProductNotFound, variant.Id)
+	}
+
+	logger.FromContext(ctx).Info("variant updated", "variant_id", variant.Id, "price_override", variant.PriceOverride)
+	return r.GetProduct(ctx, variant.Id, true)
+}
+
+// setVariantPrice prices a variant at its override, or at the prices of its product without one.
+func setVariantPrice(parent, variant *v1.Product, priceOverride *moneyv1.Money) {
+	variant.Currency = parent.BasePrice.Currency
+	variant.PriceOverride = priceOverride != nil
+	if priceOverride != nil {
+		// other currencies are converted from the override
+		variant.BasePrice = priceOverride
+		variant.PriceList = nil
+	} else {
+		variant.BasePrice = parent.BasePrice
+		variant.PriceList = parent.PriceList
+	}
+	variant.Price = money.ToFloat(variant.BasePrice.AmountMinor, variant.BasePrice.Currency)
+}
+
+// checkSiblings checks that no other variant of the product has the attributes of the variant.
+func (r *ProductRepository) checkSiblings(ctx context.Context, parentId int64, variant *v1.Product) error {
+	siblings, err := r.ListVariants(ctx, parentId, true)
+	if err != nil {
+		return err
+	}
+	for _, sibling := range siblings {
+		if sibling.Id == variant.Id || len(sibling.Attributes) != len(variant.Attributes) {
+			continue
+		}
+		same := true
+		for i := range sibling.Attributes {
+			if !proto.Equal(sibling.Attributes[i], variant.Attributes[i]) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return fmt.Errorf("%w: %s", ErrDuplicateVariant, sibling.Sku)
+		}
+	}
+	return nil
+}
+
+// ListVariants returns the variants of a product by id. Deleted variants are
+// only returned with includeDeleted.
+func (r *ProductRepository) ListVariants(ctx context.Context, parentId int64, includeDeleted bool) ([]*v1.Product, error) {
+	iter := r.session.Query(`SELECT variant_id FROM products_keyspace.variants_by_product WHERE product_id = ?`, parentId).WithContext(ctx).Iter()
+	var ids []int64
+	var id int64
+	for iter.Scan(&id) {
+		ids = append(ids, id)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to list variants of product %d: %w", parentId, err)
+	}
+
+	variants := make([]*v1.Product, 0, len(ids))
+	for _, id := range ids {
+		variant, err := r.GetProduct(ctx, id, includeDeleted)
+		if errors.Is(err, ErrProductNotFound) {
+			// deleted, or purged before its row here was removed
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, variant)
+	}
+	return variants, nil
+}
+
+// GetProductBySku returns a variant and its product with its variants.
+func (r *ProductRepository) GetProductBySku(ctx context.Context, sku string) (*v1.Product, *v1.Product, error) {
+	parentId, variantId, err := r.skus.Resolve(ctx, sku)
+	if err != nil {
+		return nil, nil, err
+	}
+	variant, err := r.GetProduct(ctx, variantId, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	parent, err := r.GetProduct(ctx, parentId, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if parent.Variants, err = r.ListVariants(ctx, parentId, false); err != nil {
+		return nil, nil, err
+	}
+	return parent, variant, nil
+}
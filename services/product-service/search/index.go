@@ -0,0 +1,359 @@
+// Package search keeps a full-text index of the catalogue in a local Bleve
+// index, inside the product service.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/index/scorch"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	v1 "github.com/yaninyzwitty/temporal-microservice-go/gen/products/v1"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"gopkg.in/inf.v0"
+)
+
+// openTimeout bounds how long Open waits for another process to let go of the index.
+const openTimeout = 5 * time.Second
+
+// ErrUnknownPriceBucket is returned when filtering on a price bucket that does not exist.
+var ErrUnknownPriceBucket = errors.New("unknown price bucket")
+
+// PriceBucket is a range of prices, in major units of the currency of the
+// index, that search results are counted and filtered by.
+type PriceBucket struct {
+	Name  string
+	Label string
+	Min   float64
+	Max   float64 // 0 for no upper bound
+}
+
+var PriceBuckets = []PriceBucket{
+	{Name: "under-10", Label: "Under 10", Min: 0, Max: 10},
+	{Name: "10-25", Label: "10 to 25", Min: 10, Max: 25},
+	{Name: "25-50", Label: "25 to 50", Min: 25, Max: 50},
+	{Name: "50-100", Label: "50 to 100", Min: 50, Max: 100},
+	{Name: "100-250", Label: "100 to 250", Min: 100, Max: 250},
+	{Name: "250-and-up", Label: "250 and up", Min: 250},
+}
+
+// document is what is indexed of a product. The attributes and stock of its
+// variants count towards the product, which is what search returns.
+type document struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+	Attributes  []string `json:"attributes"`
+	CategoryID  string   `json:"category_id"`
+	// Price is in the currency of the index; nil leaves the product out of the price buckets.
+	Price   *float64 `json:"price,omitempty"`
+	InStock bool     `json:"in_stock"`
+}
+
+func newDocument(product *v1.Product, variants []*v1.Product, price *float64) document {
+	doc := document{
+		Name:        product.Name,
+		Description: product.Description,
+		Tags:        product.Tags,
+		Price:       price,
+		InStock:     product.AvailableStock > 0,
+	}
+	if product.CategoryId != 0 {
+		doc.CategoryID = strconv.FormatInt(product.CategoryId, 10)
+	}
+	for _, p := range append([]*v1.Product{product}, variants...) {
+		for _, attribute := range p.Attributes {
+			if text, ok := attribute.Value.(*v1.Attribute_Text); ok {
+				doc.Attributes = append(doc.Attributes, text.Text)
+			}
+		}
+		if p.Sku != "" {
+			doc.Attributes = append(doc.Attributes, p.Sku)
+		}
+		if p.AvailableStock > 0 {
+			doc.InStock = true
+		}
+	}
+	return doc
+}
+
+func indexMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = en.AnalyzerName
+	text.Store = false
+	keyword := bleve.NewKeywordFieldMapping()
+	keyword.Store = false
+	number := bleve.NewNumericFieldMapping()
+	number.Store = false
+	flag := bleve.NewBooleanFieldMapping()
+	flag.Store = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt("name", text)
+	doc.AddFieldMappingsAt("description", text)
+	doc.AddFieldMappingsAt("tags", text)
+	doc.AddFieldMappingsAt("attributes", text)
+	doc.AddFieldMappingsAt("category_id", keyword)
+	doc.AddFieldMappingsAt("price", number)
+	doc.AddFieldMappingsAt("in_stock", flag)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	m.DefaultAnalyzer = en.AnalyzerName
+	return m
+}
+
+// Index is the full-text index of the catalogue. Only products are indexed,
+// not their variants. Prices are indexed in one currency, so the price buckets
+// mean the same amount whatever currency a product is priced in.
+type Index struct {
+	index     bleve.Index
+	converter *money.Converter
+	currency  string
+}
+
+// Open opens the index at path, creating it when it does not exist. Prices are
+// indexed in currency, converted with converter. It fails when another process
+// keeps the index open for longer than openTimeout.
+func Open(path string, converter *money.Converter, currency string) (*Index, error) {
+	if err := money.Validate(currency); err != nil {
+		return nil, fmt.Errorf("search index currency: %w", err)
+	}
+
+	config := map[string]interface{}{"bolt_timeout": openTimeout.String()}
+	index, err := bleve.OpenUsing(path, config)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.NewUsing(path, indexMapping(), scorch.Name, scorch.Name, config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open search index at %s: %w", path, err)
+	}
+	return &Index{index: index, converter: converter, currency: currency}, nil
+}
+
+// Currency is the currency the price buckets are in.
+func (i *Index) Currency() string {
+	return i.currency
+}
+
+func (i *Index) Close() error {
+	return i.index.Close()
+}
+
+// Empty reports whether nothing is indexed yet.
+func (i *Index) Empty() (bool, error) {
+	count, err := i.index.DocCount()
+	return count == 0, err
+}
+
+// Put indexes a product together with its variants, replacing what was indexed of it.
+func (i *Index) Put(ctx context.Context, product *v1.Product, variants []*v1.Product) error {
+	price, err := i.price(ctx, product, map[string]*inf.Dec{})
+	if err != nil {
+		return err
+	}
+	return i.index.Index(strconv.FormatInt(product.Id, 10), newDocument(product, variants, price))
+}
+
+// price is the price of a product in the currency of the index. A price the
+// product has in that currency is used as it is; otherwise the base price is
+// converted at the current rate. Rates are kept in rates, so a refresh looks
+// each one up once. It is nil when there is no rate to convert with.
+func (i *Index) price(ctx context.Context, product *v1.Product, rates map[string]*inf.Dec) (*float64, error) {
+	for _, p := range product.PriceList {
+		if p.Currency == i.currency {
+			price := money.ToFloat(p.AmountMinor, p.Currency)
+			return &price, nil
+		}
+	}
+
+	base := product.BasePrice
+	rate, ok := rates[base.Currency]
+	if !ok {
+		found, err := i.converter.Rate(ctx, base.Currency, i.currency)
+		if err != nil && !errors.Is(err, money.ErrRateNotFound) {
+			return nil, err
+		}
+		rate = found.Rate
+		rates[base.Currency] = rate
+	}
+	if rate == nil {
+		return nil, nil
+	}
+
+	amount, err := money.Convert(base.AmountMinor, base.Currency, i.currency, rate)
+	if err != nil {
+		return nil, fmt.Errorf("price of product %d: %w", product.Id, err)
+	}
+	price := money.ToFloat(amount, i.currency)
+	return &price, nil
+}
+
+// Delete removes a product from the index.
+func (i *Index) Delete(id int64) error {
+	return i.index.Delete(strconv.FormatInt(id, 10))
+}
+
+// Request is a search of the index. An empty Text matches every product.
+type Request struct {
+	Text        string
+	CategoryID  int64
+	PriceBucket string
+	InStockOnly bool
+	From        int
+	Size        int
+}
+
+type Hit struct {
+	ProductID int64
+	Score     float64
+}
+
+// FacetValue is how many hits have a value, e.g. a category id or a price bucket.
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+type Result struct {
+	Hits       []Hit
+	Total      uint64
+	Categories []FacetValue
+	Prices     []FacetValue // in the order of PriceBuckets
+	InStock    []FacetValue // "true" and "false"
+}
+
+// fields are searched by text, with matches in the name counting most.
+var fields = []struct {
+	name  string
+	boost float64
+}{
+	{name: "name", boost: 3},
+	{name: "tags", boost: 2},
+	{name: "attributes", boost: 1.5},
+	{name: "description", boost: 1},
+}
+
+// textQuery matches the words of text in every field. Exact matches outrank
+// the matches of misspelt words, which allow an edit distance that grows with
+// the length of the word.
+func textQuery(text string) query.Query {
+	var disjuncts []query.Query
+	for _, field := range fields {
+		exact := bleve.NewMatchQuery(text)
+		exact.SetField(field.name)
+		exact.SetBoost(field.boost)
+
+		fuzzy := bleve.NewMatchQuery(text)
+		fuzzy.SetField(field.name)
+		fuzzy.SetAutoFuzziness(true)
+		fuzzy.SetBoost(field.boost / 2)
+
+		disjuncts = append(disjuncts, exact, fuzzy)
+	}
+	return bleve.NewDisjunctionQuery(disjuncts...)
+}
+
+func priceQuery(bucket PriceBucket) query.Query {
+	inclusive, exclusive := true, false
+	min := bucket.Min
+	var max *float64
+	if bucket.Max != 0 {
+		max = &bucket.Max
+	}
+	q := bleve.NewNumericRangeInclusiveQuery(&min, max, &inclusive, &exclusive)
+	q.SetField("price")
+	return q
+}
+
+func (i *Index) Search(ctx context.Context, req Request) (*Result, error) {
+	var q query.Query = bleve.NewMatchAllQuery()
+	if req.Text != "" {
+		q = textQuery(req.Text)
+	}
+
+	conjuncts := []query.Query{q}
+	if req.CategoryID != 0 {
+		category := bleve.NewTermQuery(strconv.FormatInt(req.CategoryID, 10))
+		category.SetField("category_id")
+		conjuncts = append(conjuncts, category)
+	}
+	if req.PriceBucket != "" {
+		found := false
+		for _, bucket := range PriceBuckets {
+			if bucket.Name == req.PriceBucket {
+				conjuncts = append(conjuncts, priceQuery(bucket))
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownPriceBucket, req.PriceBucket)
+		}
+	}
+	if req.InStockOnly {
+		inStock := bleve.NewBoolFieldQuery(true)
+		inStock.SetField("in_stock")
+		conjuncts = append(conjuncts, inStock)
+	}
+
+	search := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(conjuncts...), req.Size, req.From, false)
+	search.AddFacet("category", bleve.NewFacetRequest("category_id", 20))
+	prices := bleve.NewFacetRequest("price", len(PriceBuckets))
+	for _, bucket := range PriceBuckets {
+		min := bucket.Min
+		var max *float64
+		if bucket.Max != 0 {
+			max = &bucket.Max
+		}
+		prices.AddNumericRange(bucket.Name, &min, max)
+	}
+	search.AddFacet("price", prices)
+	search.AddFacet("in_stock", bleve.NewFacetRequest("in_stock", 2))
+
+	found, err := i.index.SearchInContext(ctx, search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search products: %w", err)
+	}
+
+	result := &Result{Total: found.Total}
+	for _, hit := range found.Hits {
+		id, err := strconv.ParseInt(hit.ID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document id %q: %w", hit.ID, err)
+		}
+		result.Hits = append(result.Hits, Hit{ProductID: id, Score: hit.Score})
+	}
+	if facet, ok := found.Facets["category"]; ok {
+		for _, term := range facet.Terms.Terms() {
+			if term.Term == "" {
+				// products without a category
+				continue
+			}
+			result.Categories = append(result.Categories, FacetValue{Value: term.Term, Count: term.Count})
+		}
+	}
+	if facet, ok := found.Facets["price"]; ok {
+		counts := make(map[string]int, len(facet.NumericRanges))
+		for _, r := range facet.NumericRanges {
+			counts[r.Name] = r.Count
+		}
+		for _, bucket := range PriceBuckets {
+			if counts[bucket.Name] > 0 {
+				result.Prices = append(result.Prices, FacetValue{Value: bucket.Name, Count: counts[bucket.Name]})
+			}
+		}
+	}
+	if facet, ok := found.Facets["in_stock"]; ok {
+		// booleans are indexed as T and F
+		for _, term := range facet.Terms.Terms() {
+			result.InStock = append(result.InStock, FacetValue{Value: strconv.FormatBool(term.Term == "T"), Count: term.Count})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/product-service/repository"
+	"gopkg.in/inf.v0"
+)
+
+// batchSize is how many products Refresh indexes at a time.
+const batchSize = 500
+
+// Update re-indexes a product after it was written. A variant re-indexes its
+// product; a deleted or purged product is removed from the index.
+func (i *Index) Update(ctx context.Context, products *repository.ProductRepository, id int64) error {
+	product, err := products.GetProduct(ctx, id, true)
+	if err == nil && product.ParentId != 0 {
+		id = product.ParentId
+		product, err = products.GetProduct(ctx, id, true)
+	}
+	if errors.Is(err, repository.ErrProductNotFound) || (err == nil && product.DeletedAt != nil) {
+		return i.Delete(id)
+	}
+	if err != nil {
+		return err
+	}
+
+	variants, err := products.ListVariants(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	return i.Put(ctx, product, variants)
+}
+
+// Refresh indexes every product in Cassandra again and removes the products
+// that are no longer there. It returns how many products are indexed.
+func (i *Index) Refresh(ctx context.Context, products *repository.ProductRepository) (int, error) {
+	ids, err := products.ProductIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	batch := i.index.NewBatch()
+	flush := func() error {
+		if batch.Size() == 0 {
+			return nil
+		}
+		if err := i.index.Batch(batch); err != nil {
+			return fmt.Errorf("failed to write search index: %w", err)
+		}
+		batch.Reset()
+		return nil
+	}
+
+	indexed := make(map[string]bool, len(ids))
+	rates := make(map[string]*inf.Dec)
+	for _, id := range ids {
+		product, err := products.GetProduct(ctx, id, false)
+		if errors.Is(err, repository.ErrProductNotFound) {
+			// deleted since it was listed
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		variants, err := products.ListVariants(ctx, id, false)
+		if err != nil {
+			return 0, err
+		}
+
+		price, err := i.price(ctx, product, rates)
+		if err != nil {
+			return 0, err
+		}
+
+		docId := strconv.FormatInt(id, 10)
+		if err := batch.Index(docId, newDocument(product, variants, price)); err != nil {
+			return 0, err
+		}
+		indexed[docId] = true
+		if batch.Size() >= batchSize {
+			if err := flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	stale, err := i.documentIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, docId := range stale {
+		if !indexed[docId] {
+			batch.Delete(docId)
+		}
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return len(indexed), nil
+}
+
+// documentIDs returns the ids of every indexed product.
+func (i *Index) documentIDs(ctx context.Context) ([]string, error) {
+	count, err := i.index.DocCount()
+	if err != nil || count == 0 {
+		return nil, err
+	}
+	found, err := i.index.SearchInContext(ctx, bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), int(count), 0, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indexed products: %w", err)
+	}
+	ids := make([]string, 0, len(found.Hits))
+	for _, hit := range found.Hits {
+		ids = append(ids, hit.ID)
+	}
+	return ids, nil
+}
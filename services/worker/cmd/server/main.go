@@ -9,12 +9,23 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/activities"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/exports"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/payments"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/promotions"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/schedules"
+	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/tax"
 	"github.com/yaninyzwitty/temporal-microservice-go/services/order-service/workflows"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
 	database "github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/db"
 	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/ledger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/money"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/tracing"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/interceptor"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
 )
 
 func main() {
@@ -24,12 +35,24 @@ func main() {
 		slog.Error("failed to load config", "error", err)
 		os.Exit(1)
 	}
+	slog.SetDefault(logger.New("worker", cfg.Logging))
 
 	if err := godotenv.Load(); err != nil {
 		slog.Error("failed to load .env file", "error", err)
 		os.Exit(1)
 	}
 
+	shutdownTracer, err := tracing.InitTracer("worker", cfg.Tracing)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			slog.Error("failed to shutdown tracing", "error", err)
+		}
+	}()
+
 	astraCfg := &database.AstraConfig{
 		Username: cfg.Database.Username,
 		Path:     cfg.Database.Path,
@@ -43,8 +66,18 @@ func main() {
 		os.Exit(1)
 	}
 	defer session.Close()
-	// Create the Temporal client
-	c, err := client.Dial(client.Options{})
+	temporalInterceptor, err := tracing.TemporalInterceptor()
+	if err != nil {
+		slog.Error("failed to create temporal tracing interceptor", "error", err)
+		os.Exit(1)
+	}
+
+	// Create the Temporal client; the tracing interceptor is inherited by the worker
+	c, err := client.Dial(client.Options{
+		Logger:             logger.NewTemporalLogger(slog.Default()),
+		Interceptors:       []interceptor.ClientInterceptor{temporalInterceptor},
+		ContextPropagators: []workflow.ContextPropagator{logger.NewCorrelationPropagator()},
+	})
 	if err != nil {
 		slog.Error("Unable to create Temporal client", "error", err)
 		os.Exit(1)
@@ -52,18 +85,83 @@ func main() {
 	defer c.Close()
 
 	// Create the Temporal worker
-	w := worker.New(c, "order-service-queue", worker.Options{})
+	w := worker.New(c, workflows.TaskQueue, worker.Options{})
 
-	// inject cassandra session to orderactivity struct
+	paymentGateway, err := payments.NewGateway(cfg.Payments)
+	if err != nil {
+		slog.Error("Unable to create payment gateway", "error", err)
+		os.Exit(1)
+	}
+
+	taxCalculator, err := tax.NewCalculator(cfg.Tax, tax.NewRateStore(session))
+	if err != nil {
+		slog.Error("Unable to create tax calculator", "error", err)
+		os.Exit(1)
+	}
+
+	exportStorage, err := exports.NewStorage(cfg.Exports)
+	if err != nil {
+		slog.Error("Unable to create export storage", "error", err)
+		os.Exit(1)
+	}
+
+	// inject cassandra session and payment gateway to orderactivity struct
 	orderActivities := activities.OrderActivity{
+		Cassandra:     session,
+		Payments:      paymentGateway,
+		Currency:      cfg.Payments.Currency,
+		Inventory:     cfg.Inventory,
+		Ledger:        ledger.New(session, ""),
+		Temporal:      c,
+		Replenishment: cfg.Replenishment,
+		Promotions:    promotions.NewStore(session),
+		Tax:           taxCalculator,
+		Converter:     money.NewConverter(session, ""),
+		Exports:       exportStorage,
+	}
+	reconcileActivities := &activities.ReconcileActivity{
+		Cassandra: session,
+		Ledger:    orderActivities.Ledger,
+	}
+	purgeActivities := &activities.PurgeActivity{
 		Cassandra: session,
+		Ledger:    orderActivities.Ledger,
 	}
 
 	// Register the workflow functions
 	w.RegisterWorkflow(workflows.CreateOrderWorkflow)
+	w.RegisterWorkflow(workflows.ReturnWorkflow)
+	w.RegisterWorkflow(workflows.ReconcileInventoryWorkflow)
+	w.RegisterWorkflow(workflows.ReplenishmentWorkflow)
+	w.RegisterWorkflow(workflows.CustomerErasureWorkflow)
+	w.RegisterWorkflow(workflows.CustomerExportWorkflow)
+	w.RegisterWorkflow(workflows.PurgeDeletedProductsWorkflow)
 
 	// register activities
 	w.RegisterActivity(orderActivities)
+	w.RegisterActivity(reconcileActivities)
+	w.RegisterActivity(purgeActivities)
+
+	// the reconciliation and purge schedules follow config.yaml, including pause and unpause
+	if err := schedules.SyncReconcileInventory(context.Background(), c, cfg.Reconciliation); err != nil {
+		slog.Error("Unable to sync reconciliation schedule", "error", err)
+		os.Exit(1)
+	}
+	if err := schedules.SyncPurgeDeletedProducts(context.Background(), c, cfg.Purge); err != nil {
+		slog.Error("Unable to sync purge schedule", "error", err)
+		os.Exit(1)
+	}
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	go pkg.WatchConfig(watchCtx, "config.yaml", 10*time.Second, func(updated *pkg.Config) {
+		if err := schedules.SyncReconcileInventory(watchCtx, c, updated.Reconciliation); err != nil {
+			slog.Error("failed to sync reconciliation schedule", "error", err)
+		}
+		if err := schedules.SyncPurgeDeletedProducts(watchCtx, c, updated.Purge); err != nil {
+			slog.Error("failed to sync purge schedule", "error", err)
+		}
+	})
+
 	// run the worker
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		slog.Error("Unable to start temporal worker", "error", err)
@@ -0,0 +1,144 @@
+// Package address validates postal addresses against the rules of the country
+// they are in.
+package address
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrInvalid is returned for an address that does not follow the rules of its country.
+var ErrInvalid = errors.New("invalid address")
+
+// Address is a postal address. Country is an ISO 3166-1 alpha-2 code.
+type Address struct {
+	Line1      string
+	City       string
+	Region     string
+	PostalCode string
+	Country    string
+}
+
+// rule is what an address in a country must have.
+type rule struct {
+	regionRequired bool
+	// regions are the valid region codes; any region is accepted when empty
+	regions map[string]bool
+	// postalCode is the format of postal codes; countries without postal codes have none
+	postalCode     *regexp.Regexp
+	postalOptional bool
+}
+
+var (
+	usStates = set("AL", "AK", "AZ", "AR", "CA", "CO", "CT", "DE", "DC", "FL", "GA", "HI", "ID", "IL", "IN", "IA",
+		"KS", "KY", "LA", "ME", "MD", "MA", "MI", "MN", "MS", "MO", "MT", "NE", "NV", "NH", "NJ", "NM", "NY", "NC",
+		"ND", "OH", "OK", "OR", "PA", "RI", "SC", "SD", "TN", "TX", "UT", "VT", "VA", "WA", "WV", "WI", "WY",
+		"AS", "GU", "MP", "PR", "VI", "AA", "AE", "AP")
+	caProvinces  = set("AB", "BC", "MB", "NB", "NL", "NS", "NT", "NU", "ON", "PE", "QC", "SK", "YT")
+	auStates     = set("ACT", "NSW", "NT", "QLD", "SA", "TAS", "VIC", "WA")
+	fourDigits   = regexp.MustCompile(`^\d{4}$`)
+	fiveDigits   = regexp.MustCompile(`^\d{5}$`)
+	sixDigits    = regexp.MustCompile(`^\d{6}$`)
+	defaultRule  = rule{postalCode: regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,9}$`), postalOptional: true}
+	noPostalCode = rule{}
+)
+
+// rules holds the countries with rules of their own; the others follow defaultRule.
+var rules = map[string]rule{
+	"US": {regionRequired: true, regions: usStates, postalCode: regexp.MustCompile(`^\d{5}(-\d{4})?$`)},
+	"CA": {regionRequired: true, regions: caProvinces, postalCode: regexp.MustCompile(`^[A-Z]\d[A-Z] ?\d[A-Z]\d$`)},
+	"AU": {regionRequired: true, regions: auStates, postalCode: fourDigits},
+	"GB": {postalCode: regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`)},
+	"IE": {postalCode: regexp.MustCompile(`^[A-Z]\d[\dW] ?[A-Z\d]{4}$`)},
+	"NL": {postalCode: regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`)},
+	"JP": {regionRequired: true, postalCode: regexp.MustCompile(`^\d{3}-?\d{4}$`)},
+	"BR": {regionRequired: true, postalCode: regexp.MustCompile(`^\d{5}-?\d{3}$`)},
+	"MX": {regionRequired: true, postalCode: fiveDigits},
+	"IN": {regionRequired: true, postalCode: sixDigits},
+	"CN": {regionRequired: true, postalCode: sixDigits},
+	"DE": {postalCode: fiveDigits},
+	"FR": {postalCode: fiveDigits},
+	"ES": {postalCode: fiveDigits},
+	"IT": {postalCode: fiveDigits},
+	"SE": {postalCode: regexp.MustCompile(`^\d{3} ?\d{2}$`)},
+	"PL": {postalCode: regexp.MustCompile(`^\d{2}-\d{3}$`)},
+	"AT": {postalCode: fourDigits},
+	"BE": {postalCode: fourDigits},
+	"CH": {postalCode: fourDigits},
+	"DK": {postalCode: fourDigits},
+	"NO": {postalCode: fourDigits},
+	"NZ": {postalCode: fourDigits},
+	"ZA": {postalCode: fourDigits},
+	"SG": {postalCode: sixDigits},
+	"KE": {postalCode: fiveDigits},
+	"AE": noPostalCode,
+	"HK": noPostalCode,
+}
+
+// Validate checks that the address has a street, a city and a country, and the
+// region and postal code its country requires, in the format used there.
+func Validate(a Address) error {
+	if !isCountryCode(a.Country) {
+		return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code, e.g. US", ErrInvalid)
+	}
+	if strings.TrimSpace(a.Line1) == "" || strings.TrimSpace(a.City) == "" {
+		return fmt.Errorf("%w: line1 and city are required", ErrInvalid)
+	}
+
+	r, ok := rules[a.Country]
+	if !ok {
+		r = defaultRule
+	}
+
+	region := strings.ToUpper(strings.TrimSpace(a.Region))
+	if r.regionRequired && region == "" {
+		return fmt.Errorf("%w: region is required in %s", ErrInvalid, a.Country)
+	}
+	if region != "" && len(r.regions) > 0 && !r.regions[region] {
+		return fmt.Errorf("%w: %q is not a region of %s", ErrInvalid, a.Region, a.Country)
+	}
+
+	postalCode := strings.ToUpper(strings.TrimSpace(a.PostalCode))
+	switch {
+	case r.postalCode == nil && postalCode != "":
+		return fmt.Errorf("%w: %s has no postal codes", ErrInvalid, a.Country)
+	case r.postalCode != nil && postalCode == "" && !r.postalOptional:
+		return fmt.Errorf("%w: postal_code is required in %s", ErrInvalid, a.Country)
+	case r.postalCode != nil && postalCode != "" && !r.postalCode.MatchString(postalCode):
+		return fmt.Errorf("%w: %q is not a postal code of %s", ErrInvalid, a.PostalCode, a.Country)
+	}
+	return nil
+}
+
+// Normalize trims the address and upper-cases its codes, the form it is stored in.
+func Normalize(a Address) Address {
+	return Address{
+		Line1:      strings.TrimSpace(a.Line1),
+		City:       strings.TrimSpace(a.City),
+		Region:     strings.ToUpper(strings.TrimSpace(a.Region)),
+		PostalCode: strings.ToUpper(strings.TrimSpace(a.PostalCode)),
+		Country:    strings.ToUpper(strings.TrimSpace(a.Country)),
+	}
+}
+
+func isCountryCode(country string) bool {
+	if len(country) != 2 {
+		return false
+	}
+	for _, c := range country {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+func set(values ...string) map[string]bool {
+	s := make(map[string]bool, len(values))
+	for _, v := range values {
+		s[v] = true
+	}
+	return s
+}
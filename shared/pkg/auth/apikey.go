@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+)
+
+// APIKeyHeader carries a static API key.
+const APIKeyHeader = "X-Api-Key"
+
+type apiKey struct {
+	hash      []byte
+	principal *Principal
+}
+
+// APIKeyAuthenticator checks static API keys against their configured SHA-256 hashes.
+type APIKeyAuthenticator struct {
+	keys []apiKey
+}
+
+// NewAPIKeyAuthenticator builds an authenticator from the configured keys.
+func NewAPIKeyAuthenticator(keys []pkg.APIKey) (*APIKeyAuthenticator, error) {
+	a := &APIKeyAuthenticator{}
+	for _, k := range keys {
+		hash, err := hex.DecodeString(k.KeySHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("api key %q: key_sha256 must be a hex encoded sha256 digest", k.Name)
+		}
+
+		a.keys = append(a.keys, apiKey{
+			hash: hash,
+			principal: &Principal{
+				Subject:    k.Name,
+				CustomerID: k.CustomerID,
+				Roles:      k.Roles,
+			},
+		})
+	}
+	return a, nil
+}
+
+func (a *APIKeyAuthenticator) Authenticate(_ context.Context, header http.Header) (*Principal, error) {
+	key := header.Get(APIKeyHeader)
+	if key == "" {
+		return nil, ErrNoCredentials
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	for _, k := range a.keys {
+		if subtle.ConstantTimeCompare(sum[:], k.hash) == 1 {
+			return k.principal, nil
+		}
+	}
+	return nil, errors.New("invalid api key")
+}
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+)
+
+// RoleAdmin bypasses ownership checks.
+const RoleAdmin = "admin"
+
+// RoleCustomer may only act on its own customer record.
+const RoleCustomer = "customer"
+
+// ErrNoCredentials is returned by an Authenticator when the request carries
+// no credentials it understands, so the next authenticator can be tried.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Principal is the authenticated caller.
+type Principal struct {
+	Subject    string
+	CustomerID int64
+	Roles      []string
+}
+
+// HasRole reports whether p holds any of roles.
+func (p *Principal) HasRole(roles ...string) bool {
+	for _, r := range roles {
+		if slices.Contains(p.Roles, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator resolves the caller from request headers.
+type Authenticator interface {
+	Authenticate(ctx context.Context, header http.Header) (*Principal, error)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the authenticated caller, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
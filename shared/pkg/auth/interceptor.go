@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errPermissionDenied = errors.New("permission denied")
+
+// Interceptor authenticates every handler call and enforces the per-procedure
+// policies from config. Procedures without a policy are admin only.
+type Interceptor struct {
+	enabled        bool
+	authenticators []Authenticator
+	policies       map[string]pkg.Policy
+}
+
+// NewInterceptor builds the auth interceptor. When auth is disabled every call
+// is let through unauthenticated.
+func NewInterceptor(cfg pkg.Auth) (*Interceptor, error) {
+	i := &Interceptor{enabled: cfg.Enabled, policies: cfg.Policies}
+	if !cfg.Enabled {
+		slog.Warn("authentication is disabled, all requests are accepted")
+		return i, nil
+	}
+
+	if cfg.JWT.HMACSecretEnv != "" || cfg.JWT.JWKSFile != "" {
+		jwtAuth, err := NewJWTAuthenticator(cfg.JWT)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure jwt auth: %w", err)
+		}
+		i.authenticators = append(i.authenticators, jwtAuth)
+	}
+
+	if len(cfg.APIKeys) > 0 {
+		keyAuth, err := NewAPIKeyAuthenticator(cfg.APIKeys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure api key auth: %w", err)
+		}
+		i.authenticators = append(i.authenticators, keyAuth)
+	}
+
+	if len(i.authenticators) == 0 {
+		return nil, errors.New("auth is enabled but no jwt or api key authentication is configured")
+	}
+
+	return i, nil
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !i.enabled || req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		ctx, principal, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.authorize(principal, req.Spec().Procedure, req.Any()); err != nil {
+			return nil, err
+		}
+
+		return next(ctx, req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !i.enabled {
+			return next(ctx, conn)
+		}
+
+		ctx, principal, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+
+		// Ownership can only be checked once a message arrives, so the role
+		// check runs now and every received message is checked as it is read.
+		if err := i.authorize(principal, conn.Spec().Procedure, nil); err != nil {
+			return err
+		}
+
+		return next(ctx, &authorizedConn{StreamingHandlerConn: conn, interceptor: i, principal: principal})
+	}
+}
+
+func (i *Interceptor) authenticate(ctx context.Context, header http.Header) (context.Context, *Principal, error) {
+	for _, a := range i.authenticators {
+		principal, err := a.Authenticate(ctx, header)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		if err != nil {
+			logger.FromContext(ctx).Warn("authentication failed", "error", err)
+			return ctx, nil, connect.NewError(connect.CodeUnauthenticated, errors.New("invalid credentials"))
+		}
+
+		ctx = WithPrincipal(ctx, principal)
+		ctx = logger.WithLogger(ctx, logger.FromContext(ctx).With("subject", principal.Subject))
+		return ctx, principal, nil
+	}
+
+	return ctx, nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing credentials"))
+}
+
+// authorize checks p against the policy for procedure. A nil msg skips the
+// ownership check.
+func (i *Interceptor) authorize(p *Principal, procedure string, msg any) error {
+	if p.HasRole(RoleAdmin) {
+		return nil
+	}
+
+	policy, ok := i.policies[procedure]
+	if !ok || !p.HasRole(policy.Roles...) {
+		return connect.NewError(connect.CodePermissionDenied, errPermissionDenied)
+	}
+
+	if policy.OwnerField == "" || msg == nil {
+		return nil
+	}
+
+	owner, ok := ownerID(msg, policy.OwnerField)
+	if !ok || p.CustomerID == 0 || owner != p.CustomerID {
+		return connect.NewError(connect.CodePermissionDenied, errPermissionDenied)
+	}
+	return nil
+}
+
+// ownerID reads the customer ID named by field from a request message. Fields
+// of nested messages are named by their path, e.g. address.customer_id.
+func ownerID(msg any, field string) (int64, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return 0, false
+	}
+
+	r := m.ProtoReflect()
+	path := strings.Split(field, ".")
+	for _, name := range path[:len(path)-1] {
+		fd := r.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !r.Has(fd) {
+			return 0, false
+		}
+		r = r.Get(fd).Message()
+	}
+	fd := r.Descriptor().Fields().ByName(protoreflect.Name(path[len(path)-1]))
+	if fd == nil {
+		return 0, false
+	}
+
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Int32Kind:
+		return r.Get(fd).Int(), true
+	case protoreflect.StringKind:
+		id, err := strconv.ParseInt(r.Get(fd).String(), 10, 64)
+		return id, err == nil
+	default:
+		return 0, false
+	}
+}
+
+type authorizedConn struct {
+	connect.StreamingHandlerConn
+	interceptor *Interceptor
+	principal   *Principal
+}
+
+func (c *authorizedConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.authorize(c.principal, c.Spec().Procedure, msg)
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/helpers"
+)
+
+// claims are the JWT claims we rely on besides the registered ones.
+type claims struct {
+	jwt.RegisteredClaims
+	Roles      []string `json:"roles"`
+	CustomerID int64    `json:"customer_id"`
+}
+
+// JWTAuthenticator validates bearer tokens signed with an HMAC secret or with
+// a key from a local JWKS file.
+type JWTAuthenticator struct {
+	secret []byte
+	keys   map[string]any
+	parser *jwt.Parser
+}
+
+// NewJWTAuthenticator loads the signing keys described by cfg.
+func NewJWTAuthenticator(cfg pkg.JWTAuth) (*JWTAuthenticator, error) {
+	a := &JWTAuthenticator{keys: make(map[string]any)}
+
+	var methods []string
+	if cfg.HMACSecretEnv != "" {
+		secret := helpers.GetEnvOrDefault(cfg.HMACSecretEnv, "")
+		if secret == "" {
+			return nil, fmt.Errorf("environment variable %s is empty", cfg.HMACSecretEnv)
+		}
+		a.secret = []byte(secret)
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+
+	if cfg.JWKSFile != "" {
+		if err := a.loadJWKS(cfg.JWKSFile); err != nil {
+			return nil, err
+		}
+		methods = append(methods, "RS256", "RS384", "RS512", "ES256", "ES384", "ES512")
+	}
+
+	if len(methods) == 0 {
+		return nil, errors.New("jwt auth needs hmac_secret_env or jwks_file")
+	}
+
+	opts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	a.parser = jwt.NewParser(opts...)
+
+	return a, nil
+}
+
+func (a *JWTAuthenticator) Authenticate(_ context.Context, header http.Header) (*Principal, error) {
+	authz := header.Get("Authorization")
+	token, ok := strings.CutPrefix(authz, "Bearer ")
+	if !ok || token == "" {
+		return nil, ErrNoCredentials
+	}
+
+	var c claims
+	if _, err := a.parser.ParseWithClaims(token, &c, a.keyFunc); err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+
+	return &Principal{
+		Subject:    c.Subject,
+		CustomerID: c.CustomerID,
+		Roles:      c.Roles,
+	}, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return a.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := a.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (a *JWTAuthenticator) loadJWKS(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read jwks file: %w", err)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("failed to parse jwks file: %w", err)
+	}
+
+	for _, k := range set.Keys {
+		key, err := k.publicKey()
+		if err != nil {
+			return fmt.Errorf("jwks key %q: %w", k.Kid, err)
+		}
+		a.keys[k.Kid] = key
+	}
+	return nil
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -1,8 +1,10 @@
 package pkg
 
 import (
+	"context"
 	"log/slog"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -12,12 +14,152 @@ type Config struct {
 	Database       Database       `yaml:"database"`
 	ProductServer  ProductServer  `yaml:"products-server"`
 	OrderServer    OrderServer    `yaml:"order-server"`
+	CartServer     CartServer     `yaml:"cart-server"`
+	Tracing        Tracing        `yaml:"tracing"`
+	Logging        Logging        `yaml:"logging"`
+	Auth           Auth           `yaml:"auth"`
+	RateLimit      RateLimit      `yaml:"rate_limit"`
+	Payments       Payments       `yaml:"payments"`
+	Inventory      Inventory      `yaml:"inventory"`
+	Reconciliation Reconciliation `yaml:"reconciliation"`
+	Replenishment  Replenishment  `yaml:"replenishment"`
+	Tax            Tax            `yaml:"tax"`
+	Exports        Exports        `yaml:"exports"`
+	Purge          Purge          `yaml:"purge"`
+	Search         Search         `yaml:"search"`
+}
+
+type Payments struct {
+	Gateway  string       `yaml:"gateway"`  // only "fake" for now
+	Currency string       `yaml:"currency"` // ISO 4217 code orders are charged in
+	Fake     FakePayments `yaml:"fake"`
+}
+
+type FakePayments struct {
+	Outcome string `yaml:"outcome"` // approve, decline, timeout or require_3ds
+}
+
+type Inventory struct {
+	AllocationStrategy string      `yaml:"allocation_strategy"` // nearest, cheapest or fewest_splits
+	Warehouses         []Warehouse `yaml:"warehouses"`          // the first one is the default location
+}
+
+// Reconciliation is applied to the Temporal schedule whenever the worker starts
+// or config.yaml changes.
+type Reconciliation struct {
+	Schedule       string `yaml:"schedule"` // cron expression, in UTC
+	Paused         bool   `yaml:"paused"`
+	AutoCorrect    bool   `yaml:"auto_correct"`
+	MaxAutoCorrect int32  `yaml:"max_auto_correct"` // largest drift, in units, corrected automatically
+}
+
+// Purge is applied to the Temporal schedule that hard-deletes deleted products
+// whenever the worker starts or config.yaml changes.
+type Purge struct {
+	Schedule string `yaml:"schedule"` // cron expression, in UTC
+	Paused   bool   `yaml:"paused"`
+	// Retention is how long a deleted product can be restored before it is purged, e.g. 720h.
+	Retention time.Duration `yaml:"retention"`
+}
+
+type Replenishment struct {
+	// WebhookURL receives purchase orders as JSON. Empty logs them instead.
+	WebhookURL string `yaml:"webhook_url"`
+}
+
+type Tax struct {
+	Calculator string `yaml:"calculator"` // only "table" for now, rates are managed with the tax rate RPCs
+}
+
+// Exports is where customer data exports are written.
+type Exports struct {
+	Storage   string `yaml:"storage"`   // only "local" for now
+	Directory string `yaml:"directory"` // root directory of local exports
+}
+
+// Search is the full-text index of the product service.
+type Search struct {
+	IndexPath string `yaml:"index_path"` // directory of the local index
+	Currency  string `yaml:"currency"`   // ISO 4217 code prices are bucketed in
+	// RefreshInterval is how often the product service re-indexes every product
+	// from Cassandra, which picks up stock changed by orders. 0 turns it off.
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+type Warehouse struct {
+	ID                string  `yaml:"id"`
+	Name              string  `yaml:"name"`
+	Latitude          float64 `yaml:"latitude"`
+	Longitude         float64 `yaml:"longitude"`
+	ShippingCostMinor int64   `yaml:"shipping_cost_minor"` // cost of shipping one unit from here
+}
+
+// RateLimit is reloaded while services run, see WatchConfig.
+type RateLimit struct {
+	Enabled bool `yaml:"enabled"`
+	// MaxInFlight caps concurrent requests per service name; extra requests are shed.
+	MaxInFlight map[string]int           `yaml:"max_in_flight"`
+	Default     RateLimitRule            `yaml:"default"`
+	Procedures  map[string]RateLimitRule `yaml:"procedures"`
+}
+
+type RateLimitRule struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"` // 0 means unlimited
+	Burst             int     `yaml:"burst"`
+	Key               string  `yaml:"key"` // api_key, customer_id or peer_ip
+}
+
+type Auth struct {
+	Enabled bool     `yaml:"enabled"` // false accepts every request, for local dev
+	JWT     JWTAuth  `yaml:"jwt"`
+	APIKeys []APIKey `yaml:"api_keys"`
+	// Policies maps a Connect procedure to who may call it. Procedures without a policy are admin only.
+	Policies map[string]Policy `yaml:"policies"`
+}
+
+type JWTAuth struct {
+	JWKSFile      string `yaml:"jwks_file"`
+	HMACSecretEnv string `yaml:"hmac_secret_env"` // name of the env var holding the secret -- use .env
+	Issuer        string `yaml:"issuer"`
+	Audience      string `yaml:"audience"`
+}
+
+type APIKey struct {
+	Name       string   `yaml:"name"`
+	KeySHA256  string   `yaml:"key_sha256"`
+	Roles      []string `yaml:"roles"`
+	CustomerID int64    `yaml:"customer_id"`
+}
+
+type Policy struct {
+	Roles []string `yaml:"roles"`
+	// OwnerField names the request field holding a customer ID, as a dotted path
+	// for nested messages; non-admins may only pass their own.
+	OwnerField string `yaml:"owner_field"`
+}
+
+type Logging struct {
+	Level  string `yaml:"level"`  // debug, info, warn or error
+	Format string `yaml:"format"` // json or text
+}
+
+type Tracing struct {
+	Enabled bool `yaml:"enabled"`
+	// Output is the directory spans are written to, one file per service. Empty means stdout.
+	Output      string  `yaml:"output"`
+	SampleRatio float64 `yaml:"sample_ratio"`
 }
 
 type OrderServer struct {
 	Port int `yaml:"port"`
 }
 
+type CartServer struct {
+	Port int `yaml:"port"`
+	// TTL is how long a cart is kept after its last change, e.g. 720h.
+	TTL time.Duration `yaml:"ttl"`
+}
+
 type Database struct {
 	Username string `yaml:"username"`
 	// Token           string        `yaml:"token"` -- use .env
@@ -31,6 +173,8 @@ type Database struct {
 
 type CustomerServer struct {
 	Port int `yaml:"port"`
+	// ErasureGracePeriod is how long a deleted customer can be restored before their data is erased, e.g. 720h.
+	ErasureGracePeriod time.Duration `yaml:"erasure_grace_period"`
 }
 
 type ProductServer struct {
@@ -53,3 +197,35 @@ func (c *Config) LoadConfig(path string) error {
 	return nil
 
 }
+
+// WatchConfig polls the config file at path and calls onChange with the freshly
+// loaded config whenever it is modified, until ctx is done.
+func WatchConfig(ctx context.Context, path string, interval time.Duration, onChange func(*Config)) {
+	var lastMod time.Time
+	if info, err := os.Stat(path); err == nil {
+		lastMod = info.ModTime()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err := os.Stat(path)
+			if err != nil || !info.ModTime().After(lastMod) {
+				continue
+			}
+			lastMod = info.ModTime()
+
+			cfg := &Config{}
+			if err := cfg.LoadConfig(path); err != nil {
+				continue
+			}
+			slog.Info("config file changed, reloading", "path", path)
+			onChange(cfg)
+		}
+	}
+}
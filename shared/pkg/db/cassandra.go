@@ -17,6 +17,15 @@ type AstraConfig struct {
 	Token    string
 }
 
+// LogValue keeps the Astra token out of logs.
+func (c AstraConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("username", c.Username),
+		slog.String("path", c.Path),
+		slog.String("token", "[REDACTED]"),
+	)
+}
+
 // AstraMethods defines the methods for interacting with Astra DB.
 type AstraMethods interface {
 	Connect(ctx context.Context, cfg *AstraConfig, timeout time.Duration) (*gocql.Session, error)
@@ -38,6 +47,11 @@ func (db *AstraDB) Connect(ctx context.Context, cfg *AstraConfig, timeout time.D
 		return nil, fmt.Errorf("failed to create Astra DB cluster from bundle: %w", err)
 	}
 
+	// Trace every query; spans are no-ops unless a tracer provider is installed.
+	tracer := newQueryTracer()
+	cluster.QueryObserver = tracer
+	cluster.BatchObserver = tracer
+
 	// Open a new session using the cluster configuration.
 	session, err := gocql.NewSession(*cluster)
 	if err != nil {
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"context"
+
+	"github.com/gocql/gocql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// queryTracer records a client span for every CQL query and batch. gocql only
+// reports queries after they finish, so spans are back-dated to the observed start.
+type queryTracer struct {
+	tracer trace.Tracer
+}
+
+func newQueryTracer() *queryTracer {
+	return &queryTracer{tracer: otel.Tracer("github.com/gocql/gocql")}
+}
+
+func (t *queryTracer) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	_, span := t.tracer.Start(ctx, "cassandra.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(q.Start),
+		trace.WithAttributes(
+			attribute.String("db.system", "cassandra"),
+			attribute.String("db.namespace", q.Keyspace),
+			attribute.String("db.query.text", q.Statement),
+			attribute.Int("db.response.returned_rows", q.Rows),
+		),
+	)
+	if q.Err != nil {
+		span.RecordError(q.Err)
+		span.SetStatus(codes.Error, q.Err.Error())
+	}
+	span.End(trace.WithTimestamp(q.End))
+}
+
+func (t *queryTracer) ObserveBatch(ctx context.Context, b gocql.ObservedBatch) {
+	_, span := t.tracer.Start(ctx, "cassandra.batch",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(b.Start),
+		trace.WithAttributes(
+			attribute.String("db.system", "cassandra"),
+			attribute.String("db.namespace", b.Keyspace),
+			attribute.StringSlice("db.query.text", b.Statements),
+		),
+	)
+	if b.Err != nil {
+		span.RecordError(b.Err)
+		span.SetStatus(codes.Error, b.Err.Error())
+	}
+	span.End(trace.WithTimestamp(b.End))
+}
@@ -0,0 +1,167 @@
+// Package ledger records every change to the on-hand stock of products in an
+// append-only table, so stock levels can be explained and checked afterwards.
+package ledger
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/gocql/gocql"
+)
+
+// Reasons for a stock movement.
+const (
+	ReasonInitialStock   = "initial_stock"
+	ReasonAdjustment     = "adjustment"
+	ReasonOrderConfirmed = "order_confirmed"
+	ReasonOrderReverted  = "order_reverted"
+	ReasonReturnRestock  = "return_restock"
+	ReasonReconciliation = "reconciliation"
+)
+
+// Movement is one change to the on-hand stock of a product.
+type Movement struct {
+	ProductID  int64
+	LocationID string
+	Delta      int32
+	Reason     string
+	OrderID    int64  // zero when the movement is not caused by an order
+	Actor      string // who made the change: an authenticated subject or a workflow
+	OccurredAt time.Time
+	// Key identifies a movement that may be recorded more than once, e.g. by a
+	// retried activity: recording it again overwrites it instead of adding
+	// another. OccurredAt must then be the same every time.
+	Key string
+}
+
+// Ledger reads and appends stock movements.
+type Ledger struct {
+	session *gocql.Session
+	table   string
+}
+
+// New returns a ledger stored in keyspace, or in the session's keyspace when keyspace is empty.
+func New(session *gocql.Session, keyspace string) *Ledger {
+	table := "stock_movements"
+	if keyspace != "" {
+		table = keyspace + "." + table
+	}
+	return &Ledger{session: session, table: table}
+}
+
+// Record appends a movement. OccurredAt defaults to now.
+func (l *Ledger) Record(ctx context.Context, m Movement) error {
+	if m.OccurredAt.IsZero() {
+		m.OccurredAt = time.Now()
+	}
+
+	query := `INSERT INTO ` + l.table + ` (product_id, occurred_at, movement_id, location_id, delta, reason, order_id, actor) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	err := l.session.Query(query, m.ProductID, m.OccurredAt, movementID(m), m.LocationID, m.Delta, m.Reason, m.OrderID, m.Actor).WithContext(ctx).Exec()
+	if err != nil {
+		return fmt.Errorf("failed to record stock movement of product %d: %w", m.ProductID, err)
+	}
+	return nil
+}
+
+// movementID is a time UUID of when the movement occurred. The rest of the
+// UUID is random, or derived from the key and location of a keyed movement so it is
+// the same every time the movement is recorded.
+func movementID(m Movement) gocql.UUID {
+	id := gocql.UUIDFromTime(m.OccurredAt)
+	if m.Key != "" {
+		sum := sha256.Sum256([]byte(m.Key + "/" + m.LocationID))
+		copy(id[8:], sum[:8])
+		id[8] = id[8]&0x3f | 0x80 // RFC 4122 variant
+	}
+	return id
+}
+
+// List returns the movements of a product in [from, to), newest first, one page
+// at a time. pageState is nil for the first page; the returned state is nil after the last.
+func (l *Ledger) List(ctx context.Context, productId int64, from, to time.Time, pageSize int, pageState []byte) ([]Movement, []byte, error) {
+	query := `SELECT occurred_at, location_id, delta, reason, order_id, actor FROM ` + l.table + ` WHERE product_id = ? AND occurred_at >= ? AND occurred_at < ?`
+	iter := l.session.Query(query, productId, from, to).WithContext(ctx).PageSize(pageSize).PageState(pageState).Iter()
+
+	var movements []Movement
+	m := Movement{ProductID: productId}
+	for iter.Scan(&m.OccurredAt, &m.LocationID, &m.Delta, &m.Reason, &m.OrderID, &m.Actor) {
+		movements = append(movements, m)
+	}
+	next := iter.PageState()
+	if err := iter.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to list stock movements of product %d: %w", productId, err)
+	}
+	if len(next) == 0 {
+		next = nil
+	}
+	return movements, next, nil
+}
+
+// Purge removes every movement of a product once the product itself is purged.
+func (l *Ledger) Purge(ctx context.Context, productId int64) error {
+	query := `DELETE FROM ` + l.table + ` WHERE product_id = ?`
+	if err := l.session.Query(query, productId).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to purge stock movements of product %d: %w", productId, err)
+	}
+	return nil
+}
+
+// Balance is the stock of a product implied by its movements.
+func (l *Ledger) Balance(ctx context.Context, productId int64) (int32, error) {
+	var balance int32
+	query := `SELECT SUM(delta) FROM ` + l.table + ` WHERE product_id = ?`
+	if err := l.session.Query(query, productId).WithContext(ctx).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to sum stock movements of product %d: %w", productId, err)
+	}
+	return balance, nil
+}
+
+// BalanceByReason sums the movements of a product per reason.
+func (l *Ledger) BalanceByReason(ctx context.Context, productId int64) (map[string]int32, error) {
+	query := `SELECT reason, delta FROM ` + l.table + ` WHERE product_id = ?`
+	iter := l.session.Query(query, productId).WithContext(ctx).Iter()
+
+	balances := make(map[string]int32)
+	var reason string
+	var delta int32
+	for iter.Scan(&reason, &delta) {
+		balances[reason] += delta
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read stock movements of product %d: %w", productId, err)
+	}
+	return balances, nil
+}
+
+// Drift is a product whose stock differs from what its movements imply.
+type Drift struct {
+	ProductID int64
+	Stock     int32 // products.stock
+	Ledger    int32 // sum of the movements
+}
+
+// Reconcile recomputes the stock of every product from the ledger and returns
+// the products whose stock differs. productsTable is the products table to check.
+func (l *Ledger) Reconcile(ctx context.Context, productsTable string) ([]Drift, error) {
+	iter := l.session.Query(`SELECT id, stock FROM ` + productsTable).WithContext(ctx).Iter()
+
+	var drifts []Drift
+	var id int64
+	var stock int32
+	for iter.Scan(&id, &stock) {
+		balance, err := l.Balance(ctx, id)
+		if err != nil {
+			_ = iter.Close()
+			return nil, err
+		}
+		if balance != stock {
+			drifts = append(drifts, Drift{ProductID: id, Stock: stock, Ledger: balance})
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to read products: %w", err)
+	}
+	return drifts, nil
+}
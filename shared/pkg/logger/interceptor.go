@@ -0,0 +1,91 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader is read from incoming requests and echoed on responses.
+const RequestIDHeader = "X-Request-Id"
+
+// Interceptor injects a request-scoped logger carrying the request ID,
+// procedure and peer into the handler context and logs each call.
+type Interceptor struct{}
+
+// NewInterceptor returns the Connect logging interceptor.
+func NewInterceptor() *Interceptor {
+	return &Interceptor{}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		ctx, requestID := i.requestContext(ctx, req.Spec(), req.Peer(), req.Header().Get(RequestIDHeader))
+		start := time.Now()
+
+		resp, err := next(ctx, req)
+
+		i.logResult(ctx, start, err)
+		if resp != nil {
+			resp.Header().Set(RequestIDHeader, requestID)
+		}
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			connectErr.Meta().Set(RequestIDHeader, requestID)
+		}
+		return resp, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, requestID := i.requestContext(ctx, conn.Spec(), conn.Peer(), conn.RequestHeader().Get(RequestIDHeader))
+		conn.ResponseHeader().Set(RequestIDHeader, requestID)
+		start := time.Now()
+
+		err := next(ctx, conn)
+
+		i.logResult(ctx, start, err)
+		return err
+	}
+}
+
+func (i *Interceptor) requestContext(ctx context.Context, spec connect.Spec, peer connect.Peer, requestID string) (context.Context, string) {
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	l := FromContext(ctx).With(
+		"procedure", spec.Procedure,
+		"peer", peer.Addr,
+	)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		l = l.With("trace_id", sc.TraceID().String())
+	}
+
+	ctx = WithLogger(ctx, l)
+	return WithCorrelationID(ctx, RequestIDKey, requestID), requestID
+}
+
+func (i *Interceptor) logResult(ctx context.Context, start time.Time, err error) {
+	l := FromContext(ctx)
+	duration := time.Since(start)
+
+	if err != nil {
+		l.Error("request failed", "code", connect.CodeOf(err).String(), "duration", duration, "error", err)
+		return
+	}
+	l.Info("request completed", "duration", duration)
+}
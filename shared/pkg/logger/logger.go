@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+)
+
+const redacted = "[REDACTED]"
+
+// Correlation keys carried in the context and propagated into workflows and activities.
+const (
+	RequestIDKey  = "request_id"
+	OrderIDKey    = "order_id"
+	CustomerIDKey = "customer_id"
+)
+
+// sensitiveKeys are attribute keys whose values never reach the log output.
+var sensitiveKeys = map[string]struct{}{
+	"email":         {},
+	"token":         {},
+	"astra_token":   {},
+	"password":      {},
+	"secret":        {},
+	"api_key":       {},
+	"authorization": {},
+}
+
+type loggerKey struct{}
+
+type correlationKey struct{}
+
+// New builds the service logger from config: JSON or text output, the
+// configured level, and redaction of sensitive attributes.
+func New(service string, cfg pkg.Logging) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       parseLevel(cfg.Level),
+		ReplaceAttr: redact,
+	}
+
+	var handler slog.Handler
+	if strings.EqualFold(cfg.Format, "text") {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	}
+
+	return slog.New(handler).With("service", service)
+}
+
+func parseLevel(level string) slog.Level {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo
+	}
+	return l
+}
+
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if _, ok := sensitiveKeys[strings.ToLower(a.Key)]; ok {
+		return slog.String(a.Key, redacted)
+	}
+	return a
+}
+
+// WithLogger returns a copy of ctx carrying l.
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger stored in ctx, falling back to slog.Default.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+// WithCorrelationID records a correlation ID in ctx and adds it to the context
+// logger. Correlation IDs are forwarded to workflows and activities.
+func WithCorrelationID(ctx context.Context, key, value string) context.Context {
+	ids := make(map[string]string)
+	for k, v := range correlationIDs(ctx) {
+		ids[k] = v
+	}
+	ids[key] = value
+
+	ctx = context.WithValue(ctx, correlationKey{}, ids)
+	return WithLogger(ctx, FromContext(ctx).With(key, value))
+}
+
+func correlationIDs(ctx context.Context) map[string]string {
+	ids, _ := ctx.Value(correlationKey{}).(map[string]string)
+	return ids
+}
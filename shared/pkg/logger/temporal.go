@@ -0,0 +1,104 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/workflow"
+)
+
+// correlationHeader is the Temporal header used to forward correlation IDs.
+const correlationHeader = "correlation-ids"
+
+// NewTemporalLogger adapts l to the Temporal SDK logger. Workflow and activity
+// loggers obtained from the SDK add the workflow ID and run ID on top of it.
+func NewTemporalLogger(l *slog.Logger) log.Logger {
+	return log.NewStructuredLogger(l)
+}
+
+// Workflow returns the workflow logger enriched with the correlation IDs
+// forwarded from the caller, such as the request ID and order ID.
+func Workflow(ctx workflow.Context) log.Logger {
+	ids, _ := ctx.Value(correlationKey{}).(map[string]string)
+	return log.With(workflow.GetLogger(ctx), keyvals(ids)...)
+}
+
+// Activity returns the activity logger enriched with the forwarded correlation IDs.
+func Activity(ctx context.Context) log.Logger {
+	return log.With(activity.GetLogger(ctx), keyvals(correlationIDs(ctx))...)
+}
+
+func keyvals(ids map[string]string) []interface{} {
+	keys := make([]string, 0, len(ids))
+	for k := range ids {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	kv := make([]interface{}, 0, len(ids)*2)
+	for _, k := range keys {
+		kv = append(kv, k, ids[k])
+	}
+	return kv
+}
+
+type correlationPropagator struct{}
+
+// NewCorrelationPropagator returns a context propagator that carries
+// correlation IDs from the client into workflows and on to their activities.
+func NewCorrelationPropagator() workflow.ContextPropagator {
+	return &correlationPropagator{}
+}
+
+func (p *correlationPropagator) Inject(ctx context.Context, writer workflow.HeaderWriter) error {
+	return inject(correlationIDs(ctx), writer)
+}
+
+func (p *correlationPropagator) Extract(ctx context.Context, reader workflow.HeaderReader) (context.Context, error) {
+	ids, err := extract(reader)
+	if err != nil || ids == nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, correlationKey{}, ids), nil
+}
+
+func (p *correlationPropagator) InjectFromWorkflow(ctx workflow.Context, writer workflow.HeaderWriter) error {
+	ids, _ := ctx.Value(correlationKey{}).(map[string]string)
+	return inject(ids, writer)
+}
+
+func (p *correlationPropagator) ExtractToWorkflow(ctx workflow.Context, reader workflow.HeaderReader) (workflow.Context, error) {
+	ids, err := extract(reader)
+	if err != nil || ids == nil {
+		return ctx, err
+	}
+	return workflow.WithValue(ctx, correlationKey{}, ids), nil
+}
+
+func inject(ids map[string]string, writer workflow.HeaderWriter) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	payload, err := converter.GetDefaultDataConverter().ToPayload(ids)
+	if err != nil {
+		return err
+	}
+	writer.Set(correlationHeader, payload)
+	return nil
+}
+
+func extract(reader workflow.HeaderReader) (map[string]string, error) {
+	payload, ok := reader.Get(correlationHeader)
+	if !ok {
+		return nil, nil
+	}
+	var ids map[string]string
+	if err := converter.GetDefaultDataConverter().FromPayload(payload, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
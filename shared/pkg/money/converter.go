@@ -0,0 +1,173 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gocql/gocql"
+	"gopkg.in/inf.v0"
+)
+
+// ErrRateNotFound is returned when there is no exchange rate between two currencies.
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// Rate is the value of one unit of Base in Quote.
+type Rate struct {
+	Base      string
+	Quote     string
+	Rate      *inf.Dec
+	UpdatedAt time.Time
+}
+
+// Converter keeps exchange rates and converts amounts with them.
+type Converter struct {
+	session *gocql.Session
+	table   string
+}
+
+// NewConverter returns a converter whose rates are stored in keyspace, or in
+// the session's keyspace when keyspace is empty.
+func NewConverter(session *gocql.Session, keyspace string) *Converter {
+	table := "exchange_rates"
+	if keyspace != "" {
+		table = keyspace + "." + table
+	}
+	return &Converter{session: session, table: table}
+}
+
+// SetRate stores the rate from Base to Quote, replacing the previous one. The
+// rate the other way round is its inverse unless it is set as well.
+func (c *Converter) SetRate(ctx context.Context, rate Rate) error {
+	if err := Validate(rate.Base); err != nil {
+		return err
+	}
+	if err := Validate(rate.Quote); err != nil {
+		return err
+	}
+	if rate.UpdatedAt.IsZero() {
+		rate.UpdatedAt = time.Now()
+	}
+
+	query := `INSERT INTO ` + c.table + ` (base_currency, quote_currency, rate, updated_at) VALUES (?, ?, ?, ?)`
+	if err := c.session.Query(query, rate.Base, rate.Quote, rate.Rate, rate.UpdatedAt).WithContext(ctx).Exec(); err != nil {
+		return fmt.Errorf("failed to set exchange rate %s/%s: %w", rate.Base, rate.Quote, err)
+	}
+	return nil
+}
+
+// ListRates returns every stored rate.
+func (c *Converter) ListRates(ctx context.Context) ([]Rate, error) {
+	scanner := c.session.Query(`SELECT base_currency, quote_currency, rate, updated_at FROM ` + c.table).WithContext(ctx).Iter().Scanner()
+
+	var rates []Rate
+	for scanner.Next() {
+		var rate Rate
+		if err := scanner.Scan(&rate.Base, &rate.Quote, &rate.Rate, &rate.UpdatedAt); err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list exchange rates: %w", err)
+	}
+	return rates, nil
+}
+
+// Rate returns the current rate from base to quote, using the inverse of the
+// rate from quote to base when only that one is stored.
+func (c *Converter) Rate(ctx context.Context, base, quote string) (Rate, error) {
+	if base == quote {
+		return Rate{Base: base, Quote: quote, Rate: inf.NewDec(1, 0), UpdatedAt: time.Now()}, nil
+	}
+
+	rate, err := c.storedRate(ctx, base, quote)
+	if errors.Is(err, gocql.ErrNotFound) {
+		inverse, inverseErr := c.storedRate(ctx, quote, base)
+		if inverseErr != nil {
+			if errors.Is(inverseErr, gocql.ErrNotFound) {
+				return Rate{}, fmt.Errorf("%w: %s/%s", ErrRateNotFound, base, quote)
+			}
+			return Rate{}, inverseErr
+		}
+		return Rate{Base: base, Quote: quote, Rate: invert(inverse.Rate), UpdatedAt: inverse.UpdatedAt}, nil
+	}
+	return rate, err
+}
+
+func (c *Converter) storedRate(ctx context.Context, base, quote string) (Rate, error) {
+	rate := Rate{Base: base, Quote: quote, Rate: new(inf.Dec)}
+	query := `SELECT rate, updated_at FROM ` + c.table + ` WHERE base_currency = ? AND quote_currency = ?`
+	if err := c.session.Query(query, base, quote).WithContext(ctx).Scan(rate.Rate, &rate.UpdatedAt); err != nil {
+		if errors.Is(err, gocql.ErrNotFound) {
+			return Rate{}, err
+		}
+		return Rate{}, fmt.Errorf("failed to read exchange rate %s/%s: %w", base, quote, err)
+	}
+	return rate, nil
+}
+
+// Convert turns an amount in minor units of from into minor units of to at the
+// current rate, which it returns as well.
+func (c *Converter) Convert(ctx context.Context, amountMinor int64, from, to string) (int64, Rate, error) {
+	rate, err := c.Rate(ctx, from, to)
+	if err != nil {
+		return 0, Rate{}, err
+	}
+	converted, err := Convert(amountMinor, from, to, rate.Rate)
+	if err != nil {
+		return 0, Rate{}, err
+	}
+	return converted, rate, nil
+}
+
+// Pricer prices products in one currency. Every product priced from the same
+// currency is converted at the same rate, read once.
+type Pricer struct {
+	converter *Converter
+	currency  string
+	rates     map[string]Rate
+}
+
+// Pricer returns a pricer for currency.
+func (c *Converter) Pricer(currency string) *Pricer {
+	return &Pricer{converter: c, currency: currency, rates: make(map[string]Rate)}
+}
+
+// Price returns what a product costs in the currency of the pricer: its price
+// list entry for the currency when it has one, else its base price converted
+// at the current rate.
+func (p *Pricer) Price(ctx context.Context, base *inf.Dec, baseCurrency string, priceList map[string]*inf.Dec) (int64, error) {
+	if listed, ok := priceList[p.currency]; ok && listed != nil {
+		return FromDecimal(listed, p.currency)
+	}
+
+	baseMinor, err := FromDecimal(base, baseCurrency)
+	if err != nil {
+		return 0, err
+	}
+	if baseCurrency == p.currency {
+		return baseMinor, nil
+	}
+
+	rate, ok := p.rates[baseCurrency]
+	if !ok {
+		if rate, err = p.converter.Rate(ctx, baseCurrency, p.currency); err != nil {
+			return 0, err
+		}
+		p.rates[baseCurrency] = rate
+	}
+	return Convert(baseMinor, baseCurrency, p.currency, rate.Rate)
+}
+
+// Rates returns the rates the pricer converted at, by base currency.
+func (p *Pricer) Rates() []Rate {
+	rates := make([]Rate, 0, len(p.rates))
+	for _, rate := range p.rates {
+		rates = append(rates, rate)
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Base < rates[j].Base })
+	return rates
+}
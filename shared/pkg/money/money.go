@@ -0,0 +1,109 @@
+// Package money handles amounts as integer minor units of an ISO 4217
+// currency, stores them as decimals and converts them between currencies.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"gopkg.in/inf.v0"
+)
+
+// ErrUnknownCurrency is returned for a currency code that is not supported.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// exponents holds the number of minor unit digits of the supported currencies.
+var exponents = map[string]int{
+	"AED": 2, "AUD": 2, "BHD": 3, "BRL": 2, "CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2,
+	"CZK": 2, "DKK": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2,
+	"INR": 2, "ISK": 0, "JOD": 3, "JPY": 0, "KES": 2, "KRW": 0, "KWD": 3, "MXN": 2,
+	"MYR": 2, "NGN": 2, "NOK": 2, "NZD": 2, "OMR": 3, "PHP": 2, "PLN": 2, "SAR": 2,
+	"SEK": 2, "SGD": 2, "THB": 2, "TND": 3, "TRY": 2, "TWD": 2, "UGX": 0, "USD": 2,
+	"VND": 0, "ZAR": 2,
+}
+
+// Exponent returns the number of minor unit digits of the currency, e.g. 2 for
+// USD and 0 for JPY.
+func Exponent(currency string) (int, error) {
+	exp, ok := exponents[currency]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, currency)
+	}
+	return exp, nil
+}
+
+// Validate checks that the currency is a supported ISO 4217 code.
+func Validate(currency string) error {
+	_, err := Exponent(currency)
+	return err
+}
+
+// ToDecimal turns an amount in minor units into a decimal in major units,
+// e.g. 1999 USD into 19.99, the way amounts are stored.
+func ToDecimal(amountMinor int64, currency string) (*inf.Dec, error) {
+	exp, err := Exponent(currency)
+	if err != nil {
+		return nil, err
+	}
+	return inf.NewDec(amountMinor, inf.Scale(exp)), nil
+}
+
+// FromDecimal turns a decimal in major units into minor units, rounding half
+// up to the precision of the currency.
+func FromDecimal(amount *inf.Dec, currency string) (int64, error) {
+	exp, err := Exponent(currency)
+	if err != nil {
+		return 0, err
+	}
+	rounded := new(inf.Dec).Round(amount, inf.Scale(exp), inf.RoundHalfUp)
+	unscaled, ok := rounded.Unscaled()
+	if !ok {
+		return 0, fmt.Errorf("amount %s %s does not fit in minor units", amount, currency)
+	}
+	return unscaled, nil
+}
+
+// FromFloat turns a floating point amount in major units into minor units. It
+// only exists to read prices stored before amounts were decimals.
+func FromFloat(amount float64, currency string) (int64, error) {
+	exp, err := Exponent(currency)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(amount * math.Pow10(exp))), nil
+}
+
+// ToFloat turns minor units into a floating point amount in major units, for
+// fields kept for clients that predate minor units. Never compute with it.
+func ToFloat(amountMinor int64, currency string) float64 {
+	exp, err := Exponent(currency)
+	if err != nil {
+		return 0
+	}
+	return float64(amountMinor) / math.Pow10(exp)
+}
+
+// Convert turns an amount in minor units of from into minor units of to at
+// rate, the value of one unit of from in to, rounding half up.
+func Convert(amountMinor int64, from, to string, rate *inf.Dec) (int64, error) {
+	amount, err := ToDecimal(amountMinor, from)
+	if err != nil {
+		return 0, err
+	}
+	return FromDecimal(new(inf.Dec).Mul(amount, rate), to)
+}
+
+// ParseRate parses an exchange rate written as a decimal, e.g. "1.0842".
+func ParseRate(s string) (*inf.Dec, error) {
+	rate, ok := new(inf.Dec).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, fmt.Errorf("rate %q must be a positive decimal", s)
+	}
+	return rate, nil
+}
+
+// invert returns 1/rate with enough precision for any amount in minor units.
+func invert(rate *inf.Dec) *inf.Dec {
+	return new(inf.Dec).QuoRound(inf.NewDec(1, 0), rate, 12, inf.RoundHalfUp)
+}
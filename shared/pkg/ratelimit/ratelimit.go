@@ -0,0 +1,235 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/auth"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg/logger"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RetryAfterHeader tells a shed or throttled client how many seconds to wait.
+const RetryAfterHeader = "Retry-After"
+
+// Bucket keys a rule can use.
+const (
+	KeyAPIKey     = "api_key"
+	KeyCustomerID = "customer_id"
+	KeyPeerIP     = "peer_ip"
+)
+
+// idleBucketTTL is how long an unused token bucket is kept before it is dropped.
+const idleBucketTTL = 10 * time.Minute
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter is a Connect interceptor applying per-procedure token buckets and a
+// cap on in-flight requests for one service. Its settings can be swapped at
+// runtime with Update.
+type Limiter struct {
+	service  string
+	cfg      atomic.Pointer[pkg.RateLimit]
+	inFlight atomic.Int64
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// New returns a limiter for service configured from cfg.
+func New(service string, cfg pkg.RateLimit) *Limiter {
+	l := &Limiter{service: service, buckets: make(map[string]*bucket)}
+	l.cfg.Store(&cfg)
+	return l
+}
+
+// Update replaces the limits. Existing buckets are dropped so new rates apply immediately.
+func (l *Limiter) Update(cfg pkg.RateLimit) {
+	l.cfg.Store(&cfg)
+
+	l.mu.Lock()
+	l.buckets = make(map[string]*bucket)
+	l.mu.Unlock()
+
+	slog.Info("rate limits reloaded", "enabled", cfg.Enabled, "max_in_flight", cfg.MaxInFlight[l.service])
+}
+
+func (l *Limiter) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		release, err := l.admit(ctx, req.Spec().Procedure, req.Peer(), req.Header().Get(auth.APIKeyHeader), req.Any())
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return next(ctx, req)
+	}
+}
+
+func (l *Limiter) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (l *Limiter) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		release, err := l.admit(ctx, conn.Spec().Procedure, conn.Peer(), conn.RequestHeader().Get(auth.APIKeyHeader), nil)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return next(ctx, conn)
+	}
+}
+
+// admit applies the in-flight cap and the procedure's token bucket. The
+// returned release must be called once the request finishes.
+func (l *Limiter) admit(ctx context.Context, procedure string, peer connect.Peer, apiKey string, msg any) (func(), error) {
+	cfg := l.cfg.Load()
+	if !cfg.Enabled {
+		return func() {}, nil
+	}
+
+	if max := cfg.MaxInFlight[l.service]; max > 0 {
+		if l.inFlight.Add(1) > int64(max) {
+			l.inFlight.Add(-1)
+			logger.FromContext(ctx).Warn("load shedding request", "in_flight_limit", max)
+			return nil, exhausted(errors.New("server is overloaded"), time.Second)
+		}
+	} else {
+		l.inFlight.Add(1)
+	}
+	release := func() { l.inFlight.Add(-1) }
+
+	rule, ok := cfg.Procedures[procedure]
+	if !ok {
+		rule = cfg.Default
+	}
+	if rule.RequestsPerSecond <= 0 {
+		return release, nil
+	}
+
+	key := procedure + "|" + bucketKey(ctx, rule.Key, peer, apiKey, msg)
+	if delay := l.reserve(key, rule); delay > 0 {
+		release()
+		logger.FromContext(ctx).Warn("rate limit exceeded", "rule_key", rule.Key, "retry_after", delay)
+		return nil, exhausted(errors.New("rate limit exceeded"), delay)
+	}
+
+	return release, nil
+}
+
+// reserve takes a token from the bucket for key, returning how long the caller
+// must wait when none is available.
+func (l *Limiter) reserve(key string, rule pkg.RateLimitRule) time.Duration {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > time.Minute {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > idleBucketTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		burst := rule.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(rule.RequestsPerSecond))
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(rule.RequestsPerSecond), burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		return time.Second
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay
+	}
+	return 0
+}
+
+// bucketKey identifies the caller a rule limits. API key and customer ID
+// rules fall back to the peer IP when the request does not carry one.
+func bucketKey(ctx context.Context, keyType string, peer connect.Peer, apiKey string, msg any) string {
+	switch keyType {
+	case KeyAPIKey:
+		if apiKey != "" {
+			sum := sha256.Sum256([]byte(apiKey))
+			return "key:" + hex.EncodeToString(sum[:8])
+		}
+		if p, ok := auth.PrincipalFromContext(ctx); ok {
+			return "sub:" + p.Subject
+		}
+	case KeyCustomerID:
+		if p, ok := auth.PrincipalFromContext(ctx); ok && p.CustomerID != 0 {
+			return "customer:" + strconv.FormatInt(p.CustomerID, 10)
+		}
+		if id, ok := customerID(msg); ok {
+			return "customer:" + strconv.FormatInt(id, 10)
+		}
+	}
+	return "ip:" + peerIP(peer)
+}
+
+func customerID(msg any) (int64, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return 0, false
+	}
+	r := m.ProtoReflect()
+	fd := r.Descriptor().Fields().ByName("customer_id")
+	if fd == nil || fd.Kind() != protoreflect.Int64Kind {
+		return 0, false
+	}
+	return r.Get(fd).Int(), true
+}
+
+func peerIP(peer connect.Peer) string {
+	host, _, err := net.SplitHostPort(peer.Addr)
+	if err != nil {
+		return peer.Addr
+	}
+	return host
+}
+
+func exhausted(err error, retryAfter time.Duration) error {
+	connectErr := connect.NewError(connect.CodeResourceExhausted, err)
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	connectErr.Meta().Set(RetryAfterHeader, fmt.Sprint(seconds))
+	return connectErr
+}
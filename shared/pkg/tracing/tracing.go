@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
+	"github.com/yaninyzwitty/temporal-microservice-go/shared/pkg"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	temporalotel "go.temporal.io/sdk/contrib/opentelemetry"
+	"go.temporal.io/sdk/interceptor"
+)
+
+// ShutdownFunc flushes pending spans and releases the exporter.
+type ShutdownFunc func(context.Context) error
+
+// Propagator is shared by the Connect and Temporal interceptors so trace
+// context flows from the HTTP request into workflows and activities.
+var Propagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// InitTracer installs the global tracer provider for serviceName. When tracing
+// is disabled a no-op provider stays in place and the returned shutdown is a no-op.
+func InitTracer(serviceName string, cfg pkg.Tracing) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(Propagator)
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var out io.Writer = os.Stdout
+	var file *os.File
+	if cfg.Output != "" {
+		if err := os.MkdirAll(cfg.Output, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create trace output directory: %w", err)
+		}
+
+		f, err := os.OpenFile(filepath.Join(cfg.Output, serviceName+".json"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace output file: %w", err)
+		}
+		out, file = f, f
+	}
+
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(out))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	ratio := cfg.SampleRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	slog.Info("tracing initialized", "service", serviceName, "output", cfg.Output)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			if cerr := file.Close(); err == nil {
+				err = cerr
+			}
+		}
+		return err
+	}, nil
+}
+
+// ConnectInterceptor returns the otelconnect interceptor used by every Connect
+// handler and client. Remote span contexts are trusted so that a trace started
+// by a caller continues through our services.
+func ConnectInterceptor() (connect.Interceptor, error) {
+	return otelconnect.NewInterceptor(
+		otelconnect.WithPropagator(Propagator),
+		otelconnect.WithTrustRemote(),
+	)
+}
+
+// TemporalInterceptor returns the Temporal SDK tracing interceptor used by the
+// order service client and the worker.
+func TemporalInterceptor() (interceptor.Interceptor, error) {
+	return temporalotel.NewTracingInterceptor(temporalotel.TracerOptions{
+		TextMapPropagator: Propagator,
+	})
+}